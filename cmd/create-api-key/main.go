@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"slot-machine/internal/application/usecase"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/config"
+	"slot-machine/internal/infrastructure/db"
+	repository_postgres "slot-machine/internal/infrastructure/repository/postgres"
+	"strings"
+	"time"
+)
+
+// Cria uma API key diretamente no banco. Serve para gerar a primeira chave,
+// antes de existir qualquer credencial administrativa.
+func main() {
+	name := flag.String("name", "", "nome da API key")
+	scopes := flag.String("scopes", "", "escopos separados por vírgula")
+	expiresIn := flag.Duration("expires-in", 0, "validade da chave (ex: 720h); 0 para não expirar")
+	flag.Parse()
+
+	config.LoadEnv()
+	pool, err := db.NewPgxPool(config.GetRequiredEnv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("Falha ao conectar ao banco de dados: %v", err)
+	}
+	defer pool.Close()
+
+	req := &usecase.CreateAPIKeyRequest{
+		Name: *name,
+	}
+	for _, scope := range strings.Split(*scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			req.Scopes = append(req.Scopes, model.Scope(scope))
+		}
+	}
+	if *expiresIn > 0 {
+		expiresAt := time.Now().Add(*expiresIn)
+		req.ExpiresAt = &expiresAt
+	}
+
+	ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "cli")
+	ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+
+	createAPIKeyUC := usecase.NewCreateAPIKeyUseCase(
+		repository_postgres.NewPostgresAPIKeyRepository(pool),
+		repository_postgres.NewPostgresAuditRepository(pool),
+		repository_postgres.NewPostgresTransactor(pool),
+	)
+	resp, err := createAPIKeyUC.Execute(ctx, req)
+	if err != nil {
+		log.Fatalf("Falha ao criar API key: %v", err)
+	}
+
+	fmt.Printf("id: %s\nkey: %s\n", resp.APIKey.ID, resp.Key)
+}
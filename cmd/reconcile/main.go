@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slot-machine/internal/application/usecase"
+	"slot-machine/internal/infrastructure/config"
+	"slot-machine/internal/infrastructure/db"
+	"slot-machine/internal/infrastructure/report"
+	repository_postgres "slot-machine/internal/infrastructure/repository/postgres"
+)
+
+// Executa a conciliação uma vez e grava o relatório assinado. Sai com código
+// 1 se houver divergências, para que o agendador externo possa alertar.
+// Com -verify, apenas confere a assinatura de um relatório já gravado.
+func main() {
+	dir := flag.String("dir", "", "diretório dos relatórios (padrão: RECONCILIATION_REPORT_DIR)")
+	verify := flag.String("verify", "", "relatório (.json ou .csv) cuja assinatura deve ser conferida")
+	flag.Parse()
+
+	config.LoadEnv()
+	signingKey := []byte(config.GetRequiredEnv("RECONCILIATION_SIGNING_KEY"))
+
+	if *verify != "" {
+		if err := report.VerifyFile(*verify, signingKey); err != nil {
+			log.Fatalf("Falha ao verificar relatório: %v", err)
+		}
+		fmt.Println("assinatura válida")
+		return
+	}
+
+	if *dir == "" {
+		*dir = config.GetRequiredEnv("RECONCILIATION_REPORT_DIR")
+	}
+
+	pool, err := db.NewPgxPool(config.GetRequiredEnv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("Falha ao conectar ao banco de dados: %v", err)
+	}
+	defer pool.Close()
+
+	runReconciliationUC := usecase.NewRunReconciliationUseCase(
+		repository_postgres.NewPostgresReconciliationRepository(pool),
+		report.NewFileReportWriter(*dir, signingKey),
+	)
+	resp, err := runReconciliationUC.Execute(context.Background())
+	if err != nil {
+		log.Fatalf("Falha na conciliação: %v", err)
+	}
+
+	fmt.Printf("report: %s\naccounts: %d\ndiscrepancies: %d\ndifference: %d\n",
+		resp.Report.ID, resp.Report.Accounts, resp.Report.Discrepancies, resp.Report.Totals.Difference)
+	for _, file := range resp.Files {
+		fmt.Printf("file: %s\n", file)
+	}
+
+	if !resp.Report.Balanced {
+		pool.Close()
+		os.Exit(1)
+	}
+}
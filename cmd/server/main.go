@@ -11,9 +11,16 @@ import (
 	"slot-machine/internal/application/usecase"
 	"slot-machine/internal/infrastructure/config"
 	"slot-machine/internal/infrastructure/db"
+	"slot-machine/internal/infrastructure/eventbus"
 	"slot-machine/internal/infrastructure/jwt"
+	"slot-machine/internal/infrastructure/notifier"
+	"slot-machine/internal/infrastructure/publisher"
+	"slot-machine/internal/infrastructure/report"
 	repository_postgres "slot-machine/internal/infrastructure/repository/postgres"
+	"slot-machine/internal/infrastructure/scheduler"
 	"slot-machine/internal/infrastructure/security"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -33,12 +40,13 @@ import (
 
 // @securityDefinitions.apikey AdminAuth
 // @in header
-// @name X-Admin-Secret
+// @name X-API-Key
 func main() {
 	config.LoadEnv()
 	secretKey := config.GetRequiredEnv("JWT_SECRET")
 	accTokenDuration := 15 * time.Minute
 	refreshTokenDuration := 72 * time.Hour
+	passwordResetTokenDuration := 30 * time.Minute
 
 	dataseUrl := config.GetRequiredEnv("DATABASE_URL")
 
@@ -62,25 +70,238 @@ func main() {
 	slotRepo := repository_postgres.NewPostgresSlotMachineRepository(
 		pool,
 	)
+	loginAttemptRepo := repository_postgres.NewPostgresLoginAttemptRepository(
+		pool,
+	)
+	actionTokenRepo := repository_postgres.NewPostgresActionTokenRepository(
+		pool,
+	)
+	recoveryCodeRepo := repository_postgres.NewPostgresRecoveryCodeRepository(
+		pool,
+	)
+	apiKeyRepo := repository_postgres.NewPostgresAPIKeyRepository(
+		pool,
+	)
+	auditRepo := repository_postgres.NewPostgresAuditRepository(
+		pool,
+	)
+	transactionRepo := repository_postgres.NewPostgresTransactionRepository(
+		pool,
+	)
+	gamblingLimitRepo := repository_postgres.NewPostgresGamblingLimitRepository(
+		pool,
+	)
+	playSessionRepo := repository_postgres.NewPostgresPlaySessionRepository(
+		pool,
+	)
+	selfExclusionRepo := repository_postgres.NewPostgresSelfExclusionRepository(
+		pool,
+	)
+	adjustmentRepo := repository_postgres.NewPostgresAdjustmentRepository(
+		pool,
+	)
+	transactor := repository_postgres.NewPostgresTransactor(
+		pool,
+	)
+	treasuryRepo := repository_postgres.NewPostgresTreasuryRepository(
+		pool,
+	)
+	spinRepo := repository_postgres.NewPostgresSpinRepository(
+		pool,
+	)
+	jackpotRepo := repository_postgres.NewPostgresJackpotRepository(
+		pool,
+	)
+	freeSpinRepo := repository_postgres.NewPostgresFreeSpinRepository(
+		pool,
+	)
+	reconciliationRepo := repository_postgres.NewPostgresReconciliationRepository(
+		pool,
+	)
+	outboxRepo := repository_postgres.NewPostgresOutboxRepository(
+		pool,
+	)
+	webhookRepo := repository_postgres.NewPostgresWebhookRepository(
+		pool,
+	)
+	tournamentRepo := repository_postgres.NewPostgresTournamentRepository(
+		pool,
+	)
 
 	hasher := security.NewBcryptPasswordHasher(bcrypt.DefaultCost)
 	jwtManager := jwt.NewJWTManager(secretKey, accTokenDuration, refreshTokenDuration)
+	totpProvider := security.NewTOTPGenerator("Slot Machine")
+
+	passwordMinLength := 8
+	if value := config.GetEnv("PASSWORD_MIN_LENGTH"); value != "" {
+		passwordMinLength, err = strconv.Atoi(value)
+		if err != nil {
+			log.Fatalf("PASSWORD_MIN_LENGTH inválido: %v", err)
+		}
+	}
 
-	playUC := usecase.NewPlayUseCase(playerRepo, slotRepo)
-	createPlayerUC := usecase.NewCreatePlayerUseCase(playerRepo, hasher)
-	createSlotMachineUC := usecase.NewCreateSlotMachineUseCase(slotRepo)
+	var breachedPasswords []string
+	if path := config.GetEnv("BREACHED_PASSWORDS_FILE"); path != "" {
+		breachedPasswords, err = security.LoadBreachedPasswords(path)
+		if err != nil {
+			log.Fatalf("Falha ao carregar lista de senhas vazadas: %v", err)
+		}
+	}
+	passwordPolicy := security.NewPasswordPolicy(passwordMinLength, 0, breachedPasswords)
+
+	machineFloatPolicy := usecase.DefaultMachineFloatPolicy()
+	if value := config.GetEnv("MACHINE_TRANSFER_LIMIT"); value != "" {
+		machineFloatPolicy.MaxTransfer, err = strconv.Atoi(value)
+		if err != nil {
+			log.Fatalf("MACHINE_TRANSFER_LIMIT inválido: %v", err)
+		}
+	}
+	if value := config.GetEnv("MACHINE_MIN_FLOAT"); value != "" {
+		machineFloatPolicy.MinFloat, err = strconv.Atoi(value)
+		if err != nil {
+			log.Fatalf("MACHINE_MIN_FLOAT inválido: %v", err)
+		}
+	}
+
+	playerNotifier := notifier.NewLogNotifier(logger)
+	if path := config.GetEnv("NOTIFIER_FILE_PATH"); path != "" {
+		playerNotifier = notifier.NewFileNotifier(path)
+	}
+
+	eventBus := eventbus.NewInMemoryEventBus()
+
+	playUC := usecase.NewPlayUseCase(playerRepo, slotRepo, transactionRepo, gamblingLimitRepo, playSessionRepo, selfExclusionRepo, spinRepo, jackpotRepo, freeSpinRepo, outboxRepo, transactor)
+	if value := config.GetEnv("NEAR_MISS_JURISDICTIONS"); value != "" {
+		playUC.NearMiss.Jurisdictions = strings.Split(value, ",")
+	}
+	playUC.Events = eventBus
+	playUC.TournamentRepo = tournamentRepo
+	playBatchUC := usecase.NewPlayBatchUseCase(playUC)
+	createPlayerUC := usecase.NewCreatePlayerUseCase(playerRepo, hasher, passwordPolicy, actionTokenRepo, playerNotifier, outboxRepo, transactor)
+	createSlotMachineUC := usecase.NewCreateSlotMachineUseCase(slotRepo, auditRepo, outboxRepo, transactor)
 	getPlayerBalanceUC := usecase.NewGetPlayerBalanceUseCase(playerRepo)
-	getSlotMachineBalanceUC := usecase.NewGetSlotMachineBalanceUseCase(slotRepo)
-	loginUC := usecase.NewLoginUseCase(playerRepo, refreshRepo, hasher, jwtManager)
+	getSlotMachineBalanceUC := usecase.NewGetSlotMachineBalanceUseCase(slotRepo, auditRepo)
+	loginUC := usecase.NewLoginUseCase(playerRepo, refreshRepo, loginAttemptRepo, selfExclusionRepo, hasher, jwtManager)
 	refreshUC := usecase.NewRefreshTokenUseCase(jwtManager, refreshRepo)
+	changePasswordUC := usecase.NewChangePasswordUseCase(playerRepo, refreshRepo, hasher, passwordPolicy, jwtManager)
+	requestPasswordResetUC := usecase.NewRequestPasswordResetUseCase(playerRepo, actionTokenRepo, playerNotifier, passwordResetTokenDuration)
+	resetPasswordUC := usecase.NewResetPasswordUseCase(playerRepo, actionTokenRepo, refreshRepo, hasher, passwordPolicy, transactor)
+	verifyEmailUC := usecase.NewVerifyEmailUseCase(playerRepo, actionTokenRepo)
+	sendEmailVerificationUC := usecase.NewSendEmailVerificationUseCase(playerRepo, actionTokenRepo, playerNotifier)
+	verifyMFAUC := usecase.NewVerifyMFAUseCase(playerRepo, refreshRepo, recoveryCodeRepo, loginAttemptRepo, jwtManager, totpProvider)
+	enrollTOTPUC := usecase.NewEnrollTOTPUseCase(playerRepo, jwtManager, totpProvider)
+	confirmTOTPUC := usecase.NewConfirmTOTPUseCase(playerRepo, refreshRepo, recoveryCodeRepo, loginAttemptRepo, jwtManager, totpProvider)
+	disableTOTPUC := usecase.NewDisableTOTPUseCase(playerRepo, recoveryCodeRepo, hasher, totpProvider)
+	createAPIKeyUC := usecase.NewCreateAPIKeyUseCase(apiKeyRepo, auditRepo, transactor)
+	listAPIKeysUC := usecase.NewListAPIKeysUseCase(apiKeyRepo, auditRepo)
+	revokeAPIKeyUC := usecase.NewRevokeAPIKeyUseCase(apiKeyRepo, auditRepo, transactor)
+	listAuditEntriesUC := usecase.NewListAuditEntriesUseCase(auditRepo)
+	depositUC := usecase.NewDepositUseCase(playerRepo, transactionRepo, gamblingLimitRepo, selfExclusionRepo, outboxRepo, transactor)
+	depositUC.Events = eventBus
+	setGamblingLimitUC := usecase.NewSetGamblingLimitUseCase(gamblingLimitRepo)
+	getGamblingLimitsUC := usecase.NewGetGamblingLimitsUseCase(gamblingLimitRepo)
+	selfExcludeUC := usecase.NewSelfExcludeUseCase(selfExclusionRepo, refreshRepo)
+	getSelfExclusionUC := usecase.NewGetSelfExclusionUseCase(selfExclusionRepo)
+	liftSelfExclusionUC := usecase.NewLiftSelfExclusionUseCase(selfExclusionRepo, auditRepo, transactor)
+	setRealityCheckUC := usecase.NewSetRealityCheckUseCase(playerRepo)
+	acknowledgeRealityCheckUC := usecase.NewAcknowledgeRealityCheckUseCase(playSessionRepo)
+	listPlayersUC := usecase.NewListPlayersUseCase(playerRepo, auditRepo)
+	getPlayerDetailsUC := usecase.NewGetPlayerDetailsUseCase(playerRepo, transactionRepo, playSessionRepo, auditRepo)
+	blockPlayerUC := usecase.NewBlockPlayerUseCase(playerRepo, refreshRepo, auditRepo, outboxRepo, transactor)
+	unblockPlayerUC := usecase.NewUnblockPlayerUseCase(playerRepo, auditRepo, transactor)
+	proposeAdjustmentUC := usecase.NewProposeAdjustmentUseCase(adjustmentRepo, playerRepo, slotRepo, apiKeyRepo, auditRepo, transactor)
+	approveAdjustmentUC := usecase.NewApproveAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, playerRepo, outboxRepo, transactor)
+	approveAdjustmentUC.Events = eventBus
+	rejectAdjustmentUC := usecase.NewRejectAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, transactor)
+	listAdjustmentsUC := usecase.NewListAdjustmentsUseCase(adjustmentRepo, auditRepo)
+	refillSlotMachineUC := usecase.NewRefillSlotMachineUseCase(treasuryRepo, slotRepo, auditRepo, transactor)
+	refillSlotMachineUC.Policy = machineFloatPolicy
+	cashoutSlotMachineUC := usecase.NewCashoutSlotMachineUseCase(treasuryRepo, slotRepo, auditRepo, transactor)
+	cashoutSlotMachineUC.Policy = machineFloatPolicy
+	getTreasuryUC := usecase.NewGetTreasuryUseCase(treasuryRepo, auditRepo)
+	getSlotMachineStatsUC := usecase.NewGetSlotMachineStatsUseCase(slotRepo, spinRepo, auditRepo)
+	getPlayerProfileUC := usecase.NewGetPlayerProfileUseCase(playerRepo, spinRepo, playSessionRepo)
+	createJackpotPoolUC := usecase.NewCreateJackpotPoolUseCase(jackpotRepo, auditRepo, transactor)
+	createJackpotPoolUC.Events = eventBus
+	linkJackpotMachineUC := usecase.NewLinkJackpotMachineUseCase(jackpotRepo, slotRepo, auditRepo, transactor)
+	unlinkJackpotMachineUC := usecase.NewUnlinkJackpotMachineUseCase(jackpotRepo, auditRepo, transactor)
+	listJackpotsUC := usecase.NewListJackpotsUseCase(jackpotRepo)
+	createWebhookUC := usecase.NewCreateWebhookUseCase(webhookRepo, auditRepo, transactor)
+	listWebhooksUC := usecase.NewListWebhooksUseCase(webhookRepo, auditRepo)
+	deleteWebhookUC := usecase.NewDeleteWebhookUseCase(webhookRepo, auditRepo, transactor)
+	listWebhookDeliveriesUC := usecase.NewListWebhookDeliveriesUseCase(webhookRepo, auditRepo)
+	retryWebhookDeliveryUC := usecase.NewRetryWebhookDeliveryUseCase(webhookRepo, auditRepo, transactor)
+	createTournamentUC := usecase.NewCreateTournamentUseCase(tournamentRepo, slotRepo, auditRepo, transactor)
+	listTournamentsUC := usecase.NewListTournamentsUseCase(tournamentRepo)
+	joinTournamentUC := usecase.NewJoinTournamentUseCase(tournamentRepo, playerRepo, transactionRepo, treasuryRepo, gamblingLimitRepo, selfExclusionRepo, outboxRepo, transactor)
+	joinTournamentUC.Events = eventBus
+	getLeaderboardUC := usecase.NewGetLeaderboardUseCase(tournamentRepo)
+	authenticateAPIKeyUC := usecase.NewAuthenticateAPIKeyUseCase(apiKeyRepo)
 
-	handler := handler.NewHandler(createPlayerUC, createSlotMachineUC, playUC, getPlayerBalanceUC, getSlotMachineBalanceUC, loginUC, refreshUC)
+	handler := handler.NewHandler(
+		createPlayerUC,
+		createSlotMachineUC,
+		playUC,
+		getPlayerBalanceUC,
+		getSlotMachineBalanceUC,
+		loginUC,
+		refreshUC,
+		changePasswordUC,
+		requestPasswordResetUC,
+		resetPasswordUC,
+		verifyEmailUC,
+		sendEmailVerificationUC,
+		verifyMFAUC,
+		enrollTOTPUC,
+		confirmTOTPUC,
+		disableTOTPUC,
+		createAPIKeyUC,
+		listAPIKeysUC,
+		revokeAPIKeyUC,
+		listAuditEntriesUC,
+		depositUC,
+		setGamblingLimitUC,
+		getGamblingLimitsUC,
+		selfExcludeUC,
+		getSelfExclusionUC,
+		liftSelfExclusionUC,
+		setRealityCheckUC,
+		acknowledgeRealityCheckUC,
+		listPlayersUC,
+		getPlayerDetailsUC,
+		blockPlayerUC,
+		unblockPlayerUC,
+		proposeAdjustmentUC,
+		approveAdjustmentUC,
+		rejectAdjustmentUC,
+		listAdjustmentsUC,
+		refillSlotMachineUC,
+		cashoutSlotMachineUC,
+		getTreasuryUC,
+		getSlotMachineStatsUC,
+		getPlayerProfileUC,
+		createJackpotPoolUC,
+		linkJackpotMachineUC,
+		unlinkJackpotMachineUC,
+		listJackpotsUC,
+		playBatchUC,
+		eventBus,
+		createWebhookUC,
+		listWebhooksUC,
+		deleteWebhookUC,
+		listWebhookDeliveriesUC,
+		retryWebhookDeliveryUC,
+		createTournamentUC,
+		listTournamentsUC,
+		joinTournamentUC,
+		getLeaderboardUC,
+	)
 
-	router := httpInternal.NewRouter(handler, jwtManager)
+	router := httpInternal.NewRouter(handler, jwtManager, playerRepo, authenticateAPIKeyUC)
 
 	corsAllowedOrigins := []string{config.GetRequiredEnv("CORS_ALLOWED_ORIGINS")}
 	corsAllowedMethods := []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	corsAllowedHeaders := []string{"Content-Type", "Authorization"}
+	corsAllowedHeaders := []string{"Content-Type", "Authorization", "X-API-Key", "X-Request-ID"}
 
 	corsMiddleware := handlers.CORS(
 		handlers.AllowedOrigins(corsAllowedOrigins),
@@ -105,6 +326,139 @@ func main() {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Conciliação diária em segundo plano. Com várias instâncias do
+	// servidor, habilite em apenas uma ou use o cmd/reconcile.
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if dir := config.GetEnv("RECONCILIATION_REPORT_DIR"); dir != "" {
+		reconciliationTime := "00:05"
+		if value := config.GetEnv("RECONCILIATION_TIME"); value != "" {
+			reconciliationTime = value
+		}
+		at, err := scheduler.ParseTimeOfDay(reconciliationTime)
+		if err != nil {
+			log.Fatalf("RECONCILIATION_TIME inválido: %v", err)
+		}
+
+		reportWriter := report.NewFileReportWriter(dir, []byte(config.GetRequiredEnv("RECONCILIATION_SIGNING_KEY")))
+		runReconciliationUC := usecase.NewRunReconciliationUseCase(reconciliationRepo, reportWriter)
+		go scheduler.RunDaily(jobsCtx, at, func(ctx context.Context) {
+			resp, err := runReconciliationUC.Execute(ctx)
+			if err != nil {
+				logger.Errorf("Falha na conciliação diária: %v", err)
+				return
+			}
+			entry := logger.WithFields(logrus.Fields{
+				"report_id":     resp.Report.ID,
+				"discrepancies": resp.Report.Discrepancies,
+				"difference":    resp.Report.Totals.Difference,
+				"files":         resp.Files,
+			})
+			if !resp.Report.Balanced {
+				entry.Warn("Conciliação diária encontrou divergências")
+				return
+			}
+			entry.Info("Conciliação diária concluída")
+		})
+	}
+
+	// Relay do outbox: entrega os eventos de domínio ao webhook e ao arquivo
+	// configurados, ou à saída padrão, e gera as entregas das assinaturas de
+	// webhook. Com várias instâncias, um evento pode ser entregue mais de uma
+	// vez; os destinos descartam pelo ID.
+	var outboxPublishers []usecase.OutboxPublisher
+	if url := config.GetEnv("OUTBOX_WEBHOOK_URL"); url != "" {
+		outboxPublishers = append(outboxPublishers, usecase.OutboxPublisher{Name: "webhook", Publisher: publisher.NewWebhookPublisher(url, &http.Client{Timeout: 10 * time.Second})})
+	}
+	if path := config.GetEnv("OUTBOX_FILE_PATH"); path != "" {
+		outboxPublishers = append(outboxPublishers, usecase.OutboxPublisher{Name: "file", Publisher: publisher.NewFilePublisher(path)})
+	}
+	if len(outboxPublishers) == 0 {
+		outboxPublishers = append(outboxPublishers, usecase.OutboxPublisher{Name: "stdout", Publisher: publisher.NewStreamPublisher(os.Stdout)})
+	}
+	outboxRelayInterval := 5 * time.Second
+	if value := config.GetEnv("OUTBOX_RELAY_INTERVAL"); value != "" {
+		outboxRelayInterval, err = time.ParseDuration(value)
+		if err != nil || outboxRelayInterval <= 0 {
+			log.Fatalf("OUTBOX_RELAY_INTERVAL inválido: %s", value)
+		}
+	}
+	outboxPublishers = append(outboxPublishers, usecase.OutboxPublisher{Name: "webhook_subscriptions", Publisher: usecase.NewWebhookFanout(webhookRepo)})
+	relayOutboxUC := usecase.NewRelayOutboxUseCase(outboxRepo, outboxPublishers...)
+	if value := config.GetEnv("OUTBOX_MAX_ATTEMPTS"); value != "" {
+		relayOutboxUC.MaxAttempts, err = strconv.Atoi(value)
+		if err != nil || relayOutboxUC.MaxAttempts < 1 {
+			log.Fatalf("OUTBOX_MAX_ATTEMPTS inválido: %s", value)
+		}
+	}
+	go scheduler.RunEvery(jobsCtx, outboxRelayInterval, func(ctx context.Context) {
+		resp, err := relayOutboxUC.Execute(ctx)
+		if err != nil {
+			logger.Errorf("Falha ao ler o outbox: %v", err)
+			return
+		}
+		if resp.Failed != "" {
+			logger.WithFields(logrus.Fields{
+				"event_id":      resp.Failed,
+				"published":     resp.Published,
+				"dead_lettered": resp.DeadLettered,
+			}).Warnf("Falha ao publicar evento de domínio: %s", resp.Error)
+		}
+	})
+
+	webhookDeliveryInterval := 5 * time.Second
+	if value := config.GetEnv("WEBHOOK_DELIVERY_INTERVAL"); value != "" {
+		webhookDeliveryInterval, err = time.ParseDuration(value)
+		if err != nil || webhookDeliveryInterval <= 0 {
+			log.Fatalf("WEBHOOK_DELIVERY_INTERVAL inválido: %s", value)
+		}
+	}
+	deliverWebhooksUC := usecase.NewDeliverWebhooksUseCase(webhookRepo, publisher.NewHTTPWebhookSender(&http.Client{Timeout: 10 * time.Second}))
+	if value := config.GetEnv("WEBHOOK_MAX_ATTEMPTS"); value != "" {
+		deliverWebhooksUC.MaxAttempts, err = strconv.Atoi(value)
+		if err != nil || deliverWebhooksUC.MaxAttempts < 1 {
+			log.Fatalf("WEBHOOK_MAX_ATTEMPTS inválido: %s", value)
+		}
+	}
+	go scheduler.RunEvery(jobsCtx, webhookDeliveryInterval, func(ctx context.Context) {
+		resp, err := deliverWebhooksUC.Execute(ctx)
+		if err != nil {
+			logger.Errorf("Falha nas entregas de webhook: %v", err)
+			return
+		}
+		if resp.DeadLettered > 0 {
+			logger.WithField("dead_lettered", resp.DeadLettered).Warn("Entregas de webhook esgotaram as tentativas")
+		}
+	})
+
+	// Encerramento dos torneios: paga os prêmios assim que cada torneio
+	// termina. Instâncias concorrentes não pagam duas vezes, já que só uma
+	// consegue marcar o torneio como encerrado.
+	tournamentSettleInterval := time.Minute
+	if value := config.GetEnv("TOURNAMENT_SETTLE_INTERVAL"); value != "" {
+		tournamentSettleInterval, err = time.ParseDuration(value)
+		if err != nil || tournamentSettleInterval <= 0 {
+			log.Fatalf("TOURNAMENT_SETTLE_INTERVAL inválido: %s", value)
+		}
+	}
+	settleTournamentsUC := usecase.NewSettleTournamentsUseCase(tournamentRepo, playerRepo, transactionRepo, treasuryRepo, outboxRepo, transactor)
+	settleTournamentsUC.Events = eventBus
+	go scheduler.RunEvery(jobsCtx, tournamentSettleInterval, func(ctx context.Context) {
+		resp, err := settleTournamentsUC.Execute(ctx)
+		if err != nil {
+			logger.Errorf("Falha ao encerrar torneios: %v", err)
+		}
+		if resp != nil && len(resp.Unfunded) > 0 {
+			logger.WithField("tournaments", resp.Unfunded).Warn("Tesouraria sem saldo para os prêmios dos torneios")
+		}
+		if resp != nil && resp.Settled > 0 {
+			logger.WithFields(logrus.Fields{
+				"settled":     resp.Settled,
+				"prizes_paid": resp.PrizesPaid,
+			}).Info("Torneios encerrados")
+		}
+	})
+
 	// Canal para capturar erros do servidor
 	serverErrors := make(chan error, 1)
 
@@ -126,6 +480,7 @@ func main() {
 		}
 	case sig := <-sigChan:
 		logger.Infof("Recebido sinal %v, iniciando shutdown", sig)
+		stopJobs()
 
 		// Cria um contexto com timeout para o shutdown
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
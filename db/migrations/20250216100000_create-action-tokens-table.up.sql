@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS action_tokens (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    purpose VARCHAR(50) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS action_tokens_player_purpose_idx ON action_tokens (player_id, purpose);
@@ -0,0 +1,4 @@
+ALTER TABLE players DROP COLUMN IF EXISTS email_verified;
+
+DROP INDEX IF EXISTS players_email_normalized_idx;
+ALTER TABLE players ADD CONSTRAINT players_email_key UNIQUE (email);
@@ -0,0 +1,31 @@
+-- Contas cujo email só difere em maiúsculas ou espaços colidiriam no índice
+-- abaixo. Elas não são mescladas automaticamente, porque cada uma tem saldo e
+-- histórico próprios: a migração falha listando os grupos para que sejam
+-- resolvidas manualmente antes de rodá-la de novo.
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(normalized || ' (' || ids || ')', '; ' ORDER BY normalized)
+    INTO duplicates
+    FROM (
+        SELECT LOWER(TRIM(email)) AS normalized, string_agg(id, ', ' ORDER BY id) AS ids
+        FROM players
+        GROUP BY LOWER(TRIM(email))
+        HAVING COUNT(*) > 1
+    ) AS groups;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'players with duplicate emails after normalization: %', duplicates;
+    END IF;
+END;
+$$;
+
+UPDATE players SET email = LOWER(TRIM(email));
+
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS players_email_normalized_idx ON players (LOWER(email));
+
+-- Jogadores cadastrados antes da verificação de email são considerados verificados.
+ALTER TABLE players ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE players SET email_verified = TRUE;
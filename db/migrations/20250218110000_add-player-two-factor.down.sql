@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS recovery_codes;
+
+ALTER TABLE players DROP COLUMN IF EXISTS totp_last_counter;
+ALTER TABLE players DROP COLUMN IF EXISTS totp_secret;
+ALTER TABLE players DROP COLUMN IF EXISTS totp_enabled;
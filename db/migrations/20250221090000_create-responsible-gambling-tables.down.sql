@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS play_sessions;
+DROP TABLE IF EXISTS gambling_limits;
+DROP TABLE IF EXISTS transactions;
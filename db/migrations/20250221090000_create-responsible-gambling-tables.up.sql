@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS transactions (
+    id VARCHAR(36) PRIMARY KEY,
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    machine_id VARCHAR(36) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS transactions_player_type_created_at_idx ON transactions (player_id, type, created_at);
+
+CREATE TABLE IF NOT EXISTS gambling_limits (
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    period VARCHAR(20) NOT NULL DEFAULT '',
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    pending_amount INTEGER,
+    pending_effective_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (player_id, type, period)
+);
+
+CREATE TABLE IF NOT EXISTS play_sessions (
+    id VARCHAR(36) PRIMARY KEY,
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    started_at TIMESTAMPTZ NOT NULL,
+    last_activity_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS play_sessions_player_started_at_idx ON play_sessions (player_id, started_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS self_exclusions;
+DROP FUNCTION IF EXISTS self_exclusions_keep_permanent();
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS self_exclusions (
+    player_id VARCHAR(36) PRIMARY KEY REFERENCES players(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ,
+    lifted_at TIMESTAMPTZ,
+    lifted_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+-- Exclusões permanentes nunca podem ser encerradas.
+CREATE OR REPLACE FUNCTION self_exclusions_keep_permanent() RETURNS trigger AS $$
+BEGIN
+    IF OLD.ends_at IS NULL AND OLD.lifted_at IS NULL
+        AND (NEW.ends_at IS NOT NULL OR NEW.lifted_at IS NOT NULL) THEN
+        RAISE EXCEPTION 'permanent self exclusion cannot be lifted';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER self_exclusions_keep_permanent
+    BEFORE UPDATE ON self_exclusions
+    FOR EACH ROW EXECUTE FUNCTION self_exclusions_keep_permanent();
@@ -0,0 +1,7 @@
+ALTER TABLE play_sessions DROP COLUMN IF EXISTS reality_check_pending;
+ALTER TABLE play_sessions DROP COLUMN IF EXISTS last_reality_check_at;
+ALTER TABLE play_sessions DROP COLUMN IF EXISTS won;
+ALTER TABLE play_sessions DROP COLUMN IF EXISTS wagered;
+ALTER TABLE play_sessions DROP COLUMN IF EXISTS spins;
+
+ALTER TABLE players DROP COLUMN IF EXISTS reality_check_minutes;
@@ -0,0 +1,7 @@
+ALTER TABLE players ADD COLUMN IF NOT EXISTS reality_check_minutes INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE play_sessions ADD COLUMN IF NOT EXISTS spins INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE play_sessions ADD COLUMN IF NOT EXISTS wagered INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE play_sessions ADD COLUMN IF NOT EXISTS won INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE play_sessions ADD COLUMN IF NOT EXISTS last_reality_check_at TIMESTAMPTZ;
+ALTER TABLE play_sessions ADD COLUMN IF NOT EXISTS reality_check_pending BOOLEAN NOT NULL DEFAULT FALSE;
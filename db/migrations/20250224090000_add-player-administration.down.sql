@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS transactions_player_created_at_idx;
+DROP INDEX IF EXISTS players_balance_idx;
+DROP INDEX IF EXISTS players_created_at_idx;
+
+ALTER TABLE players DROP COLUMN IF EXISTS blocked_reason;
+ALTER TABLE players DROP COLUMN IF EXISTS blocked;
+ALTER TABLE players DROP COLUMN IF EXISTS created_at;
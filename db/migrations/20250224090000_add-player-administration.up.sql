@@ -0,0 +1,7 @@
+ALTER TABLE players ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE players ADD COLUMN IF NOT EXISTS blocked BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE players ADD COLUMN IF NOT EXISTS blocked_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS players_created_at_idx ON players (created_at);
+CREATE INDEX IF NOT EXISTS players_balance_idx ON players (balance);
+CREATE INDEX IF NOT EXISTS transactions_player_created_at_idx ON transactions (player_id, created_at DESC);
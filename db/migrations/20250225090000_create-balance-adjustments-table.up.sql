@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS balance_adjustments (
+    id VARCHAR(36) PRIMARY KEY,
+    target_type VARCHAR(20) NOT NULL,
+    target_id VARCHAR(36) NOT NULL,
+    amount INTEGER NOT NULL CHECK (amount <> 0),
+    reason_code VARCHAR(40) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    proposed_by VARCHAR(100) NOT NULL,
+    proposed_at TIMESTAMPTZ NOT NULL,
+    reviewed_by VARCHAR(100) NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    -- Quatro olhos: quem aprova ou rejeita nunca é quem propôs.
+    CHECK (reviewed_by = '' OR reviewed_by <> proposed_by)
+);
+
+CREATE INDEX IF NOT EXISTS balance_adjustments_status_proposed_at_idx ON balance_adjustments (status, proposed_at);
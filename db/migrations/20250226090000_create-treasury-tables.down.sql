@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS treasury_movements;
+DROP TABLE IF EXISTS treasury;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS treasury (
+    id VARCHAR(20) PRIMARY KEY,
+    balance INTEGER NOT NULL CHECK (balance >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO treasury (id, balance) VALUES ('house', 0) ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS treasury_movements (
+    id VARCHAR(36) PRIMARY KEY,
+    type VARCHAR(20) NOT NULL,
+    machine_id VARCHAR(36) NOT NULL DEFAULT '',
+    amount INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    treasury_balance INTEGER NOT NULL,
+    machine_balance INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS treasury_movements_created_at_idx ON treasury_movements (created_at);
+CREATE INDEX IF NOT EXISTS treasury_movements_machine_idx ON treasury_movements (machine_id, created_at);
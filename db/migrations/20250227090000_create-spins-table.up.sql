@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS spins (
+    id VARCHAR(36) PRIMARY KEY,
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    machine_id VARCHAR(36) NOT NULL REFERENCES slot_machines(id) ON DELETE CASCADE,
+    bet INTEGER NOT NULL CHECK (bet >= 0),
+    payout INTEGER NOT NULL CHECK (payout >= 0),
+    result TEXT[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS spins_machine_created_at_idx ON spins (machine_id, created_at) INCLUDE (player_id, bet, payout);
+CREATE INDEX IF NOT EXISTS spins_player_created_at_idx ON spins (player_id, created_at);
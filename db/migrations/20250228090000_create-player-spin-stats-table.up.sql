@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS player_spin_stats (
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    machine_id VARCHAR(36) NOT NULL REFERENCES slot_machines(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    spins INTEGER NOT NULL DEFAULT 0,
+    total_wagered BIGINT NOT NULL DEFAULT 0,
+    total_won BIGINT NOT NULL DEFAULT 0,
+    biggest_win INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (player_id, machine_id, day)
+);
+
+INSERT INTO player_spin_stats (player_id, machine_id, day, spins, total_wagered, total_won, biggest_win)
+SELECT player_id, machine_id, (created_at AT TIME ZONE 'UTC')::date, COUNT(*), SUM(bet), SUM(payout), MAX(payout)
+FROM spins
+GROUP BY player_id, machine_id, (created_at AT TIME ZONE 'UTC')::date
+ON CONFLICT (player_id, machine_id, day) DO NOTHING;
@@ -0,0 +1,10 @@
+ALTER TABLE spins
+    DROP COLUMN IF EXISTS jackpot_win,
+    DROP COLUMN IF EXISTS jackpot_contribution,
+    DROP COLUMN IF EXISTS jackpot_pool_id;
+
+ALTER TABLE treasury_movements DROP COLUMN IF EXISTS jackpot_pool_id;
+
+DROP TABLE IF EXISTS jackpot_wins;
+DROP TABLE IF EXISTS jackpot_pool_machines;
+DROP TABLE IF EXISTS jackpot_pools;
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS jackpot_pools (
+    id VARCHAR(36) PRIMARY KEY,
+    name TEXT NOT NULL,
+    seed_amount INTEGER NOT NULL CHECK (seed_amount >= 0),
+    contribution_percent NUMERIC(5, 2) NOT NULL CHECK (contribution_percent > 0 AND contribution_percent <= 100),
+    trigger_combination TEXT[] NOT NULL DEFAULT '{}',
+    trigger_odds INTEGER NOT NULL DEFAULT 0 CHECK (trigger_odds >= 0),
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    total_seeded BIGINT NOT NULL DEFAULT 0,
+    total_contributed BIGINT NOT NULL DEFAULT 0,
+    total_paid BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS jackpot_pool_machines (
+    machine_id VARCHAR(36) PRIMARY KEY REFERENCES slot_machines(id) ON DELETE CASCADE,
+    pool_id VARCHAR(36) NOT NULL REFERENCES jackpot_pools(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS jackpot_pool_machines_pool_id_idx ON jackpot_pool_machines (pool_id);
+
+CREATE TABLE IF NOT EXISTS jackpot_wins (
+    id VARCHAR(36) PRIMARY KEY,
+    pool_id VARCHAR(36) NOT NULL REFERENCES jackpot_pools(id),
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id),
+    machine_id VARCHAR(36) NOT NULL,
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS jackpot_wins_created_at_idx ON jackpot_wins (created_at);
+
+ALTER TABLE treasury_movements ADD COLUMN IF NOT EXISTS jackpot_pool_id VARCHAR(36) NOT NULL DEFAULT '';
+
+ALTER TABLE spins
+    ADD COLUMN IF NOT EXISTS jackpot_pool_id VARCHAR(36) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS jackpot_contribution INTEGER NOT NULL DEFAULT 0 CHECK (jackpot_contribution >= 0),
+    ADD COLUMN IF NOT EXISTS jackpot_win INTEGER NOT NULL DEFAULT 0 CHECK (jackpot_win >= 0);
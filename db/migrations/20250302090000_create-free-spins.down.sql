@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS player_free_spins;
+
+ALTER TABLE slot_machines DROP COLUMN IF EXISTS bonus;
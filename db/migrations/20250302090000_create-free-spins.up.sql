@@ -0,0 +1,13 @@
+ALTER TABLE slot_machines ADD COLUMN IF NOT EXISTS bonus JSONB;
+
+CREATE TABLE IF NOT EXISTS player_free_spins (
+    player_id VARCHAR(36) NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    machine_id VARCHAR(36) NOT NULL REFERENCES slot_machines(id),
+    remaining INTEGER NOT NULL CHECK (remaining >= 0),
+    multiplier INTEGER NOT NULL,
+    bet INTEGER NOT NULL,
+    awarded INTEGER NOT NULL,
+    total_won INTEGER NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (player_id, machine_id)
+);
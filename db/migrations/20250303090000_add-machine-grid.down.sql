@@ -0,0 +1,7 @@
+ALTER TABLE player_free_spins DROP COLUMN IF EXISTS lines;
+
+ALTER TABLE slot_machines
+    DROP COLUMN IF EXISTS paytable,
+    DROP COLUMN IF EXISTS paylines,
+    DROP COLUMN IF EXISTS grid_rows,
+    DROP COLUMN IF EXISTS reels;
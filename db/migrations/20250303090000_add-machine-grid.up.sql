@@ -0,0 +1,7 @@
+ALTER TABLE slot_machines
+    ADD COLUMN IF NOT EXISTS reels INTEGER NOT NULL DEFAULT 3,
+    ADD COLUMN IF NOT EXISTS grid_rows INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS paylines JSONB,
+    ADD COLUMN IF NOT EXISTS paytable JSONB;
+
+ALTER TABLE player_free_spins ADD COLUMN IF NOT EXISTS lines INTEGER NOT NULL DEFAULT 1;
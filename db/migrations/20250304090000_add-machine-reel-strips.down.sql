@@ -0,0 +1,3 @@
+ALTER TABLE slot_machines
+    DROP COLUMN IF EXISTS aligned_weight,
+    DROP COLUMN IF EXISTS reel_strips;
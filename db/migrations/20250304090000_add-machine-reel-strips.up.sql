@@ -0,0 +1,4 @@
+-- Máquinas sem fitas gravadas usam as fitas equivalentes ao level.
+ALTER TABLE slot_machines
+    ADD COLUMN IF NOT EXISTS reel_strips JSONB,
+    ADD COLUMN IF NOT EXISTS aligned_weight INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+ALTER TABLE slot_machines
+    DROP COLUMN IF EXISTS near_miss,
+    DROP COLUMN IF EXISTS jurisdiction;
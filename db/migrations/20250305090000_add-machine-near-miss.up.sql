@@ -0,0 +1,4 @@
+-- A apresentação de quase acertos fica desligada até ser configurada.
+ALTER TABLE slot_machines
+    ADD COLUMN IF NOT EXISTS jurisdiction TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS near_miss TEXT NOT NULL DEFAULT 'off';
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id VARCHAR(36) PRIMARY KEY,
+    type TEXT NOT NULL,
+    aggregate_type TEXT NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    seq BIGSERIAL NOT NULL
+);
+
+-- O relay só lê os eventos pendentes, na ordem em que foram gravados.
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (seq) WHERE published_at IS NULL;
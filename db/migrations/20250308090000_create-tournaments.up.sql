@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS tournaments (
+    id VARCHAR(36) PRIMARY KEY,
+    name TEXT NOT NULL,
+    machine_ids TEXT[] NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    entry_fee INTEGER NOT NULL DEFAULT 0,
+    guaranteed_prize INTEGER NOT NULL DEFAULT 0,
+    prize_distribution INTEGER[] NOT NULL,
+    scoring TEXT NOT NULL,
+    status TEXT NOT NULL,
+    entrants INTEGER NOT NULL DEFAULT 0,
+    created_by TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    settled_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_tournaments_open ON tournaments (ends_at) WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS tournament_entries (
+    tournament_id VARCHAR(36) NOT NULL REFERENCES tournaments (id) ON DELETE CASCADE,
+    player_id VARCHAR(36) NOT NULL,
+    score INTEGER NOT NULL DEFAULT 0,
+    spins INTEGER NOT NULL DEFAULT 0,
+    joined_at TIMESTAMPTZ NOT NULL,
+    scored_at TIMESTAMPTZ,
+    PRIMARY KEY (tournament_id, player_id)
+);
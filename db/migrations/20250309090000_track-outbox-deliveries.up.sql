@@ -0,0 +1,8 @@
+-- Cada publicador que aceitou o evento fica registrado, para que uma nova
+-- tentativa só vá aos que faltam. Eventos que esgotam as tentativas ganham
+-- dead_at e deixam de bloquear a fila.
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS delivered_to TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS dead_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (seq) WHERE published_at IS NULL AND dead_at IS NULL;
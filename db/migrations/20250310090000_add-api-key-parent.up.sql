@@ -0,0 +1,3 @@
+-- Chaves criadas com outra chave guardam a de origem, para que a revogação
+-- dela alcance as chaves derivadas.
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS parent_key_id VARCHAR(36);
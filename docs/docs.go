@@ -16,45 +16,53 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/login": {
-            "post": {
-                "description": "Autentica um usuário e retorna um token JWT.",
-                "consumes": [
-                    "application/json"
+        "/admin/adjustments": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Lista os ajustes de saldo, dos mais recentes para os mais antigos, opcionalmente filtrados por status.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Admin"
                 ],
-                "summary": "Login",
+                "summary": "Listar ajustes de saldo",
                 "parameters": [
                     {
-                        "description": "Dados de autenticação",
-                        "name": "loginRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/usecase.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "Status: pending, approved ou rejected",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Ajustes encontrados",
                         "schema": {
-                            "$ref": "#/definitions/usecase.LoginResponse"
+                            "$ref": "#/definitions/usecase.ListAdjustmentsResponse"
                         }
                     },
                     "400": {
-                        "description": "Requisição inválida",
+                        "description": "Status inválido",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
                     "401": {
-                        "description": "Credenciais inválidas",
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
@@ -66,16 +74,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/machines": {
+            },
             "post": {
                 "security": [
                     {
                         "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Permite a criação de uma nova máquina caça-níqueis com os parâmetros especificados.",
+                "description": "Propõe um crédito (valor positivo) ou débito (valor negativo) no saldo de um jogador ou máquina, com um código de motivo. O ajuste só é aplicado após a aprovação de outro administrador.",
                 "consumes": [
                     "application/json"
                 ],
@@ -83,29 +92,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SlotMachine"
+                    "Admin"
                 ],
-                "summary": "Criar uma nova máquina caça-níqueis",
+                "summary": "Propor ajuste de saldo",
                 "parameters": [
                     {
-                        "description": "Dados da máquina caça-níqueis a ser criada",
-                        "name": "createSlotMachineRequest",
+                        "description": "Dados do ajuste",
+                        "name": "proposeAdjustmentRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/usecase.CreateSlotMachineRequest"
+                            "$ref": "#/definitions/usecase.ProposeAdjustmentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Máquina criada com sucesso",
+                        "description": "Ajuste proposto",
                         "schema": {
-                            "$ref": "#/definitions/usecase.CreateSlotMachineResponse"
+                            "$ref": "#/definitions/model.BalanceAdjustment"
                         }
                     },
                     "400": {
-                        "description": "Payload inválido ou parâmetros inválidos",
+                        "description": "Payload inválido ou código de motivo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jogador ou máquina não encontrado",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
@@ -119,54 +146,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/machines/balance": {
-            "get": {
+        "/admin/adjustments/{id}/approve": {
+            "post": {
                 "security": [
                     {
                         "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Retorna o saldo da máquina caça-níqueis especificada.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Aprova o ajuste pendente e o aplica atomicamente ao saldo do alvo. O administrador que propôs o ajuste não pode aprová-lo.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "SlotMachine"
+                    "Admin"
                 ],
-                "summary": "Obter saldo da máquina caça-níqueis",
+                "summary": "Aprovar ajuste de saldo",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da máquina caça-níqueis",
-                        "name": "machine_id",
-                        "in": "query",
+                        "description": "ID do ajuste",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Saldo da máquina caça-níqueis",
+                        "description": "Ajuste aplicado",
                         "schema": {
-                            "$ref": "#/definitions/usecase.GetSlotMachineBalanceResponse"
+                            "$ref": "#/definitions/model.BalanceAdjustment"
                         }
                     },
-                    "400": {
-                        "description": "ID da máquina caça-níqueis é obrigatório",
+                    "401": {
+                        "description": "Não autorizado",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Não autorizado",
+                    "403": {
+                        "description": "Escopo insuficiente ou aprovação pelo próprio proponente",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
                     "404": {
-                        "description": "Máquina caça-níqueis não encontrada",
+                        "description": "Ajuste não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Ajuste já revisado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "O ajuste deixaria o saldo negativo",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
@@ -180,56 +219,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/play": {
+        "/admin/adjustments/{id}/reject": {
             "post": {
                 "security": [
+                    {
+                        "AdminAuth": []
+                    },
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Permite que o jogador faça uma aposta e jogue na máquina caça-níqueis especificada.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Rejeita o ajuste pendente sem alterar nenhum saldo. O administrador que propôs o ajuste não pode rejeitá-lo.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "SlotMachine"
+                    "Admin"
                 ],
-                "summary": "Jogar na máquina caça-níqueis",
+                "summary": "Rejeitar ajuste de saldo",
                 "parameters": [
                     {
-                        "description": "Dados da jogada",
-                        "name": "playRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/usecase.PlayRequest"
-                        }
+                        "type": "string",
+                        "description": "ID do ajuste",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Jogada realizada com sucesso",
+                        "description": "Ajuste rejeitado",
                         "schema": {
-                            "$ref": "#/definitions/usecase.PlayResponse"
+                            "$ref": "#/definitions/model.BalanceAdjustment"
                         }
                     },
-                    "400": {
-                        "description": "Payload inválido",
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente ou revisão pelo próprio proponente",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
                     "404": {
-                        "description": "Máquina caça-níqueis não encontrada",
+                        "description": "Ajuste não encontrado",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
-                    "422": {
-                        "description": "Saldo insuficiente",
+                    "409": {
+                        "description": "Ajuste já revisado",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
@@ -243,9 +286,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/players": {
+        "/admin/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as API keys cadastradas, incluindo as revogadas e expiradas. Os segredos nunca são retornados.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Listar API keys",
+                "responses": {
+                    "200": {
+                        "description": "API keys cadastradas",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListAPIKeysResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Permite a criação de um novo jogador com um saldo inicial.",
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma API key com nome, escopos e expiração opcional. A chave é exibida apenas nesta resposta; somente o hash é armazenado.",
                 "consumes": [
                     "application/json"
                 ],
@@ -253,35 +348,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Player"
+                    "Admin"
                 ],
-                "summary": "Criar um novo jogador",
+                "summary": "Criar API key",
                 "parameters": [
                     {
-                        "description": "Dados do jogador a ser criado",
-                        "name": "createPlayerRequest",
+                        "description": "Dados da API key",
+                        "name": "createAPIKeyRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/usecase.CreatePlayerRequest"
+                            "$ref": "#/definitions/usecase.CreateAPIKeyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Jogador criado com sucesso",
+                        "description": "API key criada",
                         "schema": {
-                            "$ref": "#/definitions/usecase.CreatePlayerResponse"
+                            "$ref": "#/definitions/usecase.CreateAPIKeyResponse"
                         }
                     },
                     "400": {
-                        "description": "Payload inválido",
+                        "description": "Payload inválido ou escopo inválido",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
-                    "409": {
-                        "description": "Jogador já existe",
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
@@ -295,30 +396,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/players/balance": {
-            "get": {
+        "/admin/api-keys/{id}": {
+            "delete": {
                 "security": [
+                    {
+                        "AdminAuth": []
+                    },
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna o saldo do jogador especificado.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Revoga a API key informada. Requisições posteriores com ela são rejeitadas.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Player"
+                    "Admin"
+                ],
+                "summary": "Revogar API key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da API key",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Obter saldo do jogador",
                 "responses": {
-                    "200": {
-                        "description": "Saldo do jogador",
-                        "schema": {
-                            "$ref": "#/definitions/usecase.GetPlayerBalanceResponse"
-                        }
+                    "204": {
+                        "description": "API key revogada"
                     },
                     "401": {
                         "description": "Não autorizado",
@@ -326,8 +433,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
                     "404": {
-                        "description": "Jogador não encontrado",
+                        "description": "API key não encontrada",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
@@ -341,45 +454,3087 @@ const docTemplate = `{
                 }
             }
         },
-        "/refresh": {
-            "post": {
-                "description": "Gera um novo token de acesso e um novo token de atualização.",
-                "consumes": [
-                    "application/json"
+        "/admin/players": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Lista os jogadores com paginação, busca por trecho do email e ordenação por data de cadastro ou saldo.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Admin"
                 ],
-                "summary": "Refresh token",
+                "summary": "Listar jogadores",
                 "parameters": [
                     {
-                        "description": "Token de atualização",
-                        "name": "refreshToken",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/usecase.RefreshTokenRequest"
-                        }
+                        "type": "string",
+                        "description": "Trecho do email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: created_at (padrão) ou balance",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sentido: asc (padrão) ou desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de jogadores (padrão 50, máximo 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de jogadores a pular",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Jogadores encontrados",
                         "schema": {
-                            "$ref": "#/definitions/usecase.RefreshTokenResponse"
+                            "$ref": "#/definitions/usecase.ListPlayersResponse"
                         }
                     },
                     "400": {
-                        "description": "Requisição inválida",
+                        "description": "Parâmetros inválidos",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
                     },
                     "401": {
-                        "description": "Token de atualização inválido",
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o perfil do jogador, os totais da carteira, a última sessão de jogo e as movimentações mais recentes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Detalhar jogador",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do jogador",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dados do jogador",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetPlayerDetailsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jogador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}/block": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bloqueia o jogador e encerra todas as suas sessões. Jogadores bloqueados não conseguem entrar nem usar tokens já emitidos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Bloquear jogador",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do jogador",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Motivo do bloqueio",
+                        "name": "blockPlayerRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.BlockPlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Jogador bloqueado"
+                    },
+                    "400": {
+                        "description": "Motivo ausente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jogador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}/self-exclusion/lift": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Encerra antecipadamente uma pausa ou autoexclusão por prazo determinado. Exclusões permanentes não podem ser encerradas.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Encerrar autoexclusão",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do jogador",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Exclusão encerrada"
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Nenhuma exclusão ativa",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Exclusão permanente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}/unblock": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove o bloqueio do jogador, que volta a poder entrar.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Desbloquear jogador",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do jogador",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Jogador desbloqueado"
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jogador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tournaments": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria um torneio nas máquinas informadas entre starts_at e ends_at. As jogadas dos inscritos pontuam pelo total apostado (total_wagered) ou pelo maior prêmio em relação à aposta, em centésimos (biggest_multiplier). O prêmio total é guaranteed_prize, pago pela tesouraria, mais as inscrições, dividido pelos percentuais de prize_distribution a partir da primeira colocação. Os prêmios são pagos automaticamente nos saldos quando o torneio termina.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Criar torneio",
+                "parameters": [
+                    {
+                        "description": "Dados do torneio",
+                        "name": "createTournamentRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateTournamentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Torneio criado",
+                        "schema": {
+                            "$ref": "#/definitions/model.Tournament"
+                        }
+                    },
+                    "400": {
+                        "description": "Agenda, pontuação ou distribuição de prêmios inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Máquina caça-níqueis não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as assinaturas de webhook cadastradas. Os segredos nunca são retornados.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Listar assinaturas de webhook",
+                "responses": {
+                    "200": {
+                        "description": "Assinaturas encontradas",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListWebhooksResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra uma URL para receber os eventos de domínio dos tipos informados. Cada entrega é um POST com o evento em JSON, assinado no cabeçalho X-Webhook-Signature como v1=HMAC-SHA256(segredo, \"\u003cX-Webhook-Timestamp\u003e.\u003ccorpo\u003e\"). O segredo, informado ou gerado, só é retornado nesta resposta.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Criar assinatura de webhook",
+                "parameters": [
+                    {
+                        "description": "URL, tipos de evento e segredo opcional",
+                        "name": "createWebhookRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Assinatura criada",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "URL, tipo de evento ou segredo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as entregas de webhook, das mais recentes para as mais antigas, com tentativas, último status HTTP e último erro. Com status=dead, lista as entregas que esgotaram as tentativas.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Listar entregas de webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da assinatura",
+                        "name": "subscription_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status: pending, delivered ou dead",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima (padrão 50, máximo 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Entregas encontradas",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListWebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Filtro inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/deliveries/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Devolve à fila uma entrega que esgotou as tentativas, com as tentativas zeradas. A assinatura precisa existir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Reenviar entrega de webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da entrega",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Entrega reagendada",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookDelivery"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Entrega ou assinatura não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "A entrega não está na lista de mortas",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a assinatura. As entregas pendentes dela vão para a lista de entregas mortas; o log de entregas é mantido.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Remover assinatura de webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da assinatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Assinatura removida"
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Assinatura não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/audit": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as ações administrativas registradas, das mais recentes para as mais antigas, com filtros opcionais.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Listar log de auditoria",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ator que executou a ação",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ação (ex: machine.create)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipo do alvo (ex: machine)",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do alvo",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final, exclusiva (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de entradas (padrão 100, máximo 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de entradas a pular",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Entradas do log de auditoria",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListAuditEntriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Abre um fluxo Server-Sent Events autenticado com o access token. Cada evento tem o tipo em event e o JSON em data: balance.updated traz o novo saldo do jogador após jogadas, depósitos, ajustes e torneios; win.big anuncia vitórias grandes de qualquer jogador, sem identificá-lo; jackpot.updated traz o valor atual dos jackpots. Comentários periódicos mantêm a conexão aberta; eventos não entregues a um cliente lento são descartados, e o saldo atual pode ser consultado em /players/balance.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Receber eventos em tempo real",
+                "responses": {
+                    "200": {
+                        "description": "Fluxo de eventos",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Streaming não suportado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/jackpots": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o valor atual de cada jackpot com as máquinas ligadas a ele e os jackpots pagos mais recentes, sem identificar os jogadores.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Listar jackpots",
+                "responses": {
+                    "200": {
+                        "description": "Jackpots e ganhadores recentes",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListJackpotsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria um pool de jackpot com valor inicial retirado da tesouraria, percentual de contribuição das apostas e gatilho (combinação de símbolos e/ou chance de 1 em N por jogada).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Criar jackpot progressivo",
+                "parameters": [
+                    {
+                        "description": "Dados do jackpot",
+                        "name": "createJackpotPoolRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateJackpotPoolRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Jackpot criado",
+                        "schema": {
+                            "$ref": "#/definitions/model.JackpotPool"
+                        }
+                    },
+                    "400": {
+                        "description": "Payload inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Saldo insuficiente na tesouraria",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/jackpots/{id}/machines": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Passa a destinar ao jackpot a contribuição das apostas feitas na máquina. Uma máquina participa de no máximo um jackpot.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Ligar máquina ao jackpot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do jackpot",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Máquina a ligar",
+                        "name": "jackpotMachineRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.JackpotMachineRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Jackpot atualizado",
+                        "schema": {
+                            "$ref": "#/definitions/model.JackpotPool"
+                        }
+                    },
+                    "400": {
+                        "description": "Payload inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jackpot ou máquina não encontrados",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Máquina já ligada a outro jackpot",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/jackpots/{id}/machines/{machine_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deixa de destinar ao jackpot a contribuição das apostas feitas na máquina. O valor acumulado permanece no jackpot.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Desligar máquina do jackpot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do jackpot",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da máquina caça-níqueis",
+                        "name": "machine_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Jackpot atualizado",
+                        "schema": {
+                            "$ref": "#/definitions/model.JackpotPool"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jackpot não encontrado ou máquina não ligada a ele",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Autentica um usuário e retorna um token JWT. Contas com 2FA (e administradores, para quem o 2FA é obrigatório) recebem apenas um mfa_token, a ser usado em /login/2fa ou no cadastro do TOTP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Dados de autenticação",
+                        "name": "loginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Credenciais inválidas",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Conta autoexcluída",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "423": {
+                        "description": "Conta temporariamente bloqueada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Muitas tentativas de login",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/2fa": {
+            "post": {
+                "description": "Conclui o login de contas com 2FA usando o mfa_token e um código TOTP ou um código de recuperação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Login com segundo fator",
+                "parameters": [
+                    {
+                        "description": "Token de MFA e código",
+                        "name": "verifyMFARequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.VerifyMFARequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Token de MFA ou código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "423": {
+                        "description": "Conta temporariamente bloqueada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Muitas tentativas",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/2fa/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Habilita o 2FA após validar um código do aplicativo autenticador e retorna os códigos de recuperação, exibidos uma única vez. Durante o login (/login/2fa/confirm) também retorna os tokens da sessão.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Confirmar cadastro do 2FA",
+                "parameters": [
+                    {
+                        "description": "Código TOTP",
+                        "name": "confirmTOTPRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ConfirmTOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ConfirmTOTPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "2FA já habilitado ou cadastro não iniciado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/2fa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera um segredo TOTP e a URI otpauth para o aplicativo autenticador. Jogadores autenticados usam /players/2fa/enroll; administradores sem 2FA usam /login/2fa/enroll com o mfa_token recebido no login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Iniciar cadastro do 2FA",
+                "parameters": [
+                    {
+                        "description": "Token de MFA (apenas durante o login)",
+                        "name": "enrollTOTPRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.EnrollTOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.EnrollTOTPResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "2FA já habilitado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/machines": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "Permite a criação de uma nova máquina caça-níqueis com os parâmetros especificados. reels e rows definem a grade (padrão 3x1), paylines as linhas de pagamento e paytable o multiplicador por quantidade de símbolos iguais a partir do primeiro rolo; omitidos, valem os padrões da grade. O campo opcional bonus define o símbolo scatter e quantas rodadas grátis (e com qual multiplicador) cada quantidade de scatters concede. strips define uma fita com pesos por rolo; omitido, vale a configuração equivalente ao level. near_miss (off ou reel_window) exige jurisdiction e só é exibido nas jurisdições permitidas pelo servidor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotMachine"
+                ],
+                "summary": "Criar uma nova máquina caça-níqueis",
+                "parameters": [
+                    {
+                        "description": "Dados da máquina caça-níqueis a ser criada",
+                        "name": "createSlotMachineRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateSlotMachineRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Máquina criada com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateSlotMachineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Payload inválido ou parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/machines/balance": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "Retorna o saldo da máquina caça-níqueis especificada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotMachine"
+                ],
+                "summary": "Obter saldo da máquina caça-níqueis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da máquina caça-níqueis",
+                        "name": "machine_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saldo da máquina caça-níqueis",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetSlotMachineBalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID da máquina caça-níqueis é obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Máquina caça-níqueis não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/machines/{id}/cashout": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Transfere o valor informado do saldo da máquina para a tesouraria da casa, mantendo na máquina o saldo mínimo configurado. O motivo é obrigatório e o valor é limitado por operação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Recolher saldo da máquina caça-níqueis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da máquina caça-níqueis",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Valor e motivo",
+                        "name": "machineTransferRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.MachineTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Movimento registrado",
+                        "schema": {
+                            "$ref": "#/definitions/model.TreasuryMovement"
+                        }
+                    },
+                    "400": {
+                        "description": "Valor ou motivo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Máquina caça-níqueis não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Saldo da máquina insuficiente ou limite por operação excedido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/machines/{id}/refill": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Transfere o valor informado da tesouraria da casa para o saldo da máquina. O motivo é obrigatório e o valor é limitado por operação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Recarregar máquina caça-níqueis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da máquina caça-níqueis",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Valor e motivo",
+                        "name": "machineTransferRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.MachineTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Movimento registrado",
+                        "schema": {
+                            "$ref": "#/definitions/model.TreasuryMovement"
+                        }
+                    },
+                    "400": {
+                        "description": "Valor ou motivo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Máquina caça-níqueis não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Saldo da tesouraria insuficiente ou limite por operação excedido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/machines/{id}/stats": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna, para o período pedido, o total apostado e pago, o RTP realizado e o teórico, a frequência de acertos, o número de jogadas, de jogadores distintos e o maior prêmio, no total e por hora ou dia (UTC).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Estatísticas da máquina caça-níqueis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da máquina caça-níqueis",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final, exclusiva (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Granularidade da série: hour ou day (padrão)",
+                        "name": "granularity",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Estatísticas da máquina",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetSlotMachineStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Máquina caça-níqueis não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/forgot": {
+            "post": {
+                "description": "Envia um token de redefinição para o email informado. A resposta é a mesma exista ou não a conta.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Solicitar redefinição de senha",
+                "parameters": [
+                    {
+                        "description": "Email da conta",
+                        "name": "requestPasswordResetRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.RequestPasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Solicitação aceita"
+                    },
+                    "400": {
+                        "description": "Payload inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/reset": {
+            "post": {
+                "description": "Redefine a senha do jogador com um token de redefinição válido e revoga todas as sessões.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Redefinir senha",
+                "parameters": [
+                    {
+                        "description": "Token e nova senha",
+                        "name": "resetPasswordRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Senha redefinida com sucesso"
+                    },
+                    "400": {
+                        "description": "Token inválido ou senha fora da política",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/play": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permite que o jogador faça uma aposta e jogue na máquina caça-níqueis especificada. amount_bet é a aposta por linha e lines o número de linhas de pagamento jogadas (todas, se omitido); a aposta total é amount_bet × lines e result traz a grade sorteada, linha a linha. Quando o intervalo de reality check do jogador termina, a resposta traz reality_check e a próxima jogada é recusada até a confirmação. Se o jogador tiver rodadas grátis na máquina, a jogada consome uma delas com a aposta que as concedeu, sem cobrar amount_bet; free_spins_remaining e bonus informam o andamento da sequência. Em jogadas sem prêmio, se a jurisdição da máquina permitir, near_miss mostra os símbolos das fitas logo acima e abaixo da grade; o resultado nunca é alterado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotMachine"
+                ],
+                "summary": "Jogar na máquina caça-níqueis",
+                "parameters": [
+                    {
+                        "description": "Dados da jogada",
+                        "name": "playRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.PlayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Jogada realizada com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.PlayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Payload inválido ou número de linhas inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Email não verificado, conta em exclusão ou limite de jogo atingido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Máquina caça-níqueis não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Saldo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Reality check pendente de confirmação",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/play/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Executa até 100 jogadas (spins) com a mesma aposta de /play, liquidando cada uma separadamente com todos os limites de aposta e de jogo responsável. A sequência para antes se uma jogada pagar mais que stop_on_win_above, se o saldo ficar abaixo de stop_on_balance_below, em qualquer jackpot com stop_on_jackpot ou quando um reality check for devido. Se um limite ou o saldo impedir uma jogada após a primeira, a resposta traz as jogadas já liquidadas com stop_reason \"limit\" e o motivo em error; qualquer outra falha após a primeira jogada encerra a sequência com stop_reason \"error\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotMachine"
+                ],
+                "summary": "Jogar em sequência (autoplay)",
+                "parameters": [
+                    {
+                        "description": "Dados da sequência de jogadas",
+                        "name": "playBatchRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.PlayBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Jogadas realizadas com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.PlayBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Payload inválido, número de jogadas ou de linhas inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Email não verificado, conta em exclusão ou limite de jogo atingido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Máquina caça-níqueis não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Saldo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Reality check pendente de confirmação",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players": {
+            "post": {
+                "description": "Permite a criação de um novo jogador com um saldo inicial. O email é normalizado e um token de verificação é enviado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Criar um novo jogador",
+                "parameters": [
+                    {
+                        "description": "Dados do jogador a ser criado",
+                        "name": "createPlayerRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreatePlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Jogador criado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreatePlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Payload inválido, email inválido ou senha fora da política",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Jogador já existe",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/2fa/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Habilita o 2FA após validar um código do aplicativo autenticador e retorna os códigos de recuperação, exibidos uma única vez. Durante o login (/login/2fa/confirm) também retorna os tokens da sessão.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Confirmar cadastro do 2FA",
+                "parameters": [
+                    {
+                        "description": "Código TOTP",
+                        "name": "confirmTOTPRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ConfirmTOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ConfirmTOTPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "2FA já habilitado ou cadastro não iniciado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/2fa/disable": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Desabilita o 2FA após confirmar a senha e um código TOTP. Não é permitido para administradores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Desabilitar 2FA",
+                "parameters": [
+                    {
+                        "description": "Senha e código TOTP",
+                        "name": "disableTOTPRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.DisableTOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "2FA desabilitado"
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Senha incorreta ou 2FA obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "2FA não habilitado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/2fa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera um segredo TOTP e a URI otpauth para o aplicativo autenticador. Jogadores autenticados usam /players/2fa/enroll; administradores sem 2FA usam /login/2fa/enroll com o mfa_token recebido no login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Iniciar cadastro do 2FA",
+                "parameters": [
+                    {
+                        "description": "Token de MFA (apenas durante o login)",
+                        "name": "enrollTOTPRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.EnrollTOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.EnrollTOTPResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "2FA já habilitado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/balance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o saldo do jogador especificado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Obter saldo do jogador",
+                "responses": {
+                    "200": {
+                        "description": "Saldo do jogador",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetPlayerBalanceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jogador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/deposit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Credita o valor informado no saldo do jogador, respeitando os limites de depósito configurados.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Depositar",
+                "parameters": [
+                    {
+                        "description": "Valor do depósito",
+                        "name": "depositRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.DepositRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Depósito realizado",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.DepositResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Valor inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Email não verificado, conta em exclusão ou limite de depósito atingido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/limits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os limites ativos do jogador e as alterações pendentes com a data em que passam a valer.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Listar limites de jogo",
+                "responses": {
+                    "200": {
+                        "description": "Limites do jogador",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetGamblingLimitsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define um limite diário, semanal ou mensal (janela móvel) de depósito, perda ou aposta, ou a duração máxima da sessão em minutos (type session, sem period). Reduções valem imediatamente; aumentos e remoções (amount 0) só após o período de espera.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Definir limite de jogo",
+                "parameters": [
+                    {
+                        "description": "Limite",
+                        "name": "setGamblingLimitRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SetGamblingLimitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Limite atualizado",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SetGamblingLimitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Limite inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados do jogador, as estatísticas de jogo de toda a vida, do dia e dos últimos 7 e 30 dias (jogadas, total apostado e ganho, maior prêmio e máquina favorita) e o resultado da sessão atual.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Perfil do jogador",
+                "responses": {
+                    "200": {
+                        "description": "Perfil do jogador",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetPlayerProfileResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Jogador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Troca a senha do jogador após confirmar a senha atual. Todas as outras sessões são revogadas e um novo par de tokens é retornado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Trocar senha",
+                "parameters": [
+                    {
+                        "description": "Senha atual e nova senha",
+                        "name": "changePasswordRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Senha alterada com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ChangePasswordResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Payload inválido ou senha fora da política",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Senha atual incorreta",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/reality-check": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define de quantos em quantos minutos (5 a 240) o jogador recebe um resumo da sessão de jogo. Zero desativa os avisos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Configurar reality check",
+                "parameters": [
+                    {
+                        "description": "Intervalo em minutos",
+                        "name": "setRealityCheckRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SetRealityCheckRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Intervalo atualizado",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SetRealityCheckResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Intervalo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/reality-check/acknowledge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirma o resumo de sessão recebido em /play, liberando a próxima jogada.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Confirmar reality check",
+                "responses": {
+                    "204": {
+                        "description": "Reality check confirmado"
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/self-exclusion": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna a pausa ou autoexclusão vigente do jogador, ou exclusion nulo quando não houver.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Consultar autoexclusão",
+                "responses": {
+                    "200": {
+                        "description": "Exclusão vigente",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetSelfExclusionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Inicia uma pausa (cool_off, de 1 a 42 dias) ou uma autoexclusão (self_exclusion, de 180 dias a 5 anos ou permanente). Todas as sessões são encerradas. Durante a pausa o jogador entra apenas com acesso de leitura; durante a autoexclusão o login é recusado. Uma exclusão ativa só pode ser prolongada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Autoexclusão",
+                "parameters": [
+                    {
+                        "description": "Tipo e duração da exclusão",
+                        "name": "selfExcludeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SelfExcludeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exclusão iniciada",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SelfExcludeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Duração inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "A exclusão ativa não pode ser encurtada",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/verify-email": {
+            "post": {
+                "description": "Confirma o email do jogador com o token enviado no cadastro. Jogadores só podem jogar após a verificação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Verificar email",
+                "parameters": [
+                    {
+                        "description": "Token de verificação",
+                        "name": "verifyEmailRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.VerifyEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Email verificado com sucesso"
+                    },
+                    "400": {
+                        "description": "Token inválido ou expirado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/players/verify-email/resend": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera um novo token de verificação para o jogador autenticado, invalidando o anterior.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Reenviar verificação de email",
+                "responses": {
+                    "202": {
+                        "description": "Token reenviado"
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Email já verificado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "Gera um novo token de acesso e um novo token de atualização.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Refresh token",
+                "parameters": [
+                    {
+                        "description": "Token de atualização",
+                        "name": "refreshToken",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.RefreshTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Token de atualização inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tournaments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os torneios, dos que começam mais tarde para os que começam mais cedo, com as máquinas, a agenda, a inscrição e a distribuição de prêmios.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Listar torneios",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Status: open ou settled",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Torneios encontrados",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListTournamentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Status inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tournaments/{id}/join": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Debita a inscrição do saldo do jogador. As inscrições ficam abertas até o fim do torneio e respeitam os limites de aposta e de perda; só as jogadas feitas depois da inscrição pontuam.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Inscrever-se em torneio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do torneio",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Inscrição realizada",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.JoinTournamentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Email não verificado, autoexclusão ou limite de jogo excedido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Torneio não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Torneio encerrado ou jogador já inscrito",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Saldo insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tournaments/{id}/leaderboard": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as primeiras colocações e a colocação do jogador. Empates ficam com quem alcançou a pontuação primeiro. Em prize vem o prêmio pago ou, com o torneio aberto, o que a colocação receberia se ele terminasse agora; só concorrem a prêmios os inscritos que jogaram no torneio.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Player"
+                ],
+                "summary": "Classificação do torneio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do torneio",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de colocações (padrão 50, máximo 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Classificação",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetLeaderboardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Limite inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Torneio não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/treasury": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o saldo da tesouraria da casa e os movimentos mais recentes (recargas, recolhimentos e ajustes).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Consultar tesouraria",
+                "responses": {
+                    "200": {
+                        "description": "Saldo e movimentos da tesouraria",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetTreasuryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autorizado",
+                        "schema": {
+                            "$ref": "#/definitions/handler_error.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Escopo insuficiente",
                         "schema": {
                             "$ref": "#/definitions/handler_error.HTTPError"
                         }
@@ -399,203 +3554,2007 @@ const docTemplate = `{
             "description": "Estrutura para representar erros na API. Contém a mensagem de erro e um código opcional. Pode ser expandida conforme necessário.",
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "Código do erro HTTP",
+                "code": {
+                    "description": "Código do erro HTTP",
+                    "type": "integer"
+                },
+                "message": {
+                    "description": "Mensagem descritiva do erro",
+                    "type": "string"
+                }
+            }
+        },
+        "model.APIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_key_id": {
+                    "description": "ParentKeyID aponta a chave usada para criar esta, quando houver.\nRevogar a chave de origem revoga também as que ela criou.",
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Scope"
+                    }
+                }
+            }
+        },
+        "model.AdjustmentReason": {
+            "type": "string",
+            "enum": [
+                "goodwill",
+                "error_correction",
+                "chargeback",
+                "promotion",
+                "machine_refill",
+                "treasury_funding"
+            ],
+            "x-enum-varnames": [
+                "ReasonGoodwill",
+                "ReasonErrorCorrection",
+                "ReasonChargeback",
+                "ReasonPromotion",
+                "ReasonMachineRefill",
+                "ReasonTreasuryFunding"
+            ]
+        },
+        "model.AdjustmentStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "approved",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "AdjustmentPending",
+                "AdjustmentApproved",
+                "AdjustmentRejected"
+            ]
+        },
+        "model.AdjustmentTarget": {
+            "type": "string",
+            "enum": [
+                "player",
+                "machine",
+                "treasury"
+            ],
+            "x-enum-varnames": [
+                "AdjustmentTargetPlayer",
+                "AdjustmentTargetMachine",
+                "AdjustmentTargetTreasury"
+            ]
+        },
+        "model.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "api_key_id": {
+                    "type": "string"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.BalanceAdjustment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "proposed_at": {
+                    "type": "string"
+                },
+                "proposed_by": {
+                    "type": "string"
+                },
+                "reason_code": {
+                    "$ref": "#/definitions/model.AdjustmentReason"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "reviewed_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.AdjustmentStatus"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "$ref": "#/definitions/model.AdjustmentTarget"
+                }
+            }
+        },
+        "model.BonusFeature": {
+            "type": "object",
+            "properties": {
+                "awards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ScatterAward"
+                    }
+                },
+                "scatter_symbol": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DomainEventType": {
+            "type": "string",
+            "enum": [
+                "player.registered",
+                "player.blocked",
+                "deposit.completed",
+                "spin.settled",
+                "jackpot.won",
+                "adjustment.approved",
+                "machine.created",
+                "tournament.joined",
+                "tournament.settled"
+            ],
+            "x-enum-varnames": [
+                "DomainEventPlayerRegistered",
+                "DomainEventPlayerBlocked",
+                "DomainEventDepositCompleted",
+                "DomainEventSpinSettled",
+                "DomainEventJackpotWon",
+                "DomainEventAdjustmentApproved",
+                "DomainEventMachineCreated",
+                "DomainEventTournamentJoined",
+                "DomainEventTournamentSettled"
+            ]
+        },
+        "model.Event": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "data": {},
+                "type": {
+                    "$ref": "#/definitions/model.EventType"
+                }
+            }
+        },
+        "model.EventType": {
+            "type": "string",
+            "enum": [
+                "balance.updated",
+                "win.big",
+                "jackpot.updated"
+            ],
+            "x-enum-varnames": [
+                "EventBalanceUpdated",
+                "EventBigWin",
+                "EventJackpotUpdated"
+            ]
+        },
+        "model.ExclusionType": {
+            "type": "string",
+            "enum": [
+                "cool_off",
+                "self_exclusion"
+            ],
+            "x-enum-varnames": [
+                "ExclusionCoolOff",
+                "ExclusionSelfExclusion"
+            ]
+        },
+        "model.GamblingLimit": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "pending_amount": {
+                    "type": "integer"
+                },
+                "pending_effective_at": {
+                    "type": "string"
+                },
+                "period": {
+                    "$ref": "#/definitions/model.LimitPeriod"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.LimitType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.JackpotPool": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "contribution_percent": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "machine_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "seed_amount": {
+                    "type": "integer"
+                },
+                "total_contributed": {
+                    "type": "integer"
+                },
+                "total_paid": {
+                    "type": "integer"
+                },
+                "total_seeded": {
+                    "type": "integer"
+                },
+                "trigger_combination": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "trigger_odds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.JackpotWin": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "machine_id": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "pool_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LimitPeriod": {
+            "type": "string",
+            "enum": [
+                "daily",
+                "weekly",
+                "monthly"
+            ],
+            "x-enum-varnames": [
+                "PeriodDaily",
+                "PeriodWeekly",
+                "PeriodMonthly"
+            ]
+        },
+        "model.LimitType": {
+            "type": "string",
+            "enum": [
+                "deposit",
+                "loss",
+                "wager",
+                "session"
+            ],
+            "x-enum-varnames": [
+                "LimitDeposit",
+                "LimitLoss",
+                "LimitWager",
+                "LimitSession"
+            ]
+        },
+        "model.LinePay": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.LineWin": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "payout": {
+                    "type": "integer"
+                },
+                "symbol": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NearMissMode": {
+            "type": "string",
+            "enum": [
+                "off",
+                "reel_window"
+            ],
+            "x-enum-varnames": [
+                "NearMissOff",
+                "NearMissReelWindow"
+            ]
+        },
+        "model.NearMissPeek": {
+            "type": "object",
+            "properties": {
+                "above": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "below": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.PlaySession": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "last_activity_at": {
+                    "type": "string"
+                },
+                "last_reality_check_at": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "reality_check_pending": {
+                    "type": "boolean"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "wagered": {
+                    "type": "integer"
+                },
+                "won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Player": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "blocked": {
+                    "type": "boolean"
+                },
+                "blocked_reason": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_verified": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reality_check_minutes": {
+                    "type": "integer"
+                },
+                "totp_enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.PlayerStats": {
+            "type": "object",
+            "properties": {
+                "biggest_win": {
+                    "type": "integer"
+                },
+                "favourite_machine": {
+                    "type": "string"
+                },
+                "net_result": {
+                    "type": "integer"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "total_wagered": {
+                    "type": "integer"
+                },
+                "total_won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RealityCheck": {
+            "type": "object",
+            "properties": {
+                "elapsed_minutes": {
+                    "type": "integer"
+                },
+                "net_result": {
+                    "type": "integer"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "wagered": {
+                    "type": "integer"
+                },
+                "won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ReelStrip": {
+            "type": "object",
+            "properties": {
+                "symbols": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "weights": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "model.ScatterAward": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "free_spins": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Scope": {
+            "type": "string",
+            "enum": [
+                "machines:read",
+                "machines:write",
+                "api_keys:manage",
+                "audit:read",
+                "players:read",
+                "players:write",
+                "adjustments:propose",
+                "adjustments:approve",
+                "treasury:read",
+                "treasury:manage",
+                "jackpots:manage",
+                "webhooks:manage",
+                "tournaments:manage"
+            ],
+            "x-enum-varnames": [
+                "ScopeMachinesRead",
+                "ScopeMachinesWrite",
+                "ScopeAPIKeysManage",
+                "ScopeAuditRead",
+                "ScopePlayersRead",
+                "ScopePlayersWrite",
+                "ScopeAdjustmentsPropose",
+                "ScopeAdjustmentsApprove",
+                "ScopeTreasuryRead",
+                "ScopeTreasuryManage",
+                "ScopeJackpotsManage",
+                "ScopeWebhooksManage",
+                "ScopeTournamentsManage"
+            ]
+        },
+        "model.SelfExclusion": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "lifted_at": {
+                    "type": "string"
+                },
+                "lifted_by": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.ExclusionType"
+                }
+            }
+        },
+        "model.SlotMachine": {
+            "type": "object",
+            "properties": {
+                "aligned_weight": {
+                    "type": "integer"
+                },
+                "balance": {
+                    "type": "integer"
+                },
+                "bonus": {
+                    "$ref": "#/definitions/model.BonusFeature"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "initial_balance": {
+                    "type": "integer"
+                },
+                "jurisdiction": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "multiple_gain": {
+                    "type": "integer"
+                },
+                "near_miss": {
+                    "$ref": "#/definitions/model.NearMissMode"
+                },
+                "paylines": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "paytable": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LinePay"
+                    }
+                },
+                "reels": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "integer"
+                },
+                "strips": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ReelStrip"
+                    }
+                },
+                "symbols": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.StatsGranularity": {
+            "type": "string",
+            "enum": [
+                "hour",
+                "day"
+            ],
+            "x-enum-varnames": [
+                "GranularityHour",
+                "GranularityDay"
+            ]
+        },
+        "model.Tournament": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "entrants": {
+                    "type": "integer"
+                },
+                "entry_fee": {
+                    "type": "integer"
+                },
+                "guaranteed_prize": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "machine_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prize_distribution": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "scoring": {
+                    "$ref": "#/definitions/model.TournamentScoring"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.TournamentStatus"
+                }
+            }
+        },
+        "model.TournamentEntry": {
+            "type": "object",
+            "properties": {
+                "joined_at": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "prize": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "scored_at": {
+                    "type": "string"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "tournament_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TournamentScoring": {
+            "type": "string",
+            "enum": [
+                "total_wagered",
+                "biggest_multiplier"
+            ],
+            "x-enum-varnames": [
+                "TournamentScoringTotalWagered",
+                "TournamentScoringBiggestMultiplier"
+            ]
+        },
+        "model.TournamentStatus": {
+            "type": "string",
+            "enum": [
+                "open",
+                "settled"
+            ],
+            "x-enum-varnames": [
+                "TournamentStatusOpen",
+                "TournamentStatusSettled"
+            ]
+        },
+        "model.Transaction": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "machine_id": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.TransactionType"
+                }
+            }
+        },
+        "model.TransactionType": {
+            "type": "string",
+            "enum": [
+                "deposit",
+                "bet",
+                "win",
+                "jackpot_win",
+                "adjustment_credit",
+                "adjustment_debit",
+                "tournament_entry",
+                "tournament_prize"
+            ],
+            "x-enum-varnames": [
+                "TransactionDeposit",
+                "TransactionBet",
+                "TransactionWin",
+                "TransactionJackpotWin",
+                "TransactionAdjustmentCredit",
+                "TransactionAdjustmentDebit",
+                "TransactionTournamentEntry",
+                "TransactionTournamentPrize"
+            ]
+        },
+        "model.Treasury": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TreasuryMovement": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "jackpot_pool_id": {
+                    "type": "string"
+                },
+                "machine_balance": {
+                    "type": "integer"
+                },
+                "machine_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "treasury_balance": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.TreasuryMovementType"
+                }
+            }
+        },
+        "model.TreasuryMovementType": {
+            "type": "string",
+            "enum": [
+                "refill",
+                "cashout",
+                "adjustment",
+                "jackpot_seed",
+                "tournament_entry",
+                "tournament_prize"
+            ],
+            "x-enum-varnames": [
+                "MovementRefill",
+                "MovementCashout",
+                "MovementAdjustment",
+                "MovementJackpotSeed",
+                "MovementTournamentEntry",
+                "MovementTournamentPrize"
+            ]
+        },
+        "model.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "$ref": "#/definitions/model.DomainEventType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_attempt_at": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.WebhookDeliveryStatus"
+                },
+                "subscription_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "delivered",
+                "dead"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliveryDelivered",
+                "WebhookDeliveryDead"
+            ]
+        },
+        "model.WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DomainEventType"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.BatchStopReason": {
+            "type": "string",
+            "enum": [
+                "completed",
+                "win",
+                "balance",
+                "jackpot",
+                "reality_check",
+                "limit",
+                "error"
+            ],
+            "x-enum-varnames": [
+                "BatchStopCompleted",
+                "BatchStopWin",
+                "BatchStopBalance",
+                "BatchStopJackpot",
+                "BatchStopRealityCheck",
+                "BatchStopLimit",
+                "BatchStopError"
+            ]
+        },
+        "usecase.BatchTotals": {
+            "type": "object",
+            "properties": {
+                "net": {
+                    "type": "integer"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "total_bet": {
+                    "type": "integer"
+                },
+                "total_won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.BlockPlayerRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.BonusRoundSummary": {
+            "type": "object",
+            "properties": {
+                "free_spins_awarded": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "integer"
+                },
+                "total_free_spins": {
+                    "type": "integer"
+                },
+                "total_won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.ChangePasswordRequest": {
+            "type": "object",
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.ChangePasswordResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.ConfirmTOTPRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "mfa_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.ConfirmTOTPResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateAPIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Scope"
+                    }
+                }
+            }
+        },
+        "usecase.CreateAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/model.APIKey"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateJackpotPoolRequest": {
+            "type": "object",
+            "properties": {
+                "contribution_percent": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "seed_amount": {
+                    "type": "integer"
+                },
+                "trigger_combination": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "trigger_odds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.CreatePlayerRequest": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreatePlayerResponse": {
+            "type": "object",
+            "properties": {
+                "player": {
+                    "$ref": "#/definitions/model.Player"
+                }
+            }
+        },
+        "usecase.CreateSlotMachineRequest": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "bonus": {
+                    "$ref": "#/definitions/model.BonusFeature"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "jurisdiction": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "multiple_gain": {
+                    "type": "integer"
+                },
+                "near_miss": {
+                    "$ref": "#/definitions/model.NearMissMode"
+                },
+                "paylines": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "paytable": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LinePay"
+                    }
+                },
+                "reels": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "integer"
+                },
+                "strips": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ReelStrip"
+                    }
+                }
+            }
+        },
+        "usecase.CreateSlotMachineResponse": {
+            "type": "object",
+            "properties": {
+                "machine": {
+                    "$ref": "#/definitions/model.SlotMachine"
+                }
+            }
+        },
+        "usecase.CreateTournamentRequest": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "entry_fee": {
+                    "type": "integer"
+                },
+                "guaranteed_prize": {
+                    "type": "integer"
+                },
+                "machine_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prize_distribution": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "scoring": {
+                    "$ref": "#/definitions/model.TournamentScoring"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DomainEventType"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "type": "string"
+                },
+                "subscription": {
+                    "$ref": "#/definitions/model.WebhookSubscription"
+                }
+            }
+        },
+        "usecase.CurrentSessionSummary": {
+            "type": "object",
+            "properties": {
+                "net_result": {
+                    "type": "integer"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "wagered": {
+                    "type": "integer"
+                },
+                "won": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.DepositRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.DepositResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.DisableTOTPRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.EnrollTOTPRequest": {
+            "type": "object",
+            "properties": {
+                "mfa_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.EnrollTOTPResponse": {
+            "type": "object",
+            "properties": {
+                "provisioning_uri": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.GetGamblingLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.GamblingLimit"
+                    }
+                }
+            }
+        },
+        "usecase.GetLeaderboardResponse": {
+            "type": "object",
+            "properties": {
+                "leaderboard": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TournamentEntry"
+                    }
+                },
+                "player_entry": {
+                    "$ref": "#/definitions/model.TournamentEntry"
+                },
+                "prize_pool": {
+                    "type": "integer"
+                },
+                "tournament": {
+                    "$ref": "#/definitions/model.Tournament"
+                }
+            }
+        },
+        "usecase.GetPlayerBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "player": {
+                    "$ref": "#/definitions/model.Player"
+                }
+            }
+        },
+        "usecase.GetPlayerDetailsResponse": {
+            "type": "object",
+            "properties": {
+                "last_session": {
+                    "$ref": "#/definitions/model.PlaySession"
+                },
+                "player": {
+                    "$ref": "#/definitions/model.Player"
+                },
+                "recent_transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Transaction"
+                    }
+                },
+                "wallet": {
+                    "$ref": "#/definitions/usecase.PlayerWallet"
+                }
+            }
+        },
+        "usecase.GetPlayerProfileResponse": {
+            "type": "object",
+            "properties": {
+                "current_session": {
+                    "$ref": "#/definitions/usecase.CurrentSessionSummary"
+                },
+                "last_30_days": {
+                    "$ref": "#/definitions/model.PlayerStats"
+                },
+                "last_7_days": {
+                    "$ref": "#/definitions/model.PlayerStats"
+                },
+                "lifetime": {
+                    "$ref": "#/definitions/model.PlayerStats"
+                },
+                "player": {
+                    "$ref": "#/definitions/model.Player"
+                },
+                "today": {
+                    "$ref": "#/definitions/model.PlayerStats"
+                }
+            }
+        },
+        "usecase.GetSelfExclusionResponse": {
+            "type": "object",
+            "properties": {
+                "exclusion": {
+                    "$ref": "#/definitions/model.SelfExclusion"
+                }
+            }
+        },
+        "usecase.GetSlotMachineBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "machine": {
+                    "$ref": "#/definitions/model.SlotMachine"
+                }
+            }
+        },
+        "usecase.GetSlotMachineStatsResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "granularity": {
+                    "$ref": "#/definitions/model.StatsGranularity"
+                },
+                "machine_id": {
+                    "type": "string"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.MachineStatsSummary"
+                    }
+                },
+                "theoretical_rtp": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totals": {
+                    "$ref": "#/definitions/usecase.MachineStatsSummary"
+                }
+            }
+        },
+        "usecase.GetTreasuryResponse": {
+            "type": "object",
+            "properties": {
+                "recent_movements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TreasuryMovement"
+                    }
+                },
+                "treasury": {
+                    "$ref": "#/definitions/model.Treasury"
+                }
+            }
+        },
+        "usecase.JackpotMachineRequest": {
+            "type": "object",
+            "properties": {
+                "machine_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.JackpotSummary": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "machine_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.JackpotWinner": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "machine_id": {
+                    "type": "string"
+                },
+                "pool_id": {
+                    "type": "string"
+                },
+                "won_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.JoinTournamentResponse": {
+            "type": "object",
+            "properties": {
+                "entry": {
+                    "$ref": "#/definitions/model.TournamentEntry"
+                },
+                "player_balance": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.ListAPIKeysResponse": {
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.APIKey"
+                    }
+                }
+            }
+        },
+        "usecase.ListAdjustmentsResponse": {
+            "type": "object",
+            "properties": {
+                "adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.BalanceAdjustment"
+                    }
+                }
+            }
+        },
+        "usecase.ListAuditEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AuditEntry"
+                    }
+                }
+            }
+        },
+        "usecase.ListJackpotsResponse": {
+            "type": "object",
+            "properties": {
+                "jackpots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.JackpotSummary"
+                    }
+                },
+                "recent_winners": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.JackpotWinner"
+                    }
+                }
+            }
+        },
+        "usecase.ListPlayersResponse": {
+            "type": "object",
+            "properties": {
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Player"
+                    }
+                },
+                "total": {
                     "type": "integer"
+                }
+            }
+        },
+        "usecase.ListTournamentsResponse": {
+            "type": "object",
+            "properties": {
+                "tournaments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Tournament"
+                    }
+                }
+            }
+        },
+        "usecase.ListWebhookDeliveriesResponse": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookDelivery"
+                    }
+                }
+            }
+        },
+        "usecase.ListWebhooksResponse": {
+            "type": "object",
+            "properties": {
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookSubscription"
+                    }
+                }
+            }
+        },
+        "usecase.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
                 },
-                "message": {
-                    "description": "Mensagem descritiva do erro",
+                "password": {
                     "type": "string"
                 }
             }
         },
-        "model.Player": {
+        "usecase.LoginResponse": {
             "type": "object",
             "properties": {
-                "balance": {
-                    "type": "integer"
+                "access_token": {
+                    "type": "string"
                 },
-                "email": {
+                "mfa_enrollment_required": {
+                    "type": "boolean"
+                },
+                "mfa_required": {
+                    "type": "boolean"
+                },
+                "mfa_token": {
                     "type": "string"
                 },
-                "id": {
+                "refresh_token": {
                     "type": "string"
                 }
             }
         },
-        "model.SlotMachine": {
+        "usecase.MachineStatsSummary": {
             "type": "object",
             "properties": {
-                "balance": {
+                "hit_frequency": {
+                    "type": "number"
+                },
+                "largest_win": {
                     "type": "integer"
                 },
-                "description": {
+                "realised_rtp": {
+                    "type": "number"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "start": {
                     "type": "string"
                 },
-                "id": {
+                "total_paid": {
+                    "type": "integer"
+                },
+                "total_wagered": {
+                    "type": "integer"
+                },
+                "unique_players": {
+                    "type": "integer"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.MachineTransferRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "reason": {
                     "type": "string"
+                }
+            }
+        },
+        "usecase.PlayBatchRequest": {
+            "type": "object",
+            "properties": {
+                "amount_bet": {
+                    "type": "integer"
                 },
-                "initial_balance": {
+                "lines": {
                     "type": "integer"
                 },
-                "level": {
+                "machine_id": {
+                    "type": "string"
+                },
+                "spins": {
                     "type": "integer"
                 },
-                "multiple_gain": {
+                "stop_on_balance_below": {
+                    "type": "integer"
+                },
+                "stop_on_jackpot": {
+                    "type": "boolean"
+                },
+                "stop_on_win_above": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.PlayBatchResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "player_balance": {
                     "type": "integer"
                 },
-                "permutations": {
+                "spins": {
                     "type": "array",
                     "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
+                        "$ref": "#/definitions/usecase.PlayResponse"
                     }
                 },
-                "symbols": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "stop_reason": {
+                    "$ref": "#/definitions/usecase.BatchStopReason"
+                },
+                "totals": {
+                    "$ref": "#/definitions/usecase.BatchTotals"
                 }
             }
         },
-        "usecase.CreatePlayerRequest": {
+        "usecase.PlayRequest": {
             "type": "object",
             "properties": {
-                "balance": {
+                "amount_bet": {
                     "type": "integer"
                 },
-                "email": {
-                    "type": "string"
+                "lines": {
+                    "type": "integer"
                 },
-                "password": {
+                "machine_id": {
                     "type": "string"
                 }
             }
         },
-        "usecase.CreatePlayerResponse": {
+        "usecase.PlayResponse": {
             "type": "object",
             "properties": {
-                "player": {
-                    "$ref": "#/definitions/model.Player"
+                "bonus": {
+                    "$ref": "#/definitions/usecase.BonusRoundSummary"
+                },
+                "free_spin": {
+                    "type": "boolean"
+                },
+                "free_spins_remaining": {
+                    "type": "integer"
+                },
+                "jackpot": {
+                    "$ref": "#/definitions/model.JackpotWin"
+                },
+                "line_wins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LineWin"
+                    }
+                },
+                "lines": {
+                    "type": "integer"
+                },
+                "near_miss": {
+                    "$ref": "#/definitions/model.NearMissPeek"
+                },
+                "player_balance": {
+                    "type": "integer"
+                },
+                "reality_check": {
+                    "$ref": "#/definitions/model.RealityCheck"
+                },
+                "result": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "slot_machine_balance": {
+                    "type": "integer"
+                },
+                "total_bet": {
+                    "type": "integer"
+                },
+                "win": {
+                    "type": "boolean"
                 }
             }
         },
-        "usecase.CreateSlotMachineRequest": {
+        "usecase.PlayerWallet": {
             "type": "object",
             "properties": {
                 "balance": {
                     "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "total_deposited": {
+                    "type": "integer"
                 },
-                "level": {
+                "total_wagered": {
                     "type": "integer"
                 },
-                "multiple_gain": {
+                "total_won": {
                     "type": "integer"
                 }
             }
         },
-        "usecase.CreateSlotMachineResponse": {
+        "usecase.ProposeAdjustmentRequest": {
             "type": "object",
             "properties": {
-                "machine": {
-                    "$ref": "#/definitions/model.SlotMachine"
+                "amount": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "reason_code": {
+                    "$ref": "#/definitions/model.AdjustmentReason"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "$ref": "#/definitions/model.AdjustmentTarget"
                 }
             }
         },
-        "usecase.GetPlayerBalanceResponse": {
+        "usecase.RefreshTokenRequest": {
             "type": "object",
             "properties": {
-                "player": {
-                    "$ref": "#/definitions/model.Player"
+                "refresh_token": {
+                    "type": "string"
                 }
             }
         },
-        "usecase.GetSlotMachineBalanceResponse": {
+        "usecase.RefreshTokenResponse": {
             "type": "object",
             "properties": {
-                "machine": {
-                    "$ref": "#/definitions/model.SlotMachine"
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
                 }
             }
         },
-        "usecase.LoginRequest": {
+        "usecase.RequestPasswordResetRequest": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
                 "email": {
                     "type": "string"
-                },
-                "password": {
-                    "type": "string"
                 }
             }
         },
-        "usecase.LoginResponse": {
+        "usecase.ResetPasswordRequest": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "new_password": {
                     "type": "string"
                 },
-                "refresh_token": {
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "usecase.PlayRequest": {
+        "usecase.SelfExcludeRequest": {
             "type": "object",
             "properties": {
-                "amount_bet": {
+                "days": {
                     "type": "integer"
                 },
-                "machine_id": {
-                    "type": "string"
+                "permanent": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.ExclusionType"
                 }
             }
         },
-        "usecase.PlayResponse": {
+        "usecase.SelfExcludeResponse": {
             "type": "object",
             "properties": {
-                "player_balance": {
+                "exclusion": {
+                    "$ref": "#/definitions/model.SelfExclusion"
+                }
+            }
+        },
+        "usecase.SetGamblingLimitRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
                     "type": "integer"
                 },
-                "result": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "period": {
+                    "$ref": "#/definitions/model.LimitPeriod"
                 },
-                "slot_machine_balance": {
+                "type": {
+                    "$ref": "#/definitions/model.LimitType"
+                }
+            }
+        },
+        "usecase.SetGamblingLimitResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "$ref": "#/definitions/model.GamblingLimit"
+                }
+            }
+        },
+        "usecase.SetRealityCheckRequest": {
+            "type": "object",
+            "properties": {
+                "interval_minutes": {
                     "type": "integer"
-                },
-                "win": {
-                    "type": "boolean"
                 }
             }
         },
-        "usecase.RefreshTokenRequest": {
+        "usecase.SetRealityCheckResponse": {
             "type": "object",
             "properties": {
-                "refresh_token": {
+                "interval_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.VerifyEmailRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "usecase.RefreshTokenResponse": {
+        "usecase.VerifyMFARequest": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "code": {
                     "type": "string"
                 },
-                "refresh_token": {
+                "mfa_token": {
+                    "type": "string"
+                },
+                "recovery_code": {
                     "type": "string"
                 }
             }
@@ -604,7 +5563,7 @@ const docTemplate = `{
     "securityDefinitions": {
         "AdminAuth": {
             "type": "apiKey",
-            "name": "X-Admin-Secret",
+            "name": "X-API-Key",
             "in": "header"
         },
         "BearerAuth": {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"slot-machine/internal/application/usecase"
 	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
 )
 
 func HandleError(w http.ResponseWriter, err error) {
@@ -15,6 +16,213 @@ func HandleError(w http.ResponseWriter, err error) {
 			Code:    http.StatusUnprocessableEntity,
 			Message: "Insufficient balance",
 		})
+	case usecase.ErrTooManyLoginAttempts:
+		w.WriteHeader(http.StatusTooManyRequests)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusTooManyRequests,
+			Message: "Too many login attempts, try again later",
+		})
+	case usecase.ErrAccountLocked:
+		w.WriteHeader(http.StatusLocked)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusLocked,
+			Message: "Account temporarily locked",
+		})
+	case usecase.ErrValidate:
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Missing or invalid parameters",
+		})
+	case security.ErrPasswordTooShort, security.ErrPasswordTooLong, security.ErrPasswordBreached,
+		usecase.ErrPasswordReused, usecase.ErrInvalidResetToken, usecase.ErrInvalidEmail,
+		usecase.ErrInvalidVerificationToken:
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	case usecase.ErrInvalidCurrentPassword:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: "Current password is incorrect",
+		})
+	case usecase.ErrEmailNotVerified:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: "Email not verified",
+		})
+	case usecase.ErrDepositLimitExceeded, usecase.ErrLossLimitExceeded, usecase.ErrWagerLimitExceeded,
+		usecase.ErrSessionLimitReached:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: err.Error(),
+		})
+	case usecase.ErrSelfExcluded:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: "Account is self-excluded",
+		})
+	case usecase.ErrPlayerBlocked:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: "Account is blocked",
+		})
+	case usecase.ErrRealityCheckPending:
+		w.WriteHeader(http.StatusPreconditionRequired)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusPreconditionRequired,
+			Message: "Reality check must be acknowledged before playing",
+		})
+	case usecase.ErrExclusionCannotBeShortened, usecase.ErrPermanentExclusion:
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case usecase.ErrEmailAlreadyVerified:
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusConflict,
+			Message: "Email already verified",
+		})
+	case usecase.ErrInvalidMFAToken, usecase.ErrInvalidTOTPCode:
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: err.Error(),
+		})
+	case usecase.ErrTOTPAlreadyEnabled, usecase.ErrTOTPNotEnabled:
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case usecase.ErrTOTPRequiredForAdmins:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: err.Error(),
+		})
+	case usecase.ErrUnauthorized:
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+	case usecase.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: "API key does not have the required scope",
+		})
+	case usecase.ErrInvalidReasonCode:
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	case usecase.ErrSelfApproval:
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusForbidden,
+			Message: err.Error(),
+		})
+	case repository.ErrAdjustmentNotPending:
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusConflict,
+			Message: "Adjustment has already been reviewed",
+		})
+	case repository.ErrInsufficientTreasuryBalance, repository.ErrInsufficientMachineBalance:
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: err.Error(),
+		})
+	case usecase.ErrTransferLimitExceeded:
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: err.Error(),
+		})
+	case repository.ErrNegativeBalance:
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: err.Error(),
+		})
+	case usecase.ErrInvalidScope:
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	case repository.ErrSelfExclusionNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusNotFound,
+			Message: "No active self-exclusion",
+		})
+	case repository.ErrAdjustmentNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusNotFound,
+			Message: "Adjustment not found",
+		})
+	case repository.ErrJackpotPoolNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusNotFound,
+			Message: "Jackpot pool not found",
+		})
+	case repository.ErrMachineNotLinked:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case repository.ErrMachineAlreadyLinked:
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case repository.ErrWebhookSubscriptionNotFound, repository.ErrWebhookDeliveryNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case usecase.ErrWebhookDeliveryNotDead:
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case repository.ErrTournamentNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusNotFound,
+			Message: "Tournament not found",
+		})
+	case usecase.ErrTournamentClosed, repository.ErrTournamentEntryExists:
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case repository.ErrAPIKeyNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(HTTPError{
+			Code:    http.StatusNotFound,
+			Message: "API key not found",
+		})
 	case repository.ErrPlayerNotFound, repository.ErrSlotMachineNotFound:
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode(HTTPError{
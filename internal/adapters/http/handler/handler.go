@@ -2,21 +2,79 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	handler_error "slot-machine/internal/adapters/http/handler/error"
 	"slot-machine/internal/adapters/http/middleware"
 	"slot-machine/internal/application/usecase"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
 	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
 )
 
 type Handler struct {
-	CreatePlayerUseCase          *usecase.CreatePlayerUseCase
-	CreateSlotMachineUseCase     *usecase.CreateSlotMachineUseCase
-	PlayUseCase                  *usecase.PlayUseCase
-	GetPlayerBalanceUseCase      *usecase.GetPlayerBalanceUseCase
-	GetSlotMachineBalanceUseCase *usecase.GetSlotMachineBalanceUseCase
-	loginUseCase                 *usecase.LoginUseCase
-	refreshTokenUseCase *usecase.RefreshTokenUseCase
+	CreatePlayerUseCase            *usecase.CreatePlayerUseCase
+	CreateSlotMachineUseCase       *usecase.CreateSlotMachineUseCase
+	PlayUseCase                    *usecase.PlayUseCase
+	GetPlayerBalanceUseCase        *usecase.GetPlayerBalanceUseCase
+	GetSlotMachineBalanceUseCase   *usecase.GetSlotMachineBalanceUseCase
+	loginUseCase                   *usecase.LoginUseCase
+	refreshTokenUseCase            *usecase.RefreshTokenUseCase
+	ChangePasswordUseCase          *usecase.ChangePasswordUseCase
+	RequestPasswordResetUseCase    *usecase.RequestPasswordResetUseCase
+	ResetPasswordUseCase           *usecase.ResetPasswordUseCase
+	VerifyEmailUseCase             *usecase.VerifyEmailUseCase
+	SendEmailVerificationUseCase   *usecase.SendEmailVerificationUseCase
+	VerifyMFAUseCase               *usecase.VerifyMFAUseCase
+	EnrollTOTPUseCase              *usecase.EnrollTOTPUseCase
+	ConfirmTOTPUseCase             *usecase.ConfirmTOTPUseCase
+	DisableTOTPUseCase             *usecase.DisableTOTPUseCase
+	CreateAPIKeyUseCase            *usecase.CreateAPIKeyUseCase
+	ListAPIKeysUseCase             *usecase.ListAPIKeysUseCase
+	RevokeAPIKeyUseCase            *usecase.RevokeAPIKeyUseCase
+	ListAuditEntriesUseCase        *usecase.ListAuditEntriesUseCase
+	DepositUseCase                 *usecase.DepositUseCase
+	SetGamblingLimitUseCase        *usecase.SetGamblingLimitUseCase
+	GetGamblingLimitsUseCase       *usecase.GetGamblingLimitsUseCase
+	SelfExcludeUseCase             *usecase.SelfExcludeUseCase
+	GetSelfExclusionUseCase        *usecase.GetSelfExclusionUseCase
+	LiftSelfExclusionUseCase       *usecase.LiftSelfExclusionUseCase
+	SetRealityCheckUseCase         *usecase.SetRealityCheckUseCase
+	AcknowledgeRealityCheckUseCase *usecase.AcknowledgeRealityCheckUseCase
+	ListPlayersUseCase             *usecase.ListPlayersUseCase
+	GetPlayerDetailsUseCase        *usecase.GetPlayerDetailsUseCase
+	BlockPlayerUseCase             *usecase.BlockPlayerUseCase
+	UnblockPlayerUseCase           *usecase.UnblockPlayerUseCase
+	ProposeAdjustmentUseCase       *usecase.ProposeAdjustmentUseCase
+	ApproveAdjustmentUseCase       *usecase.ApproveAdjustmentUseCase
+	RejectAdjustmentUseCase        *usecase.RejectAdjustmentUseCase
+	ListAdjustmentsUseCase         *usecase.ListAdjustmentsUseCase
+	RefillSlotMachineUseCase       *usecase.RefillSlotMachineUseCase
+	CashoutSlotMachineUseCase      *usecase.CashoutSlotMachineUseCase
+	GetTreasuryUseCase             *usecase.GetTreasuryUseCase
+	GetSlotMachineStatsUseCase     *usecase.GetSlotMachineStatsUseCase
+	GetPlayerProfileUseCase        *usecase.GetPlayerProfileUseCase
+	CreateJackpotPoolUseCase       *usecase.CreateJackpotPoolUseCase
+	LinkJackpotMachineUseCase      *usecase.LinkJackpotMachineUseCase
+	UnlinkJackpotMachineUseCase    *usecase.UnlinkJackpotMachineUseCase
+	ListJackpotsUseCase            *usecase.ListJackpotsUseCase
+	PlayBatchUseCase               *usecase.PlayBatchUseCase
+	EventSubscriber                ports.EventSubscriber
+	CreateWebhookUseCase           *usecase.CreateWebhookUseCase
+	ListWebhooksUseCase            *usecase.ListWebhooksUseCase
+	DeleteWebhookUseCase           *usecase.DeleteWebhookUseCase
+	ListWebhookDeliveriesUseCase   *usecase.ListWebhookDeliveriesUseCase
+	RetryWebhookDeliveryUseCase    *usecase.RetryWebhookDeliveryUseCase
+	CreateTournamentUseCase        *usecase.CreateTournamentUseCase
+	ListTournamentsUseCase         *usecase.ListTournamentsUseCase
+	JoinTournamentUseCase          *usecase.JoinTournamentUseCase
+	GetLeaderboardUseCase          *usecase.GetLeaderboardUseCase
 }
 
 func NewHandler(
@@ -27,29 +85,129 @@ func NewHandler(
 	gsmUC *usecase.GetSlotMachineBalanceUseCase,
 	loginUC *usecase.LoginUseCase,
 	refreshUC *usecase.RefreshTokenUseCase,
+	changePasswordUC *usecase.ChangePasswordUseCase,
+	requestPasswordResetUC *usecase.RequestPasswordResetUseCase,
+	resetPasswordUC *usecase.ResetPasswordUseCase,
+	verifyEmailUC *usecase.VerifyEmailUseCase,
+	sendEmailVerificationUC *usecase.SendEmailVerificationUseCase,
+	verifyMFAUC *usecase.VerifyMFAUseCase,
+	enrollTOTPUC *usecase.EnrollTOTPUseCase,
+	confirmTOTPUC *usecase.ConfirmTOTPUseCase,
+	disableTOTPUC *usecase.DisableTOTPUseCase,
+	createAPIKeyUC *usecase.CreateAPIKeyUseCase,
+	listAPIKeysUC *usecase.ListAPIKeysUseCase,
+	revokeAPIKeyUC *usecase.RevokeAPIKeyUseCase,
+	listAuditEntriesUC *usecase.ListAuditEntriesUseCase,
+	depositUC *usecase.DepositUseCase,
+	setGamblingLimitUC *usecase.SetGamblingLimitUseCase,
+	getGamblingLimitsUC *usecase.GetGamblingLimitsUseCase,
+	selfExcludeUC *usecase.SelfExcludeUseCase,
+	getSelfExclusionUC *usecase.GetSelfExclusionUseCase,
+	liftSelfExclusionUC *usecase.LiftSelfExclusionUseCase,
+	setRealityCheckUC *usecase.SetRealityCheckUseCase,
+	acknowledgeRealityCheckUC *usecase.AcknowledgeRealityCheckUseCase,
+	listPlayersUC *usecase.ListPlayersUseCase,
+	getPlayerDetailsUC *usecase.GetPlayerDetailsUseCase,
+	blockPlayerUC *usecase.BlockPlayerUseCase,
+	unblockPlayerUC *usecase.UnblockPlayerUseCase,
+	proposeAdjustmentUC *usecase.ProposeAdjustmentUseCase,
+	approveAdjustmentUC *usecase.ApproveAdjustmentUseCase,
+	rejectAdjustmentUC *usecase.RejectAdjustmentUseCase,
+	listAdjustmentsUC *usecase.ListAdjustmentsUseCase,
+	refillSlotMachineUC *usecase.RefillSlotMachineUseCase,
+	cashoutSlotMachineUC *usecase.CashoutSlotMachineUseCase,
+	getTreasuryUC *usecase.GetTreasuryUseCase,
+	getSlotMachineStatsUC *usecase.GetSlotMachineStatsUseCase,
+	getPlayerProfileUC *usecase.GetPlayerProfileUseCase,
+	createJackpotPoolUC *usecase.CreateJackpotPoolUseCase,
+	linkJackpotMachineUC *usecase.LinkJackpotMachineUseCase,
+	unlinkJackpotMachineUC *usecase.UnlinkJackpotMachineUseCase,
+	listJackpotsUC *usecase.ListJackpotsUseCase,
+	playBatchUC *usecase.PlayBatchUseCase,
+	eventSubscriber ports.EventSubscriber,
+	createWebhookUC *usecase.CreateWebhookUseCase,
+	listWebhooksUC *usecase.ListWebhooksUseCase,
+	deleteWebhookUC *usecase.DeleteWebhookUseCase,
+	listWebhookDeliveriesUC *usecase.ListWebhookDeliveriesUseCase,
+	retryWebhookDeliveryUC *usecase.RetryWebhookDeliveryUseCase,
+	createTournamentUC *usecase.CreateTournamentUseCase,
+	listTournamentsUC *usecase.ListTournamentsUseCase,
+	joinTournamentUC *usecase.JoinTournamentUseCase,
+	getLeaderboardUC *usecase.GetLeaderboardUseCase,
 ) *Handler {
 	return &Handler{
-		CreatePlayerUseCase:          cpUC,
-		CreateSlotMachineUseCase:     csmUC,
-		PlayUseCase:                  pUC,
-		GetPlayerBalanceUseCase:      gpUC,
-		GetSlotMachineBalanceUseCase: gsmUC,
-		loginUseCase:                 loginUC,
-		refreshTokenUseCase: refreshUC,
+		CreatePlayerUseCase:            cpUC,
+		CreateSlotMachineUseCase:       csmUC,
+		PlayUseCase:                    pUC,
+		GetPlayerBalanceUseCase:        gpUC,
+		GetSlotMachineBalanceUseCase:   gsmUC,
+		loginUseCase:                   loginUC,
+		refreshTokenUseCase:            refreshUC,
+		ChangePasswordUseCase:          changePasswordUC,
+		RequestPasswordResetUseCase:    requestPasswordResetUC,
+		ResetPasswordUseCase:           resetPasswordUC,
+		VerifyEmailUseCase:             verifyEmailUC,
+		SendEmailVerificationUseCase:   sendEmailVerificationUC,
+		VerifyMFAUseCase:               verifyMFAUC,
+		EnrollTOTPUseCase:              enrollTOTPUC,
+		ConfirmTOTPUseCase:             confirmTOTPUC,
+		DisableTOTPUseCase:             disableTOTPUC,
+		CreateAPIKeyUseCase:            createAPIKeyUC,
+		ListAPIKeysUseCase:             listAPIKeysUC,
+		RevokeAPIKeyUseCase:            revokeAPIKeyUC,
+		ListAuditEntriesUseCase:        listAuditEntriesUC,
+		DepositUseCase:                 depositUC,
+		SetGamblingLimitUseCase:        setGamblingLimitUC,
+		GetGamblingLimitsUseCase:       getGamblingLimitsUC,
+		SelfExcludeUseCase:             selfExcludeUC,
+		GetSelfExclusionUseCase:        getSelfExclusionUC,
+		LiftSelfExclusionUseCase:       liftSelfExclusionUC,
+		SetRealityCheckUseCase:         setRealityCheckUC,
+		AcknowledgeRealityCheckUseCase: acknowledgeRealityCheckUC,
+		ListPlayersUseCase:             listPlayersUC,
+		GetPlayerDetailsUseCase:        getPlayerDetailsUC,
+		BlockPlayerUseCase:             blockPlayerUC,
+		UnblockPlayerUseCase:           unblockPlayerUC,
+		ProposeAdjustmentUseCase:       proposeAdjustmentUC,
+		ApproveAdjustmentUseCase:       approveAdjustmentUC,
+		RejectAdjustmentUseCase:        rejectAdjustmentUC,
+		ListAdjustmentsUseCase:         listAdjustmentsUC,
+		RefillSlotMachineUseCase:       refillSlotMachineUC,
+		CashoutSlotMachineUseCase:      cashoutSlotMachineUC,
+		GetTreasuryUseCase:             getTreasuryUC,
+		GetSlotMachineStatsUseCase:     getSlotMachineStatsUC,
+		GetPlayerProfileUseCase:        getPlayerProfileUC,
+		CreateJackpotPoolUseCase:       createJackpotPoolUC,
+		LinkJackpotMachineUseCase:      linkJackpotMachineUC,
+		UnlinkJackpotMachineUseCase:    unlinkJackpotMachineUC,
+		ListJackpotsUseCase:            listJackpotsUC,
+		PlayBatchUseCase:               playBatchUC,
+		EventSubscriber:                eventSubscriber,
+		CreateWebhookUseCase:           createWebhookUC,
+		ListWebhooksUseCase:            listWebhooksUC,
+		DeleteWebhookUseCase:           deleteWebhookUC,
+		ListWebhookDeliveriesUseCase:   listWebhookDeliveriesUC,
+		RetryWebhookDeliveryUseCase:    retryWebhookDeliveryUC,
+		CreateTournamentUseCase:        createTournamentUC,
+		ListTournamentsUseCase:         listTournamentsUC,
+		JoinTournamentUseCase:          joinTournamentUC,
+		GetLeaderboardUseCase:          getLeaderboardUC,
 	}
 }
 
 // PlaySlotMachine permite que o jogador jogue na máquina caça-níqueis.
 // @Summary Jogar na máquina caça-níqueis
-// @Description Permite que o jogador faça uma aposta e jogue na máquina caça-níqueis especificada.
+// @Description Permite que o jogador faça uma aposta e jogue na máquina caça-níqueis especificada. amount_bet é a aposta por linha e lines o número de linhas de pagamento jogadas (todas, se omitido); a aposta total é amount_bet × lines e result traz a grade sorteada, linha a linha. Quando o intervalo de reality check do jogador termina, a resposta traz reality_check e a próxima jogada é recusada até a confirmação. Se o jogador tiver rodadas grátis na máquina, a jogada consome uma delas com a aposta que as concedeu, sem cobrar amount_bet; free_spins_remaining e bonus informam o andamento da sequência. Em jogadas sem prêmio, se a jurisdição da máquina permitir, near_miss mostra os símbolos das fitas logo acima e abaixo da grade; o resultado nunca é alterado.
 // @Tags SlotMachine
 // @Accept json
 // @Produce json
 // @Param playRequest body usecase.PlayRequest true "Dados da jogada"
 // @Success 200 {object} usecase.PlayResponse "Jogada realizada com sucesso"
-// @Failure 400 {object} handler_error.HTTPError "Payload inválido"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido ou número de linhas inválido"
+// @Failure 403 {object} handler_error.HTTPError "Email não verificado, conta em exclusão ou limite de jogo atingido"
 // @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
 // @Failure 422 {object} handler_error.HTTPError "Saldo insuficiente"
+// @Failure 428 {object} handler_error.HTTPError "Reality check pendente de confirmação"
 // @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
 // @Router /play [post]
 // @Security BearerAuth
@@ -87,15 +245,65 @@ func (h *Handler) PlaySlotMachine(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// PlaySlotMachineBatch executa uma sequência de jogadas automáticas.
+// @Summary Jogar em sequência (autoplay)
+// @Description Executa até 100 jogadas (spins) com a mesma aposta de /play, liquidando cada uma separadamente com todos os limites de aposta e de jogo responsável. A sequência para antes se uma jogada pagar mais que stop_on_win_above, se o saldo ficar abaixo de stop_on_balance_below, em qualquer jackpot com stop_on_jackpot ou quando um reality check for devido. Se um limite ou o saldo impedir uma jogada após a primeira, a resposta traz as jogadas já liquidadas com stop_reason "limit" e o motivo em error; qualquer outra falha após a primeira jogada encerra a sequência com stop_reason "error".
+// @Tags SlotMachine
+// @Accept json
+// @Produce json
+// @Param playBatchRequest body usecase.PlayBatchRequest true "Dados da sequência de jogadas"
+// @Success 200 {object} usecase.PlayBatchResponse "Jogadas realizadas com sucesso"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido, número de jogadas ou de linhas inválido"
+// @Failure 403 {object} handler_error.HTTPError "Email não verificado, conta em exclusão ou limite de jogo atingido"
+// @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
+// @Failure 422 {object} handler_error.HTTPError "Saldo insuficiente"
+// @Failure 428 {object} handler_error.HTTPError "Reality check pendente de confirmação"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /play/batch [post]
+// @Security BearerAuth
+func (h *Handler) PlaySlotMachineBatch(w http.ResponseWriter, r *http.Request) {
+	var req usecase.PlayBatchRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	req.PlayerID = userID
+
+	response, err := h.PlayBatchUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
 // CreatePlayer permite a criação de um novo jogador.
 // @Summary Criar um novo jogador
-// @Description Permite a criação de um novo jogador com um saldo inicial.
+// @Description Permite a criação de um novo jogador com um saldo inicial. O email é normalizado e um token de verificação é enviado.
 // @Tags Player
 // @Accept json
 // @Produce json
 // @Param createPlayerRequest body usecase.CreatePlayerRequest true "Dados do jogador a ser criado"
 // @Success 201 {object} usecase.CreatePlayerResponse "Jogador criado com sucesso"
-// @Failure 400 {object} handler_error.HTTPError "Payload inválido"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido, email inválido ou senha fora da política"
 // @Failure 409 {object} handler_error.HTTPError "Jogador já existe"
 // @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
 // @Router /players [post]
@@ -130,6 +338,10 @@ func (h *Handler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 				Message: "email and password must be provided",
 			})
 
+			return
+		} else if err == usecase.ErrInvalidEmail || err == security.ErrPasswordTooShort || err == security.ErrPasswordTooLong || err == security.ErrPasswordBreached {
+			handler_error.HandleError(w, err)
+
 			return
 		}
 		w.WriteHeader(http.StatusInternalServerError)
@@ -147,13 +359,15 @@ func (h *Handler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 
 // CreateSlotMachine permite a criação de uma nova máquina caça-níqueis.
 // @Summary Criar uma nova máquina caça-níqueis
-// @Description Permite a criação de uma nova máquina caça-níqueis com os parâmetros especificados.
+// @Description Permite a criação de uma nova máquina caça-níqueis com os parâmetros especificados. reels e rows definem a grade (padrão 3x1), paylines as linhas de pagamento e paytable o multiplicador por quantidade de símbolos iguais a partir do primeiro rolo; omitidos, valem os padrões da grade. O campo opcional bonus define o símbolo scatter e quantas rodadas grátis (e com qual multiplicador) cada quantidade de scatters concede. strips define uma fita com pesos por rolo; omitido, vale a configuração equivalente ao level. near_miss (off ou reel_window) exige jurisdiction e só é exibido nas jurisdições permitidas pelo servidor.
 // @Tags SlotMachine
 // @Accept json
 // @Produce json
 // @Param createSlotMachineRequest body usecase.CreateSlotMachineRequest true "Dados da máquina caça-níqueis a ser criada"
 // @Success 201 {object} usecase.CreateSlotMachineResponse "Máquina criada com sucesso"
 // @Failure 400 {object} handler_error.HTTPError "Payload inválido ou parâmetros inválidos"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
 // @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
 // @Router /machines [post]
 // @Security AdminAuth
@@ -173,6 +387,10 @@ func (h *Handler) CreateSlotMachine(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.CreateSlotMachineUseCase.Execute(r.Context(), &req)
 	if err != nil {
+		if err == usecase.ErrForbidden {
+			handler_error.HandleError(w, err)
+			return
+		}
 		if err == usecase.ErrUnauthorized {
 			w.WriteHeader(http.StatusUnauthorized)
 			json.NewEncoder(w).Encode(handler_error.HTTPError{
@@ -214,7 +432,7 @@ func (h *Handler) CreateSlotMachine(w http.ResponseWriter, r *http.Request) {
 
 // LoginHandler realiza a autenticação do usuário e retorna um token JWT.
 // @Summary Login
-// @Description Autentica um usuário e retorna um token JWT.
+// @Description Autentica um usuário e retorna um token JWT. Contas com 2FA (e administradores, para quem o 2FA é obrigatório) recebem apenas um mfa_token, a ser usado em /login/2fa ou no cadastro do TOTP.
 // @Tags Authentication
 // @Accept json
 // @Produce json
@@ -222,6 +440,9 @@ func (h *Handler) CreateSlotMachine(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} usecase.LoginResponse
 // @Failure 400 {object} handler_error.HTTPError "Requisição inválida"
 // @Failure 401 {object} handler_error.HTTPError "Credenciais inválidas"
+// @Failure 403 {object} handler_error.HTTPError "Conta autoexcluída"
+// @Failure 423 {object} handler_error.HTTPError "Conta temporariamente bloqueada"
+// @Failure 429 {object} handler_error.HTTPError "Muitas tentativas de login"
 // @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
 // @Router /login [post]
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
@@ -233,13 +454,16 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.IP = middleware.ClientIP(r)
+
 	resp, err := h.loginUseCase.Execute(r.Context(), &req)
 	if err != nil {
 		if err == usecase.ErrInvalidCredentials {
 			http.Error(w, err.Error(), http.StatusUnauthorized)
 			return
 		}
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		w.Header().Set("Content-Type", "application/json")
+		handler_error.HandleError(w, err)
 		return
 	}
 
@@ -260,48 +484,52 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 // @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
 // @Router /refresh [post]
 func (h *Handler) Refresh(w http.ResponseWriter, r *http.Request) {
-    var req usecase.RefreshTokenRequest
-    decoder := json.NewDecoder(r.Body)
-    decoder.DisallowUnknownFields()
-    if err := decoder.Decode(&req); err != nil {
-        http.Error(w, "Invalid request payload", http.StatusBadRequest)
-        return
-    }
-
-    resp, err := h.refreshTokenUseCase.Execute(r.Context(), &req)
-    if err != nil {
-        if err == usecase.ErrInvalidRefreshToken {
+	var req usecase.RefreshTokenRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.refreshTokenUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		if err == usecase.ErrInvalidRefreshToken {
 			w.WriteHeader(http.StatusUnauthorized)
 			json.NewEncoder(w).Encode(handler_error.HTTPError{
 				Code:    http.StatusUnauthorized,
 				Message: err.Error(),
 			})
 
+			return
+		}
 
-            return
-        }
-		
-        http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-        return
-    }
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
 
-    w.Header().Set("Content-Type", "application/json")
-    json.NewEncoder(w).Encode(resp)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
 }
 
-// GetPlayerBalance retorna o saldo do jogador.
-// @Summary Obter saldo do jogador
-// @Description Retorna o saldo do jogador especificado.
+// ChangePassword troca a senha do jogador autenticado.
+// @Summary Trocar senha
+// @Description Troca a senha do jogador após confirmar a senha atual. Todas as outras sessões são revogadas e um novo par de tokens é retornado.
 // @Tags Player
 // @Accept json
 // @Produce json
-// @Success 200 {object} usecase.GetPlayerBalanceResponse "Saldo do jogador"
+// @Param changePasswordRequest body usecase.ChangePasswordRequest true "Senha atual e nova senha"
+// @Success 200 {object} usecase.ChangePasswordResponse "Senha alterada com sucesso"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido ou senha fora da política"
 // @Failure 401 {object} handler_error.HTTPError "Não autorizado"
-// @Failure 404 {object} handler_error.HTTPError "Jogador não encontrado"
+// @Failure 403 {object} handler_error.HTTPError "Senha atual incorreta"
 // @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
-// @Router /players/balance [get]
+// @Router /players/password [post]
 // @Security BearerAuth
-func (h *Handler) GetPlayerBalance(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req usecase.ChangePasswordRequest
+	w.Header().Set("Content-Type", "application/json")
+
 	userID, err := middleware.GetUserIDFromContext(r.Context())
 	if err != nil {
 		w.WriteHeader(http.StatusUnauthorized)
@@ -312,84 +540,1898 @@ func (h *Handler) GetPlayerBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := usecase.GetPlayerBalanceRequest{
-		PlayerID: userID,
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
 	}
 
-	resp, err := h.GetPlayerBalanceUseCase.Execute(r.Context(), &req)
+	req.PlayerID = userID
+
+	resp, err := h.ChangePasswordUseCase.Execute(r.Context(), &req)
 	if err != nil {
-		if err == repository.ErrPlayerNotFound {
-			http.Error(w, "Player not found", http.StatusNotFound)
-			return
-		}
-		http.Error(w, "Unable to get player balance", http.StatusInternalServerError)
+		handler_error.HandleError(w, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
 
-// GetSlotMachineBalance retorna o saldo da máquina caça-níqueis.
-// @Summary Obter saldo da máquina caça-níqueis
-// @Description Retorna o saldo da máquina caça-níqueis especificada.
-// @Tags SlotMachine
+// RequestPasswordReset envia um token de redefinição de senha.
+// @Summary Solicitar redefinição de senha
+// @Description Envia um token de redefinição para o email informado. A resposta é a mesma exista ou não a conta.
+// @Tags Authentication
 // @Accept json
 // @Produce json
-// @Param machine_id query string true "ID da máquina caça-níqueis"
-// @Success 200 {object} usecase.GetSlotMachineBalanceResponse "Saldo da máquina caça-níqueis"
-// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
-// @Failure 400 {object} handler_error.HTTPError "ID da máquina caça-níqueis é obrigatório"
-// @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
+// @Param requestPasswordResetRequest body usecase.RequestPasswordResetRequest true "Email da conta"
+// @Success 202 "Solicitação aceita"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido"
 // @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
-// @Router /machines/balance [get]
-// @Security AdminAuth
-func (h *Handler) GetSlotMachineBalance(w http.ResponseWriter, r *http.Request) {
+// @Router /password/forgot [post]
+func (h *Handler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req usecase.RequestPasswordResetRequest
 	w.Header().Set("Content-Type", "application/json")
 
-	machineID := r.URL.Query().Get("machine_id")
-	if machineID == "" {
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(handler_error.HTTPError{
 			Code:    http.StatusBadRequest,
-			Message: "machine_id is required",
+			Message: "Invalid request payload",
 		})
+		return
+	}
 
+	if err := h.RequestPasswordResetUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
 		return
 	}
 
-	req := usecase.GetSlotMachineBalanceRequest{
-		MachineID: machineID,
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ResetPassword redefine a senha usando o token recebido.
+// @Summary Redefinir senha
+// @Description Redefine a senha do jogador com um token de redefinição válido e revoga todas as sessões.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param resetPasswordRequest body usecase.ResetPasswordRequest true "Token e nova senha"
+// @Success 204 "Senha redefinida com sucesso"
+// @Failure 400 {object} handler_error.HTTPError "Token inválido ou senha fora da política"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /password/reset [post]
+func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req usecase.ResetPasswordRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
 	}
 
-	resp, err := h.GetSlotMachineBalanceUseCase.Execute(r.Context(), &req)
+	if err := h.ResetPasswordUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// VerifyEmail confirma o email do jogador.
+// @Summary Verificar email
+// @Description Confirma o email do jogador com o token enviado no cadastro. Jogadores só podem jogar após a verificação.
+// @Tags Player
+// @Accept json
+// @Produce json
+// @Param verifyEmailRequest body usecase.VerifyEmailRequest true "Token de verificação"
+// @Success 204 "Email verificado com sucesso"
+// @Failure 400 {object} handler_error.HTTPError "Token inválido ou expirado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/verify-email [post]
+func (h *Handler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req usecase.VerifyEmailRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	if err := h.VerifyEmailUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ResendEmailVerification reenvia o token de verificação de email.
+// @Summary Reenviar verificação de email
+// @Description Gera um novo token de verificação para o jogador autenticado, invalidando o anterior.
+// @Tags Player
+// @Produce json
+// @Success 202 "Token reenviado"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 409 {object} handler_error.HTTPError "Email já verificado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/verify-email/resend [post]
+// @Security BearerAuth
+func (h *Handler) ResendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
 	if err != nil {
-		if err == usecase.ErrUnauthorized {
-			w.WriteHeader(http.StatusUnauthorized)
-			json.NewEncoder(w).Encode(handler_error.HTTPError{
-				Code:    http.StatusUnauthorized,
-				Message: "Unauthorized",
-			})
-			return
-		}
-		if err == repository.ErrSlotMachineNotFound {
-			w.WriteHeader(http.StatusNotFound)
-			json.NewEncoder(w).Encode(handler_error.HTTPError{
-				Code:    http.StatusNotFound,
-				Message: "Slot machine not found",
-			})
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
 
-			return
-		}
+	req := usecase.SendEmailVerificationRequest{
+		PlayerID: userID,
+	}
 
-		w.WriteHeader(http.StatusInternalServerError)
+	if err := h.SendEmailVerificationUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// VerifyMFA conclui o login com o segundo fator.
+// @Summary Login com segundo fator
+// @Description Conclui o login de contas com 2FA usando o mfa_token e um código TOTP ou um código de recuperação.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param verifyMFARequest body usecase.VerifyMFARequest true "Token de MFA e código"
+// @Success 200 {object} usecase.LoginResponse
+// @Failure 400 {object} handler_error.HTTPError "Requisição inválida"
+// @Failure 401 {object} handler_error.HTTPError "Token de MFA ou código inválido"
+// @Failure 423 {object} handler_error.HTTPError "Conta temporariamente bloqueada"
+// @Failure 429 {object} handler_error.HTTPError "Muitas tentativas"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /login/2fa [post]
+func (h *Handler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req usecase.VerifyMFARequest
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(handler_error.HTTPError{
-			Code:    http.StatusInternalServerError,
-			Message: "Unable to get slot machine balance",
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	resp, err := h.VerifyMFAUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// EnrollTOTP inicia o cadastro do TOTP.
+// @Summary Iniciar cadastro do 2FA
+// @Description Gera um segredo TOTP e a URI otpauth para o aplicativo autenticador. Jogadores autenticados usam /players/2fa/enroll; administradores sem 2FA usam /login/2fa/enroll com o mfa_token recebido no login.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param enrollTOTPRequest body usecase.EnrollTOTPRequest false "Token de MFA (apenas durante o login)"
+// @Success 200 {object} usecase.EnrollTOTPResponse
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 409 {object} handler_error.HTTPError "2FA já habilitado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/2fa/enroll [post]
+// @Router /login/2fa/enroll [post]
+// @Security BearerAuth
+func (h *Handler) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	var req usecase.EnrollTOTPRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
 		})
+		return
+	}
+
+	if userID, err := middleware.GetUserIDFromContext(r.Context()); err == nil {
+		req.PlayerID = userID
+	}
 
+	resp, err := h.EnrollTOTPUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
 		return
 	}
 
 	json.NewEncoder(w).Encode(resp)
 }
+
+// ConfirmTOTP confirma o cadastro do TOTP.
+// @Summary Confirmar cadastro do 2FA
+// @Description Habilita o 2FA após validar um código do aplicativo autenticador e retorna os códigos de recuperação, exibidos uma única vez. Durante o login (/login/2fa/confirm) também retorna os tokens da sessão.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param confirmTOTPRequest body usecase.ConfirmTOTPRequest true "Código TOTP"
+// @Success 200 {object} usecase.ConfirmTOTPResponse
+// @Failure 400 {object} handler_error.HTTPError "Requisição inválida"
+// @Failure 401 {object} handler_error.HTTPError "Código inválido"
+// @Failure 409 {object} handler_error.HTTPError "2FA já habilitado ou cadastro não iniciado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/2fa/confirm [post]
+// @Router /login/2fa/confirm [post]
+// @Security BearerAuth
+func (h *Handler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	var req usecase.ConfirmTOTPRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	if userID, err := middleware.GetUserIDFromContext(r.Context()); err == nil {
+		req.PlayerID = userID
+	}
+
+	resp, err := h.ConfirmTOTPUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// DisableTOTP desabilita o 2FA do jogador.
+// @Summary Desabilitar 2FA
+// @Description Desabilita o 2FA após confirmar a senha e um código TOTP. Não é permitido para administradores.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param disableTOTPRequest body usecase.DisableTOTPRequest true "Senha e código TOTP"
+// @Success 204 "2FA desabilitado"
+// @Failure 400 {object} handler_error.HTTPError "Requisição inválida"
+// @Failure 401 {object} handler_error.HTTPError "Código inválido"
+// @Failure 403 {object} handler_error.HTTPError "Senha incorreta ou 2FA obrigatório"
+// @Failure 409 {object} handler_error.HTTPError "2FA não habilitado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/2fa/disable [post]
+// @Security BearerAuth
+func (h *Handler) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	var req usecase.DisableTOTPRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	req.PlayerID = userID
+
+	if err := h.DisableTOTPUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPlayerBalance retorna o saldo do jogador.
+// @Summary Obter saldo do jogador
+// @Description Retorna o saldo do jogador especificado.
+// @Tags Player
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecase.GetPlayerBalanceResponse "Saldo do jogador"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 404 {object} handler_error.HTTPError "Jogador não encontrado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/balance [get]
+// @Security BearerAuth
+func (h *Handler) GetPlayerBalance(w http.ResponseWriter, r *http.Request) {
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	req := usecase.GetPlayerBalanceRequest{
+		PlayerID: userID,
+	}
+
+	resp, err := h.GetPlayerBalanceUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		if err == repository.ErrPlayerNotFound {
+			http.Error(w, "Player not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Unable to get player balance", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetSlotMachineBalance retorna o saldo da máquina caça-níqueis.
+// @Summary Obter saldo da máquina caça-níqueis
+// @Description Retorna o saldo da máquina caça-níqueis especificada.
+// @Tags SlotMachine
+// @Accept json
+// @Produce json
+// @Param machine_id query string true "ID da máquina caça-níqueis"
+// @Success 200 {object} usecase.GetSlotMachineBalanceResponse "Saldo da máquina caça-níqueis"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 400 {object} handler_error.HTTPError "ID da máquina caça-níqueis é obrigatório"
+// @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /machines/balance [get]
+// @Security AdminAuth
+func (h *Handler) GetSlotMachineBalance(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	machineID := r.URL.Query().Get("machine_id")
+	if machineID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "machine_id is required",
+		})
+
+		return
+	}
+
+	req := usecase.GetSlotMachineBalanceRequest{
+		MachineID: machineID,
+	}
+
+	resp, err := h.GetSlotMachineBalanceUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		if err == usecase.ErrForbidden {
+			handler_error.HandleError(w, err)
+			return
+		}
+		if err == usecase.ErrUnauthorized {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(handler_error.HTTPError{
+				Code:    http.StatusUnauthorized,
+				Message: "Unauthorized",
+			})
+			return
+		}
+		if err == repository.ErrSlotMachineNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(handler_error.HTTPError{
+				Code:    http.StatusNotFound,
+				Message: "Slot machine not found",
+			})
+
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusInternalServerError,
+			Message: "Unable to get slot machine balance",
+		})
+
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreateAPIKey cria uma API key administrativa.
+// @Summary Criar API key
+// @Description Cria uma API key com nome, escopos e expiração opcional. A chave é exibida apenas nesta resposta; somente o hash é armazenado.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param createAPIKeyRequest body usecase.CreateAPIKeyRequest true "Dados da API key"
+// @Success 201 {object} usecase.CreateAPIKeyResponse "API key criada"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido ou escopo inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/api-keys [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	resp, err := h.CreateAPIKeyUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListAPIKeys lista as API keys administrativas.
+// @Summary Listar API keys
+// @Description Lista as API keys cadastradas, incluindo as revogadas e expiradas. Os segredos nunca são retornados.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} usecase.ListAPIKeysResponse "API keys cadastradas"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/api-keys [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	resp, err := h.ListAPIKeysUseCase.Execute(r.Context())
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RevokeAPIKey revoga uma API key.
+// @Summary Revogar API key
+// @Description Revoga a API key informada. Requisições posteriores com ela são rejeitadas.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID da API key"
+// @Success 204 "API key revogada"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "API key não encontrada"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/api-keys/{id} [delete]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.RevokeAPIKeyRequest{
+		ID: mux.Vars(r)["id"],
+	}
+
+	if err := h.RevokeAPIKeyUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListAuditEntries lista o log de auditoria das ações administrativas.
+// @Summary Listar log de auditoria
+// @Description Lista as ações administrativas registradas, das mais recentes para as mais antigas, com filtros opcionais.
+// @Tags Admin
+// @Produce json
+// @Param actor query string false "Ator que executou a ação"
+// @Param action query string false "Ação (ex: machine.create)"
+// @Param target_type query string false "Tipo do alvo (ex: machine)"
+// @Param target_id query string false "ID do alvo"
+// @Param from query string false "Data inicial (RFC3339)"
+// @Param to query string false "Data final, exclusiva (RFC3339)"
+// @Param limit query int false "Quantidade máxima de entradas (padrão 100, máximo 500)"
+// @Param offset query int false "Quantidade de entradas a pular"
+// @Success 200 {object} usecase.ListAuditEntriesResponse "Entradas do log de auditoria"
+// @Failure 400 {object} handler_error.HTTPError "Parâmetros inválidos"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /audit [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+	req := usecase.ListAuditEntriesRequest{
+		Actor:      query.Get("actor"),
+		Action:     query.Get("action"),
+		TargetType: query.Get("target_type"),
+		TargetID:   query.Get("target_id"),
+	}
+
+	var err error
+	if value := query.Get("from"); value != "" {
+		if req.From, err = time.Parse(time.RFC3339, value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+	if value := query.Get("to"); value != "" {
+		if req.To, err = time.Parse(time.RFC3339, value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+
+	resp, err := h.ListAuditEntriesUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// Deposit credita um depósito no saldo do jogador.
+// @Summary Depositar
+// @Description Credita o valor informado no saldo do jogador, respeitando os limites de depósito configurados.
+// @Tags Player
+// @Accept json
+// @Produce json
+// @Param depositRequest body usecase.DepositRequest true "Valor do depósito"
+// @Success 200 {object} usecase.DepositResponse "Depósito realizado"
+// @Failure 400 {object} handler_error.HTTPError "Valor inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Email não verificado, conta em exclusão ou limite de depósito atingido"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/deposit [post]
+// @Security BearerAuth
+func (h *Handler) Deposit(w http.ResponseWriter, r *http.Request) {
+	var req usecase.DepositRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	req.PlayerID = userID
+
+	resp, err := h.DepositUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// SetGamblingLimit define um limite de jogo responsável.
+// @Summary Definir limite de jogo
+// @Description Define um limite diário, semanal ou mensal (janela móvel) de depósito, perda ou aposta, ou a duração máxima da sessão em minutos (type session, sem period). Reduções valem imediatamente; aumentos e remoções (amount 0) só após o período de espera.
+// @Tags Player
+// @Accept json
+// @Produce json
+// @Param setGamblingLimitRequest body usecase.SetGamblingLimitRequest true "Limite"
+// @Success 200 {object} usecase.SetGamblingLimitResponse "Limite atualizado"
+// @Failure 400 {object} handler_error.HTTPError "Limite inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/limits [post]
+// @Security BearerAuth
+func (h *Handler) SetGamblingLimit(w http.ResponseWriter, r *http.Request) {
+	var req usecase.SetGamblingLimitRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	req.PlayerID = userID
+
+	resp, err := h.SetGamblingLimitUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetGamblingLimits lista os limites de jogo do jogador.
+// @Summary Listar limites de jogo
+// @Description Lista os limites ativos do jogador e as alterações pendentes com a data em que passam a valer.
+// @Tags Player
+// @Produce json
+// @Success 200 {object} usecase.GetGamblingLimitsResponse "Limites do jogador"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/limits [get]
+// @Security BearerAuth
+func (h *Handler) GetGamblingLimits(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	resp, err := h.GetGamblingLimitsUseCase.Execute(r.Context(), &usecase.GetGamblingLimitsRequest{PlayerID: userID})
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// SelfExclude inicia uma pausa ou autoexclusão do jogador.
+// @Summary Autoexclusão
+// @Description Inicia uma pausa (cool_off, de 1 a 42 dias) ou uma autoexclusão (self_exclusion, de 180 dias a 5 anos ou permanente). Todas as sessões são encerradas. Durante a pausa o jogador entra apenas com acesso de leitura; durante a autoexclusão o login é recusado. Uma exclusão ativa só pode ser prolongada.
+// @Tags Player
+// @Accept json
+// @Produce json
+// @Param selfExcludeRequest body usecase.SelfExcludeRequest true "Tipo e duração da exclusão"
+// @Success 200 {object} usecase.SelfExcludeResponse "Exclusão iniciada"
+// @Failure 400 {object} handler_error.HTTPError "Duração inválida"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 409 {object} handler_error.HTTPError "A exclusão ativa não pode ser encurtada"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/self-exclusion [post]
+// @Security BearerAuth
+func (h *Handler) SelfExclude(w http.ResponseWriter, r *http.Request) {
+	var req usecase.SelfExcludeRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	req.PlayerID = userID
+
+	resp, err := h.SelfExcludeUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetSelfExclusion retorna a exclusão vigente do jogador.
+// @Summary Consultar autoexclusão
+// @Description Retorna a pausa ou autoexclusão vigente do jogador, ou exclusion nulo quando não houver.
+// @Tags Player
+// @Produce json
+// @Success 200 {object} usecase.GetSelfExclusionResponse "Exclusão vigente"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/self-exclusion [get]
+// @Security BearerAuth
+func (h *Handler) GetSelfExclusion(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	resp, err := h.GetSelfExclusionUseCase.Execute(r.Context(), &usecase.GetSelfExclusionRequest{PlayerID: userID})
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// LiftSelfExclusion encerra antecipadamente a exclusão de um jogador.
+// @Summary Encerrar autoexclusão
+// @Description Encerra antecipadamente uma pausa ou autoexclusão por prazo determinado. Exclusões permanentes não podem ser encerradas.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID do jogador"
+// @Success 204 "Exclusão encerrada"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Nenhuma exclusão ativa"
+// @Failure 409 {object} handler_error.HTTPError "Exclusão permanente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/players/{id}/self-exclusion/lift [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) LiftSelfExclusion(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.LiftSelfExclusionRequest{
+		PlayerID: mux.Vars(r)["id"],
+	}
+
+	if err := h.LiftSelfExclusionUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetRealityCheck define o intervalo dos avisos de reality check.
+// @Summary Configurar reality check
+// @Description Define de quantos em quantos minutos (5 a 240) o jogador recebe um resumo da sessão de jogo. Zero desativa os avisos.
+// @Tags Player
+// @Accept json
+// @Produce json
+// @Param setRealityCheckRequest body usecase.SetRealityCheckRequest true "Intervalo em minutos"
+// @Success 200 {object} usecase.SetRealityCheckResponse "Intervalo atualizado"
+// @Failure 400 {object} handler_error.HTTPError "Intervalo inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/reality-check [post]
+// @Security BearerAuth
+func (h *Handler) SetRealityCheck(w http.ResponseWriter, r *http.Request) {
+	var req usecase.SetRealityCheckRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	req.PlayerID = userID
+
+	resp, err := h.SetRealityCheckUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// AcknowledgeRealityCheck confirma o reality check pendente.
+// @Summary Confirmar reality check
+// @Description Confirma o resumo de sessão recebido em /play, liberando a próxima jogada.
+// @Tags Player
+// @Produce json
+// @Success 204 "Reality check confirmado"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/reality-check/acknowledge [post]
+// @Security BearerAuth
+func (h *Handler) AcknowledgeRealityCheck(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	req := usecase.AcknowledgeRealityCheckRequest{PlayerID: userID}
+	if err := h.AcknowledgeRealityCheckUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListPlayers lista os jogadores para a administração.
+// @Summary Listar jogadores
+// @Description Lista os jogadores com paginação, busca por trecho do email e ordenação por data de cadastro ou saldo.
+// @Tags Admin
+// @Produce json
+// @Param email query string false "Trecho do email"
+// @Param sort query string false "Ordenação: created_at (padrão) ou balance"
+// @Param order query string false "Sentido: asc (padrão) ou desc"
+// @Param limit query int false "Quantidade máxima de jogadores (padrão 50, máximo 200)"
+// @Param offset query int false "Quantidade de jogadores a pular"
+// @Success 200 {object} usecase.ListPlayersResponse "Jogadores encontrados"
+// @Failure 400 {object} handler_error.HTTPError "Parâmetros inválidos"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/players [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ListPlayers(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+	req := usecase.ListPlayersRequest{
+		Email:  query.Get("email"),
+		SortBy: query.Get("sort"),
+		Order:  query.Get("order"),
+	}
+
+	var err error
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+
+	resp, err := h.ListPlayersUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetPlayerDetails retorna os dados de um jogador para a administração.
+// @Summary Detalhar jogador
+// @Description Retorna o perfil do jogador, os totais da carteira, a última sessão de jogo e as movimentações mais recentes.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID do jogador"
+// @Success 200 {object} usecase.GetPlayerDetailsResponse "Dados do jogador"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Jogador não encontrado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/players/{id} [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) GetPlayerDetails(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.GetPlayerDetailsRequest{
+		PlayerID: mux.Vars(r)["id"],
+	}
+
+	resp, err := h.GetPlayerDetailsUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// BlockPlayer bloqueia um jogador.
+// @Summary Bloquear jogador
+// @Description Bloqueia o jogador e encerra todas as suas sessões. Jogadores bloqueados não conseguem entrar nem usar tokens já emitidos.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do jogador"
+// @Param blockPlayerRequest body usecase.BlockPlayerRequest true "Motivo do bloqueio"
+// @Success 204 "Jogador bloqueado"
+// @Failure 400 {object} handler_error.HTTPError "Motivo ausente"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Jogador não encontrado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/players/{id}/block [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) BlockPlayer(w http.ResponseWriter, r *http.Request) {
+	var req usecase.BlockPlayerRequest
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+		return
+	}
+
+	req.PlayerID = mux.Vars(r)["id"]
+
+	if err := h.BlockPlayerUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnblockPlayer desbloqueia um jogador.
+// @Summary Desbloquear jogador
+// @Description Remove o bloqueio do jogador, que volta a poder entrar.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID do jogador"
+// @Success 204 "Jogador desbloqueado"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Jogador não encontrado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/players/{id}/unblock [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) UnblockPlayer(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.UnblockPlayerRequest{
+		PlayerID: mux.Vars(r)["id"],
+	}
+
+	if err := h.UnblockPlayerUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ProposeAdjustment propõe um ajuste manual de saldo.
+// @Summary Propor ajuste de saldo
+// @Description Propõe um crédito (valor positivo) ou débito (valor negativo) no saldo de um jogador ou máquina, com um código de motivo. O ajuste só é aplicado após a aprovação de outro administrador.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param proposeAdjustmentRequest body usecase.ProposeAdjustmentRequest true "Dados do ajuste"
+// @Success 201 {object} model.BalanceAdjustment "Ajuste proposto"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido ou código de motivo inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Jogador ou máquina não encontrado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/adjustments [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ProposeAdjustment(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.ProposeAdjustmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	resp, err := h.ProposeAdjustmentUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListAdjustments lista os ajustes de saldo.
+// @Summary Listar ajustes de saldo
+// @Description Lista os ajustes de saldo, dos mais recentes para os mais antigos, opcionalmente filtrados por status.
+// @Tags Admin
+// @Produce json
+// @Param status query string false "Status: pending, approved ou rejected"
+// @Success 200 {object} usecase.ListAdjustmentsResponse "Ajustes encontrados"
+// @Failure 400 {object} handler_error.HTTPError "Status inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/adjustments [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ListAdjustments(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.ListAdjustmentsRequest{
+		Status: model.AdjustmentStatus(r.URL.Query().Get("status")),
+	}
+
+	resp, err := h.ListAdjustmentsUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ApproveAdjustment aprova e aplica um ajuste de saldo.
+// @Summary Aprovar ajuste de saldo
+// @Description Aprova o ajuste pendente e o aplica atomicamente ao saldo do alvo. O administrador que propôs o ajuste não pode aprová-lo.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID do ajuste"
+// @Success 200 {object} model.BalanceAdjustment "Ajuste aplicado"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente ou aprovação pelo próprio proponente"
+// @Failure 404 {object} handler_error.HTTPError "Ajuste não encontrado"
+// @Failure 409 {object} handler_error.HTTPError "Ajuste já revisado"
+// @Failure 422 {object} handler_error.HTTPError "O ajuste deixaria o saldo negativo"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/adjustments/{id}/approve [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ApproveAdjustment(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.ApproveAdjustmentRequest{
+		ID: mux.Vars(r)["id"],
+	}
+
+	resp, err := h.ApproveAdjustmentUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RejectAdjustment rejeita um ajuste de saldo.
+// @Summary Rejeitar ajuste de saldo
+// @Description Rejeita o ajuste pendente sem alterar nenhum saldo. O administrador que propôs o ajuste não pode rejeitá-lo.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID do ajuste"
+// @Success 200 {object} model.BalanceAdjustment "Ajuste rejeitado"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente ou revisão pelo próprio proponente"
+// @Failure 404 {object} handler_error.HTTPError "Ajuste não encontrado"
+// @Failure 409 {object} handler_error.HTTPError "Ajuste já revisado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/adjustments/{id}/reject [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) RejectAdjustment(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.RejectAdjustmentRequest{
+		ID: mux.Vars(r)["id"],
+	}
+
+	resp, err := h.RejectAdjustmentUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreateWebhook cadastra uma assinatura de webhook.
+// @Summary Criar assinatura de webhook
+// @Description Cadastra uma URL para receber os eventos de domínio dos tipos informados. Cada entrega é um POST com o evento em JSON, assinado no cabeçalho X-Webhook-Signature como v1=HMAC-SHA256(segredo, "<X-Webhook-Timestamp>.<corpo>"). O segredo, informado ou gerado, só é retornado nesta resposta.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param createWebhookRequest body usecase.CreateWebhookRequest true "URL, tipos de evento e segredo opcional"
+// @Success 201 {object} usecase.CreateWebhookResponse "Assinatura criada"
+// @Failure 400 {object} handler_error.HTTPError "URL, tipo de evento ou segredo inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/webhooks [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	resp, err := h.CreateWebhookUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListWebhooks lista as assinaturas de webhook.
+// @Summary Listar assinaturas de webhook
+// @Description Lista as assinaturas de webhook cadastradas. Os segredos nunca são retornados.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} usecase.ListWebhooksResponse "Assinaturas encontradas"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/webhooks [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	resp, err := h.ListWebhooksUseCase.Execute(r.Context())
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// DeleteWebhook remove uma assinatura de webhook.
+// @Summary Remover assinatura de webhook
+// @Description Remove a assinatura. As entregas pendentes dela vão para a lista de entregas mortas; o log de entregas é mantido.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID da assinatura"
+// @Success 204 "Assinatura removida"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Assinatura não encontrada"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/webhooks/{id} [delete]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.DeleteWebhookRequest{
+		ID: mux.Vars(r)["id"],
+	}
+
+	if err := h.DeleteWebhookUseCase.Execute(r.Context(), &req); err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries lista o log de entregas de webhook.
+// @Summary Listar entregas de webhook
+// @Description Lista as entregas de webhook, das mais recentes para as mais antigas, com tentativas, último status HTTP e último erro. Com status=dead, lista as entregas que esgotaram as tentativas.
+// @Tags Admin
+// @Produce json
+// @Param subscription_id query string false "ID da assinatura"
+// @Param status query string false "Status: pending, delivered ou dead"
+// @Param limit query int false "Quantidade máxima (padrão 50, máximo 500)"
+// @Success 200 {object} usecase.ListWebhookDeliveriesResponse "Entregas encontradas"
+// @Failure 400 {object} handler_error.HTTPError "Filtro inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/webhooks/deliveries [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+	req := usecase.ListWebhookDeliveriesRequest{
+		SubscriptionID: query.Get("subscription_id"),
+		Status:         model.WebhookDeliveryStatus(query.Get("status")),
+	}
+	if value := query.Get("limit"); value != "" {
+		var err error
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+
+	resp, err := h.ListWebhookDeliveriesUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RetryWebhookDelivery reenvia uma entrega morta.
+// @Summary Reenviar entrega de webhook
+// @Description Devolve à fila uma entrega que esgotou as tentativas, com as tentativas zeradas. A assinatura precisa existir.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID da entrega"
+// @Success 200 {object} model.WebhookDelivery "Entrega reagendada"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Entrega ou assinatura não encontrada"
+// @Failure 409 {object} handler_error.HTTPError "A entrega não está na lista de mortas"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/webhooks/deliveries/{id}/retry [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) RetryWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.RetryWebhookDeliveryRequest{
+		ID: mux.Vars(r)["id"],
+	}
+
+	resp, err := h.RetryWebhookDeliveryUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreateTournament cria um torneio.
+// @Summary Criar torneio
+// @Description Cria um torneio nas máquinas informadas entre starts_at e ends_at. As jogadas dos inscritos pontuam pelo total apostado (total_wagered) ou pelo maior prêmio em relação à aposta, em centésimos (biggest_multiplier). O prêmio total é guaranteed_prize, pago pela tesouraria, mais as inscrições, dividido pelos percentuais de prize_distribution a partir da primeira colocação. Os prêmios são pagos automaticamente nos saldos quando o torneio termina.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param createTournamentRequest body usecase.CreateTournamentRequest true "Dados do torneio"
+// @Success 201 {object} model.Tournament "Torneio criado"
+// @Failure 400 {object} handler_error.HTTPError "Agenda, pontuação ou distribuição de prêmios inválida"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /admin/tournaments [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) CreateTournament(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.CreateTournamentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	resp, err := h.CreateTournamentUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListTournaments lista os torneios.
+// @Summary Listar torneios
+// @Description Lista os torneios, dos que começam mais tarde para os que começam mais cedo, com as máquinas, a agenda, a inscrição e a distribuição de prêmios.
+// @Tags Player
+// @Produce json
+// @Param status query string false "Status: open ou settled"
+// @Success 200 {object} usecase.ListTournamentsResponse "Torneios encontrados"
+// @Failure 400 {object} handler_error.HTTPError "Status inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /tournaments [get]
+// @Security BearerAuth
+func (h *Handler) ListTournaments(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	req := usecase.ListTournamentsRequest{
+		Status: model.TournamentStatus(r.URL.Query().Get("status")),
+	}
+
+	resp, err := h.ListTournamentsUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// JoinTournament inscreve o jogador em um torneio.
+// @Summary Inscrever-se em torneio
+// @Description Debita a inscrição do saldo do jogador. As inscrições ficam abertas até o fim do torneio e respeitam os limites de aposta e de perda; só as jogadas feitas depois da inscrição pontuam.
+// @Tags Player
+// @Produce json
+// @Param id path string true "ID do torneio"
+// @Success 201 {object} usecase.JoinTournamentResponse "Inscrição realizada"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Email não verificado, autoexclusão ou limite de jogo excedido"
+// @Failure 404 {object} handler_error.HTTPError "Torneio não encontrado"
+// @Failure 409 {object} handler_error.HTTPError "Torneio encerrado ou jogador já inscrito"
+// @Failure 422 {object} handler_error.HTTPError "Saldo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /tournaments/{id}/join [post]
+// @Security BearerAuth
+func (h *Handler) JoinTournament(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	req := usecase.JoinTournamentRequest{
+		TournamentID: mux.Vars(r)["id"],
+		PlayerID:     userID,
+	}
+
+	resp, err := h.JoinTournamentUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetTournamentLeaderboard retorna a classificação de um torneio.
+// @Summary Classificação do torneio
+// @Description Retorna as primeiras colocações e a colocação do jogador. Empates ficam com quem alcançou a pontuação primeiro. Em prize vem o prêmio pago ou, com o torneio aberto, o que a colocação receberia se ele terminasse agora; só concorrem a prêmios os inscritos que jogaram no torneio.
+// @Tags Player
+// @Produce json
+// @Param id path string true "ID do torneio"
+// @Param limit query int false "Quantidade de colocações (padrão 50, máximo 500)"
+// @Success 200 {object} usecase.GetLeaderboardResponse "Classificação"
+// @Failure 400 {object} handler_error.HTTPError "Limite inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 404 {object} handler_error.HTTPError "Torneio não encontrado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /tournaments/{id}/leaderboard [get]
+// @Security BearerAuth
+func (h *Handler) GetTournamentLeaderboard(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	req := usecase.GetLeaderboardRequest{
+		TournamentID: mux.Vars(r)["id"],
+		PlayerID:     userID,
+	}
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+
+	resp, err := h.GetLeaderboardUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RefillSlotMachine transfere fundos da tesouraria para a máquina.
+// @Summary Recarregar máquina caça-níqueis
+// @Description Transfere o valor informado da tesouraria da casa para o saldo da máquina. O motivo é obrigatório e o valor é limitado por operação.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da máquina caça-níqueis"
+// @Param machineTransferRequest body usecase.MachineTransferRequest true "Valor e motivo"
+// @Success 200 {object} model.TreasuryMovement "Movimento registrado"
+// @Failure 400 {object} handler_error.HTTPError "Valor ou motivo inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
+// @Failure 422 {object} handler_error.HTTPError "Saldo da tesouraria insuficiente ou limite por operação excedido"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /machines/{id}/refill [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) RefillSlotMachine(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.MachineTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	req.MachineID = mux.Vars(r)["id"]
+
+	resp, err := h.RefillSlotMachineUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CashoutSlotMachine recolhe fundos da máquina para a tesouraria.
+// @Summary Recolher saldo da máquina caça-níqueis
+// @Description Transfere o valor informado do saldo da máquina para a tesouraria da casa, mantendo na máquina o saldo mínimo configurado. O motivo é obrigatório e o valor é limitado por operação.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da máquina caça-níqueis"
+// @Param machineTransferRequest body usecase.MachineTransferRequest true "Valor e motivo"
+// @Success 200 {object} model.TreasuryMovement "Movimento registrado"
+// @Failure 400 {object} handler_error.HTTPError "Valor ou motivo inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
+// @Failure 422 {object} handler_error.HTTPError "Saldo da máquina insuficiente ou limite por operação excedido"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /machines/{id}/cashout [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) CashoutSlotMachine(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.MachineTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	req.MachineID = mux.Vars(r)["id"]
+
+	resp, err := h.CashoutSlotMachineUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetTreasury retorna o saldo da tesouraria da casa.
+// @Summary Consultar tesouraria
+// @Description Retorna o saldo da tesouraria da casa e os movimentos mais recentes (recargas, recolhimentos e ajustes).
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} usecase.GetTreasuryResponse "Saldo e movimentos da tesouraria"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /treasury [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) GetTreasury(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	resp, err := h.GetTreasuryUseCase.Execute(r.Context())
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetSlotMachineStats retorna as métricas de desempenho da máquina.
+// @Summary Estatísticas da máquina caça-níqueis
+// @Description Retorna, para o período pedido, o total apostado e pago, o RTP realizado e o teórico, a frequência de acertos, o número de jogadas, de jogadores distintos e o maior prêmio, no total e por hora ou dia (UTC).
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID da máquina caça-níqueis"
+// @Param from query string false "Data inicial (RFC3339)"
+// @Param to query string false "Data final, exclusiva (RFC3339)"
+// @Param granularity query string false "Granularidade da série: hour ou day (padrão)"
+// @Success 200 {object} usecase.GetSlotMachineStatsResponse "Estatísticas da máquina"
+// @Failure 400 {object} handler_error.HTTPError "Parâmetros inválidos"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Máquina caça-níqueis não encontrada"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /machines/{id}/stats [get]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) GetSlotMachineStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+	req := usecase.GetSlotMachineStatsRequest{
+		MachineID:   mux.Vars(r)["id"],
+		Granularity: model.StatsGranularity(query.Get("granularity")),
+	}
+
+	var err error
+	if value := query.Get("from"); value != "" {
+		if req.From, err = time.Parse(time.RFC3339, value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+	if value := query.Get("to"); value != "" {
+		if req.To, err = time.Parse(time.RFC3339, value); err != nil {
+			handler_error.HandleError(w, usecase.ErrValidate)
+			return
+		}
+	}
+
+	resp, err := h.GetSlotMachineStatsUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetPlayerProfile retorna o perfil e as estatísticas do jogador autenticado.
+// @Summary Perfil do jogador
+// @Description Retorna os dados do jogador, as estatísticas de jogo de toda a vida, do dia e dos últimos 7 e 30 dias (jogadas, total apostado e ganho, maior prêmio e máquina favorita) e o resultado da sessão atual.
+// @Tags Player
+// @Produce json
+// @Success 200 {object} usecase.GetPlayerProfileResponse "Perfil do jogador"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 404 {object} handler_error.HTTPError "Jogador não encontrado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /players/me [get]
+// @Security BearerAuth
+func (h *Handler) GetPlayerProfile(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		handler_error.HandleError(w, usecase.ErrUnauthorized)
+		return
+	}
+
+	resp, err := h.GetPlayerProfileUseCase.Execute(r.Context(), &usecase.GetPlayerProfileRequest{PlayerID: userID})
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreateJackpotPool cria um jackpot progressivo.
+// @Summary Criar jackpot progressivo
+// @Description Cria um pool de jackpot com valor inicial retirado da tesouraria, percentual de contribuição das apostas e gatilho (combinação de símbolos e/ou chance de 1 em N por jogada).
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param createJackpotPoolRequest body usecase.CreateJackpotPoolRequest true "Dados do jackpot"
+// @Success 201 {object} model.JackpotPool "Jackpot criado"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 422 {object} handler_error.HTTPError "Saldo insuficiente na tesouraria"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /jackpots [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) CreateJackpotPool(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.CreateJackpotPoolRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	resp, err := h.CreateJackpotPoolUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// LinkJackpotMachine liga uma máquina a um jackpot.
+// @Summary Ligar máquina ao jackpot
+// @Description Passa a destinar ao jackpot a contribuição das apostas feitas na máquina. Uma máquina participa de no máximo um jackpot.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do jackpot"
+// @Param jackpotMachineRequest body usecase.JackpotMachineRequest true "Máquina a ligar"
+// @Success 200 {object} model.JackpotPool "Jackpot atualizado"
+// @Failure 400 {object} handler_error.HTTPError "Payload inválido"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Jackpot ou máquina não encontrados"
+// @Failure 409 {object} handler_error.HTTPError "Máquina já ligada a outro jackpot"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /jackpots/{id}/machines [post]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) LinkJackpotMachine(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req usecase.JackpotMachineRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request payload",
+		})
+
+		return
+	}
+
+	req.PoolID = mux.Vars(r)["id"]
+
+	resp, err := h.LinkJackpotMachineUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// UnlinkJackpotMachine desliga uma máquina de um jackpot.
+// @Summary Desligar máquina do jackpot
+// @Description Deixa de destinar ao jackpot a contribuição das apostas feitas na máquina. O valor acumulado permanece no jackpot.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "ID do jackpot"
+// @Param machine_id path string true "ID da máquina caça-níqueis"
+// @Success 200 {object} model.JackpotPool "Jackpot atualizado"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 403 {object} handler_error.HTTPError "Escopo insuficiente"
+// @Failure 404 {object} handler_error.HTTPError "Jackpot não encontrado ou máquina não ligada a ele"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /jackpots/{id}/machines/{machine_id} [delete]
+// @Security AdminAuth
+// @Security BearerAuth
+func (h *Handler) UnlinkJackpotMachine(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	vars := mux.Vars(r)
+	req := usecase.JackpotMachineRequest{
+		PoolID:    vars["id"],
+		MachineID: vars["machine_id"],
+	}
+
+	resp, err := h.UnlinkJackpotMachineUseCase.Execute(r.Context(), &req)
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListJackpots lista os jackpots e os ganhadores recentes.
+// @Summary Listar jackpots
+// @Description Retorna o valor atual de cada jackpot com as máquinas ligadas a ele e os jackpots pagos mais recentes, sem identificar os jogadores.
+// @Tags Player
+// @Produce json
+// @Success 200 {object} usecase.ListJackpotsResponse "Jackpots e ganhadores recentes"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Erro interno do servidor"
+// @Router /jackpots [get]
+// @Security BearerAuth
+func (h *Handler) ListJackpots(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	resp, err := h.ListJackpotsUseCase.Execute(r.Context())
+	if err != nil {
+		handler_error.HandleError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// eventsKeepAlive é o intervalo dos comentários enviados para manter a
+// conexão de eventos aberta em proxies que encerram conexões ociosas.
+const eventsKeepAlive = 15 * time.Second
+
+// StreamEvents envia os eventos do jogador em tempo real.
+// @Summary Receber eventos em tempo real
+// @Description Abre um fluxo Server-Sent Events autenticado com o access token. Cada evento tem o tipo em event e o JSON em data: balance.updated traz o novo saldo do jogador após jogadas, depósitos, ajustes e torneios; win.big anuncia vitórias grandes de qualquer jogador, sem identificá-lo; jackpot.updated traz o valor atual dos jackpots. Comentários periódicos mantêm a conexão aberta; eventos não entregues a um cliente lento são descartados, e o saldo atual pode ser consultado em /players/balance.
+// @Tags Player
+// @Produce text/event-stream
+// @Success 200 {object} model.Event "Fluxo de eventos"
+// @Failure 401 {object} handler_error.HTTPError "Não autorizado"
+// @Failure 500 {object} handler_error.HTTPError "Streaming não suportado"
+// @Router /events [get]
+// @Security BearerAuth
+func (h *Handler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(handler_error.HTTPError{
+			Code:    http.StatusUnauthorized,
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	events, cancel := h.EventSubscriber.Subscribe(userID)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// O WriteTimeout do servidor encerraria o fluxo; o prazo é renovado a
+	// cada escrita.
+	rc := http.NewResponseController(w)
+	keepAlive := time.NewTicker(eventsKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		rc.SetWriteDeadline(time.Now().Add(2 * eventsKeepAlive))
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		}
+	}
+}
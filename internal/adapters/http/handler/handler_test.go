@@ -4,16 +4,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	httpGo "net/http"
 	"net/http/httptest"
 	"slot-machine/internal/adapters/http/handler"
 	"slot-machine/internal/application/usecase"
 	"slot-machine/internal/domain/contextkeys"
 	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/notifier"
 	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
 	"slot-machine/internal/infrastructure/security"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -28,8 +31,12 @@ func SetupHandler() *handler.Handler {
 	slotMachineRepo := repository_in_memory.NewInMemorySlotMachineRepository()
 	hasher := security.NewBcryptPasswordHasher(bcrypt.DefaultCost)
 
-	createPlayerUC := usecase.NewCreatePlayerUseCase(playerRepo, hasher)
-	createSlotMachineUC := usecase.NewCreateSlotMachineUseCase(slotMachineRepo)
+	tokenRepo := repository_in_memory.NewInMemoryActionTokenRepository()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	createPlayerUC := usecase.NewCreatePlayerUseCase(playerRepo, hasher, security.NewPasswordPolicy(8, 0, nil), tokenRepo, notifier.NewLogNotifier(logger), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	createSlotMachineUC := usecase.NewCreateSlotMachineUseCase(slotMachineRepo, repository_in_memory.NewInMemoryAuditRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
 
 	handler := &handler.Handler{
 		CreatePlayerUseCase:      createPlayerUC,
@@ -49,8 +56,8 @@ func TestHandler(t *testing.T) {
 	t.Run("CreatePlayer_Success", func(t *testing.T) {
 		reqBody := usecase.CreatePlayerRequest{
 			Balance:  1000,
-			Email:    "email",
-			Password: "abc",
+			Email:    "player@email.com",
+			Password: "abcdefgh",
 		}
 
 		jsonBody, err := json.Marshal(reqBody)
@@ -86,7 +93,7 @@ func TestHandler(t *testing.T) {
 		initialPlayer := &model.Player{
 			ID:       "player456",
 			Balance:  500,
-			Email:    "email",
+			Email:    "player@email.com",
 			Password: "aaa",
 		}
 
@@ -95,8 +102,8 @@ func TestHandler(t *testing.T) {
 
 		reqBody := usecase.CreatePlayerRequest{
 			Balance:  1500,
-			Email:    "email",
-			Password: "aaa",
+			Email:    "player@email.com",
+			Password: "aaaaaaaa",
 		}
 
 		jsonBody, err := json.Marshal(reqBody)
@@ -188,7 +195,7 @@ func TestHandler(t *testing.T) {
 		assert.Equal(t, reqBody.MultipleGain, resp.Machine.MultipleGain, "Ganho múltiplo deve corresponder ao solicitado")
 		assert.Equal(t, reqBody.Description, resp.Machine.Description, "Descrição da máquina deve corresponder ao solicitado")
 		assert.NotEmpty(t, resp.Machine.ID, "ID da máquina deve ser gerado")
-		assert.NotEmpty(t, resp.Machine.Permutations, "Permutações devem ser geradas")
+		assert.NotEmpty(t, resp.Machine.Strips, "Fitas dos rolos devem ser geradas")
 
 		storedMachine, err := handler.CreateSlotMachineUseCase.SlotMachineRepo.GetSlotMachine(context.Background(), resp.Machine.ID)
 		assert.NoError(t, err, "Erro ao recuperar a máquina do repositório")
@@ -257,3 +264,37 @@ func TestHandler(t *testing.T) {
 		assert.Equal(t, expectedError, actualError, "Mensagem de erro deve corresponder ao esperado")
 	})
 }
+
+// closedSubscriber entrega os eventos informados e fecha o canal, encerrando
+// o fluxo sem depender de tempo.
+type closedSubscriber struct {
+	events []model.Event
+}
+
+func (s closedSubscriber) Subscribe(playerID string) (<-chan model.Event, func()) {
+	events := make(chan model.Event, len(s.events))
+	for _, event := range s.events {
+		events <- event
+	}
+	close(events)
+	return events, func() {}
+}
+
+func TestHandler_StreamEvents(t *testing.T) {
+	handler := &handler.Handler{
+		EventSubscriber: closedSubscriber{events: []model.Event{
+			{Type: model.EventBalanceUpdated, PlayerID: "player1", Data: model.BalanceUpdate{Balance: 150, Delta: 50, Reason: model.BalanceReasonDeposit}},
+		}},
+	}
+
+	req, err := httpGo.NewRequest("GET", "/events", nil)
+	assert.NoError(t, err, "Erro ao criar a requisição HTTP")
+	req = req.WithContext(context.WithValue(req.Context(), contextkeys.ContextKeyUserID, "player1"))
+
+	rr := httptest.NewRecorder()
+	handler.StreamEvents(rr, req)
+
+	assert.Equal(t, httpGo.StatusOK, rr.Code)
+	assert.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+	assert.Contains(t, rr.Body.String(), "event: balance.updated\ndata: {\"type\":\"balance.updated\",\"data\":{\"balance\":150,\"delta\":50,\"reason\":\"deposit\"}")
+}
@@ -7,39 +7,75 @@ import (
 	"strings"
 
 	handler_error "slot-machine/internal/adapters/http/handler/error"
+	"slot-machine/internal/application/usecase"
 	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
 	"slot-machine/internal/domain/ports"
-	"slot-machine/internal/infrastructure/config"
+	"slot-machine/internal/domain/repository"
 )
 
-func AdminMiddleware(jwtManager ports.JWTManager) func(http.Handler) http.Handler {
-	adminSecret := config.GetRequiredEnv("ADMIN_SECRET")
-
+// AdminMiddleware aceita um token JWT de um jogador com papel de administrador
+// ou uma API key enviada em X-API-Key. No segundo caso os escopos e o id da
+// chave são propagados no contexto.
+func AdminMiddleware(jwtManager ports.JWTManager, playerRepo repository.PlayerRepository, authenticateAPIKeyUC *usecase.AuthenticateAPIKeyUseCase) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
 
 			if authHeader != "" && strings.HasPrefix(strings.ToLower(authHeader), "bearer ") {
-				JWTMiddleware(jwtManager)(next).ServeHTTP(w, r)
+				JWTMiddleware(jwtManager, playerRepo)(requireAdminRole(playerRepo, next)).ServeHTTP(w, r)
 				return
 			}
 
-			requestSecret := r.Header.Get("X-Admin-Secret")
-			if requestSecret != "" && requestSecret == adminSecret {
-				ctx := context.WithValue(r.Context(), contextkeys.ContextKeyUserID, "admin")
-				ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
-				next.ServeHTTP(w, r.WithContext(ctx))
+			requestKey := r.Header.Get("X-API-Key")
+			if requestKey != "" {
+				apiKey, err := authenticateAPIKeyUC.Execute(r.Context(), requestKey)
+				if err == nil {
+					ctx := context.WithValue(r.Context(), contextkeys.ContextKeyUserID, "api_key:"+apiKey.ID)
+					ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+					ctx = context.WithValue(ctx, contextkeys.ContextKeyAPIKeyID, apiKey.ID)
+					ctx = context.WithValue(ctx, contextkeys.ContextKeyScopes, apiKey.Scopes)
+					next.ServeHTTP(w, r.WithContext(ctx))
 
-				return
+					return
+				}
+				if err != usecase.ErrInvalidAPIKey {
+					w.Header().Set("Content-Type", "application/json")
+					handler_error.HandleError(w, err)
+					return
+				}
 			}
 
-			w.Header().Set("Content-Type", "application/json")
-
-			w.WriteHeader(http.StatusUnauthorized)
-			json.NewEncoder(w).Encode(handler_error.HTTPError{
-				Code:    http.StatusUnauthorized,
-				Message: "Unauthorized",
-			})
+			writeUnauthorized(w)
 		})
 	}
 }
+
+func requireAdminRole(playerRepo repository.PlayerRepository, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, err := GetUserIDFromContext(r.Context())
+		if err != nil {
+			writeUnauthorized(w)
+			return
+		}
+
+		player, err := playerRepo.GetPlayer(r.Context(), userID)
+		if err != nil || player.Role != model.AdminRole {
+			writeUnauthorized(w)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), contextkeys.ContextKeyIsAdmin, true)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func writeUnauthorized(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(handler_error.HTTPError{
+		Code:    http.StatusUnauthorized,
+		Message: "Unauthorized",
+	})
+}
@@ -6,8 +6,10 @@ import (
 	"errors"
 	"net/http"
 	handler_error "slot-machine/internal/adapters/http/handler/error"
+	"slot-machine/internal/application/usecase"
 	"slot-machine/internal/domain/contextkeys"
 	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -16,7 +18,9 @@ import (
 type ContextKey string
 
 
-func JWTMiddleware(jwtManager ports.JWTManager) mux.MiddlewareFunc {
+// JWTMiddleware valida o access token e recusa jogadores bloqueados, mesmo
+// com um token emitido antes do bloqueio.
+func JWTMiddleware(jwtManager ports.JWTManager, playerRepo repository.PlayerRepository) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
@@ -56,6 +60,25 @@ func JWTMiddleware(jwtManager ports.JWTManager) mux.MiddlewareFunc {
 				return
 			}
 
+			player, err := playerRepo.GetPlayer(r.Context(), claims.UserID)
+			if err != nil {
+				if err == repository.ErrPlayerNotFound {
+					w.WriteHeader(http.StatusUnauthorized)
+					json.NewEncoder(w).Encode(handler_error.HTTPError{
+						Code:    http.StatusUnauthorized,
+						Message: "Invalid token",
+					})
+
+					return
+				}
+				handler_error.HandleError(w, err)
+				return
+			}
+			if player.Blocked {
+				handler_error.HandleError(w, usecase.ErrPlayerBlocked)
+				return
+			}
+
 			ctx := context.WithValue(r.Context(), contextkeys.ContextKeyUserID, claims.UserID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
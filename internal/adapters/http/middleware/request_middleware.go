@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"slot-machine/internal/domain/contextkeys"
+
+	"github.com/google/uuid"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// RequestMiddleware propaga o id da requisição e o IP do cliente no contexto.
+// Um X-Request-ID recebido é reaproveitado quando for válido.
+func RequestMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		ctx := context.WithValue(r.Context(), contextkeys.ContextKeyRequestID, requestID)
+		ctx = context.WithValue(ctx, contextkeys.ContextKeyClientIP, ClientIP(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ClientIP retorna o endereço do cliente a partir da conexão, sem confiar em
+// cabeçalhos que podem ser forjados.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
@@ -7,30 +7,84 @@ import (
 
 	"slot-machine/internal/adapters/http/handler"
 	"slot-machine/internal/adapters/http/middleware"
+	"slot-machine/internal/application/usecase"
 	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
 
 	"github.com/gorilla/mux"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
-func NewRouter(handler *handler.Handler, jwtManager ports.JWTManager) http.Handler {
+func NewRouter(handler *handler.Handler, jwtManager ports.JWTManager, playerRepo repository.PlayerRepository, authenticateAPIKeyUC *usecase.AuthenticateAPIKeyUseCase) http.Handler {
 	r := mux.NewRouter()
+	r.Use(middleware.RequestMiddleware)
 
 	r.HandleFunc("/login", handler.Login).Methods("POST")
 	r.HandleFunc("/refresh", handler.Refresh).Methods("POST")
+	r.HandleFunc("/login/2fa", handler.VerifyMFA).Methods("POST")
+	r.HandleFunc("/login/2fa/enroll", handler.EnrollTOTP).Methods("POST")
+	r.HandleFunc("/login/2fa/confirm", handler.ConfirmTOTP).Methods("POST")
 	r.HandleFunc("/players", handler.CreatePlayer).Methods("POST")
+	r.HandleFunc("/players/verify-email", handler.VerifyEmail).Methods("POST")
+	r.HandleFunc("/password/forgot", handler.RequestPasswordReset).Methods("POST")
+	r.HandleFunc("/password/reset", handler.ResetPassword).Methods("POST")
 
 	secure := r.PathPrefix("/").Subrouter()
-	secure.Use(middleware.JWTMiddleware(jwtManager))
+	secure.Use(middleware.JWTMiddleware(jwtManager, playerRepo))
 
+	secure.HandleFunc("/players/me", handler.GetPlayerProfile).Methods("GET")
 	secure.HandleFunc("/players/balance", handler.GetPlayerBalance).Methods("GET")
+	secure.HandleFunc("/players/password", handler.ChangePassword).Methods("POST")
+	secure.HandleFunc("/players/verify-email/resend", handler.ResendEmailVerification).Methods("POST")
+	secure.HandleFunc("/players/2fa/enroll", handler.EnrollTOTP).Methods("POST")
+	secure.HandleFunc("/players/2fa/confirm", handler.ConfirmTOTP).Methods("POST")
+	secure.HandleFunc("/players/2fa/disable", handler.DisableTOTP).Methods("POST")
+	secure.HandleFunc("/players/deposit", handler.Deposit).Methods("POST")
+	secure.HandleFunc("/players/limits", handler.GetGamblingLimits).Methods("GET")
+	secure.HandleFunc("/players/limits", handler.SetGamblingLimit).Methods("POST")
+	secure.HandleFunc("/players/self-exclusion", handler.GetSelfExclusion).Methods("GET")
+	secure.HandleFunc("/players/self-exclusion", handler.SelfExclude).Methods("POST")
+	secure.HandleFunc("/players/reality-check", handler.SetRealityCheck).Methods("POST")
+	secure.HandleFunc("/players/reality-check/acknowledge", handler.AcknowledgeRealityCheck).Methods("POST")
 	secure.HandleFunc("/play", handler.PlaySlotMachine).Methods("POST")
+	secure.HandleFunc("/play/batch", handler.PlaySlotMachineBatch).Methods("POST")
+	secure.HandleFunc("/jackpots", handler.ListJackpots).Methods("GET")
+	secure.HandleFunc("/tournaments", handler.ListTournaments).Methods("GET")
+	secure.HandleFunc("/tournaments/{id}/join", handler.JoinTournament).Methods("POST")
+	secure.HandleFunc("/tournaments/{id}/leaderboard", handler.GetTournamentLeaderboard).Methods("GET")
+	secure.HandleFunc("/events", handler.StreamEvents).Methods("GET")
 
 	admin := r.PathPrefix("/").Subrouter()
-	admin.Use(middleware.AdminMiddleware(jwtManager))
+	admin.Use(middleware.AdminMiddleware(jwtManager, playerRepo, authenticateAPIKeyUC))
 
 	admin.HandleFunc("/machines", handler.CreateSlotMachine).Methods("POST")
 	admin.HandleFunc("/machines/balance", handler.GetSlotMachineBalance).Methods("GET")
+	admin.HandleFunc("/machines/{id}/stats", handler.GetSlotMachineStats).Methods("GET")
+	admin.HandleFunc("/machines/{id}/refill", handler.RefillSlotMachine).Methods("POST")
+	admin.HandleFunc("/machines/{id}/cashout", handler.CashoutSlotMachine).Methods("POST")
+	admin.HandleFunc("/treasury", handler.GetTreasury).Methods("GET")
+	admin.HandleFunc("/jackpots", handler.CreateJackpotPool).Methods("POST")
+	admin.HandleFunc("/jackpots/{id}/machines", handler.LinkJackpotMachine).Methods("POST")
+	admin.HandleFunc("/jackpots/{id}/machines/{machine_id}", handler.UnlinkJackpotMachine).Methods("DELETE")
+	admin.HandleFunc("/admin/api-keys", handler.CreateAPIKey).Methods("POST")
+	admin.HandleFunc("/admin/api-keys", handler.ListAPIKeys).Methods("GET")
+	admin.HandleFunc("/admin/api-keys/{id}", handler.RevokeAPIKey).Methods("DELETE")
+	admin.HandleFunc("/audit", handler.ListAuditEntries).Methods("GET")
+	admin.HandleFunc("/admin/players", handler.ListPlayers).Methods("GET")
+	admin.HandleFunc("/admin/players/{id}", handler.GetPlayerDetails).Methods("GET")
+	admin.HandleFunc("/admin/players/{id}/block", handler.BlockPlayer).Methods("POST")
+	admin.HandleFunc("/admin/players/{id}/unblock", handler.UnblockPlayer).Methods("POST")
+	admin.HandleFunc("/admin/players/{id}/self-exclusion/lift", handler.LiftSelfExclusion).Methods("POST")
+	admin.HandleFunc("/admin/adjustments", handler.ProposeAdjustment).Methods("POST")
+	admin.HandleFunc("/admin/adjustments", handler.ListAdjustments).Methods("GET")
+	admin.HandleFunc("/admin/adjustments/{id}/approve", handler.ApproveAdjustment).Methods("POST")
+	admin.HandleFunc("/admin/adjustments/{id}/reject", handler.RejectAdjustment).Methods("POST")
+	admin.HandleFunc("/admin/webhooks", handler.CreateWebhook).Methods("POST")
+	admin.HandleFunc("/admin/webhooks", handler.ListWebhooks).Methods("GET")
+	admin.HandleFunc("/admin/webhooks/deliveries", handler.ListWebhookDeliveries).Methods("GET")
+	admin.HandleFunc("/admin/webhooks/deliveries/{id}/retry", handler.RetryWebhookDelivery).Methods("POST")
+	admin.HandleFunc("/admin/webhooks/{id}", handler.DeleteWebhook).Methods("DELETE")
+	admin.HandleFunc("/admin/tournaments", handler.CreateTournament).Methods("POST")
 
 	r.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
 
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type AcknowledgeRealityCheckUseCase struct {
+	PlaySessionRepo repository.PlaySessionRepository
+	now             func() time.Time
+}
+
+type AcknowledgeRealityCheckRequest struct {
+	PlayerID string `json:"-"`
+}
+
+func NewAcknowledgeRealityCheckUseCase(sessionRepo repository.PlaySessionRepository) *AcknowledgeRealityCheckUseCase {
+	return &AcknowledgeRealityCheckUseCase{
+		PlaySessionRepo: sessionRepo,
+		now:             time.Now,
+	}
+}
+
+// Execute confirma o aviso pendente da sessão atual, liberando a próxima
+// jogada. Sem aviso pendente, não faz nada.
+func (uc *AcknowledgeRealityCheckUseCase) Execute(ctx context.Context, req *AcknowledgeRealityCheckRequest) error {
+	session, err := uc.PlaySessionRepo.GetLatestPlaySession(ctx, req.PlayerID)
+	if err == repository.ErrPlaySessionNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !session.RealityCheckPending {
+		return nil
+	}
+
+	// O intervalo até o próximo aviso conta a partir da confirmação.
+	session.RealityCheckPending = false
+	session.LastRealityCheckAt = uc.now()
+	return uc.PlaySessionRepo.SavePlaySession(ctx, session)
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"math/rand"
+	"slot-machine/internal/domain/model"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcknowledgeRealityCheckUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	sessionRepo := repository_in_memory.NewInMemoryPlaySessionRepository()
+
+	now := time.Date(2025, 2, 23, 9, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	setRealityCheckUC := NewSetRealityCheckUseCase(playerRepo)
+	acknowledgeUC := NewAcknowledgeRealityCheckUseCase(sessionRepo)
+	acknowledgeUC.now = clock
+	playUC := NewPlayUseCase(
+		playerRepo,
+		slotRepo,
+		repository_in_memory.NewInMemoryTransactionRepository(),
+		repository_in_memory.NewInMemoryGamblingLimitRepository(),
+		sessionRepo,
+		repository_in_memory.NewInMemorySelfExclusionRepository(),
+		repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)),
+		repository_in_memory.NewInMemoryFreeSpinRepository(),
+		repository_in_memory.NewInMemoryOutboxRepository(),
+		repository_in_memory.NewInMemoryTransactor(),
+	)
+	playUC.now = clock
+	playUC.rng = rand.New(rand.NewSource(0))
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Balance: 1000, EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	err = slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{
+		ID:           "machine1",
+		MultipleGain: 2,
+		Balance:      5000,
+		Strips:       fixedStrips("A", "B", "C"),
+	})
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	t.Run("Execute_InvalidInterval", func(t *testing.T) {
+		_, err := setRealityCheckUC.Execute(ctx, &SetRealityCheckRequest{PlayerID: "player1", IntervalMinutes: 2})
+		assert.Equal(t, ErrValidate, err, "Expected ErrValidate for an interval below the minimum")
+	})
+
+	t.Run("Execute_RealityCheckBlocksUntilAcknowledged", func(t *testing.T) {
+		_, err := setRealityCheckUC.Execute(ctx, &SetRealityCheckRequest{PlayerID: "player1", IntervalMinutes: 15})
+		assert.NoError(t, err, "Expected no error setting the interval")
+
+		resp, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected no error on the first spin")
+		assert.Nil(t, resp.RealityCheck, "Expected no reality check before the interval")
+
+		now = now.Add(16 * time.Minute)
+		resp, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected no error on the spin that triggers the reality check")
+		if assert.NotNil(t, resp.RealityCheck, "Expected a reality check after the interval") {
+			assert.Equal(t, 16, resp.RealityCheck.ElapsedMinutes)
+			assert.Equal(t, 2, resp.RealityCheck.Spins)
+			assert.Equal(t, 20, resp.RealityCheck.Wagered)
+			assert.Equal(t, -20, resp.RealityCheck.NetResult)
+		}
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.Equal(t, ErrRealityCheckPending, err, "Expected spins to be rejected until the reality check is acknowledged")
+
+		err = acknowledgeUC.Execute(ctx, &AcknowledgeRealityCheckRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error acknowledging the reality check")
+
+		resp, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected spins to resume after the acknowledgement")
+		assert.Nil(t, resp.RealityCheck, "Expected the interval to restart at the acknowledgement")
+	})
+	t.Run("Execute_PendingCheckSurvivesIdleTimeout", func(t *testing.T) {
+		now = now.Add(16 * time.Minute)
+		resp, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected no error on the spin that triggers the reality check")
+		assert.NotNil(t, resp.RealityCheck, "Expected a reality check after the interval")
+
+		now = now.Add(playUC.SessionIdleTimeout + time.Minute)
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.Equal(t, ErrRealityCheckPending, err, "Expected the pending reality check to carry over to the new session")
+
+		err = acknowledgeUC.Execute(ctx, &AcknowledgeRealityCheckRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error acknowledging the reality check")
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected spins to resume after the acknowledgement")
+	})
+}
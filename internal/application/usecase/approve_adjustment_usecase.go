@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type ApproveAdjustmentUseCase struct {
+	AdjustmentRepo repository.AdjustmentRepository
+	APIKeyRepo     repository.APIKeyRepository
+	AuditRepo      repository.AuditRepository
+	PlayerRepo     repository.PlayerRepository
+	OutboxRepo     repository.OutboxRepository
+	Transactor     ports.Transactor
+	// Events recebe o novo saldo do jogador ajustado.
+	Events ports.EventPublisher
+	now    func() time.Time
+}
+
+type ApproveAdjustmentRequest struct {
+	ID string `json:"-"`
+}
+
+func NewApproveAdjustmentUseCase(adjustmentRepo repository.AdjustmentRepository, apiKeyRepo repository.APIKeyRepository, auditRepo repository.AuditRepository, playerRepo repository.PlayerRepository, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *ApproveAdjustmentUseCase {
+	return &ApproveAdjustmentUseCase{
+		AdjustmentRepo: adjustmentRepo,
+		APIKeyRepo:     apiKeyRepo,
+		AuditRepo:      auditRepo,
+		PlayerRepo:     playerRepo,
+		OutboxRepo:     outboxRepo,
+		Transactor:     transactor,
+		now:            time.Now,
+	}
+}
+
+// Execute aprova o ajuste e o aplica ao saldo do alvo. Quem propôs não pode
+// aprovar.
+func (uc *ApproveAdjustmentUseCase) Execute(ctx context.Context, req *ApproveAdjustmentRequest) (*model.BalanceAdjustment, error) {
+	if err := authorize(ctx, model.ScopeAdjustmentsApprove); err != nil {
+		return nil, err
+	}
+
+	adjustment, err := uc.AdjustmentRepo.GetAdjustment(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if adjustment.Status != model.AdjustmentPending {
+		return nil, repository.ErrAdjustmentNotPending
+	}
+
+	reviewer, err := responsibleAdmin(ctx, uc.APIKeyRepo)
+	if err != nil {
+		return nil, err
+	}
+	if reviewer == adjustment.ProposedBy {
+		return nil, ErrSelfApproval
+	}
+
+	before := *adjustment
+	now := uc.now()
+	adjustment.ReviewedBy = reviewer
+	adjustment.ReviewedAt = &now
+
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.AdjustmentRepo.ApplyAdjustment(ctx, adjustment); err != nil {
+			return err
+		}
+		if err := recordAudit(ctx, uc.AuditRepo, AuditActionAdjustmentApprove, "adjustment", adjustment.ID, before, adjustment, now); err != nil {
+			return err
+		}
+		return recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventAdjustmentApproved, "adjustment", adjustment.ID, model.AdjustmentApprovedPayload{
+			AdjustmentID: adjustment.ID,
+			TargetType:   adjustment.TargetType,
+			TargetID:     adjustment.TargetID,
+			Amount:       adjustment.Amount,
+			ReasonCode:   adjustment.ReasonCode,
+		}, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// O ajuste já foi aplicado; sem o jogador, apenas o evento é perdido.
+	if adjustment.TargetType == model.AdjustmentTargetPlayer && uc.Events != nil {
+		if player, err := uc.PlayerRepo.GetPlayer(ctx, adjustment.TargetID); err == nil {
+			publishEvent(uc.Events, model.EventBalanceUpdated, player.ID, model.BalanceUpdate{
+				Balance: player.Balance,
+				Delta:   adjustment.Amount,
+				Reason:  model.BalanceReasonAdjustment,
+			}, now)
+		}
+	}
+
+	return adjustment, nil
+}
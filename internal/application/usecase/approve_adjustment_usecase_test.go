@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproveAdjustmentUseCase(t *testing.T) {
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	txRepo := repository_in_memory.NewInMemoryTransactionRepository()
+	apiKeyRepo := repository_in_memory.NewInMemoryAPIKeyRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	adjustmentRepo := repository_in_memory.NewInMemoryAdjustmentRepository(playerRepo, slotRepo, txRepo, repository_in_memory.NewInMemoryTreasuryRepository(slotRepo))
+
+	proposeUC := NewProposeAdjustmentUseCase(adjustmentRepo, playerRepo, slotRepo, apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	approveUC := NewApproveAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, playerRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	rejectUC := NewRejectAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+
+	adminCtx := func(userID string) context.Context {
+		ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, userID)
+		return context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+	}
+	proposer := adminCtx("admin1")
+	approver := adminCtx("admin2")
+
+	err := playerRepo.CreatePlayer(proposer, &model.Player{ID: "player1", Balance: 100})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+	err = slotRepo.CreateSlotMachine(proposer, &model.SlotMachine{ID: "machine1", Balance: 1000})
+	assert.NoError(t, err, "Erro ao criar máquina para testes")
+
+	t.Run("Execute_InvalidReason", func(t *testing.T) {
+		_, err := proposeUC.Execute(proposer, &ProposeAdjustmentRequest{TargetType: model.AdjustmentTargetPlayer, TargetID: "player1", Amount: 10, ReasonCode: "because"})
+		assert.Equal(t, ErrInvalidReasonCode, err, "Expected ErrInvalidReasonCode")
+	})
+
+	t.Run("Execute_ProposerCannotApprove", func(t *testing.T) {
+		adjustment, err := proposeUC.Execute(proposer, &ProposeAdjustmentRequest{TargetType: model.AdjustmentTargetPlayer, TargetID: "player1", Amount: 50, ReasonCode: model.ReasonGoodwill})
+		assert.NoError(t, err, "Expected no error proposing an adjustment")
+
+		_, err = approveUC.Execute(proposer, &ApproveAdjustmentRequest{ID: adjustment.ID})
+		assert.Equal(t, ErrSelfApproval, err, "Expected the proposer to be unable to approve")
+
+		player, _ := playerRepo.GetPlayer(proposer, "player1")
+		assert.Equal(t, 100, player.Balance, "Expected the balance to be unchanged before approval")
+	})
+
+	t.Run("Execute_APIKeyCountsAsItsCreator", func(t *testing.T) {
+		key := &model.APIKey{ID: "key1", CreatedBy: "admin1", Scopes: []model.Scope{model.ScopeAdjustmentsApprove}}
+		err := apiKeyRepo.CreateAPIKey(proposer, key)
+		assert.NoError(t, err, "Erro ao criar API key para testes")
+
+		adjustment, err := proposeUC.Execute(proposer, &ProposeAdjustmentRequest{TargetType: model.AdjustmentTargetMachine, TargetID: "machine1", Amount: 500, ReasonCode: model.ReasonMachineRefill})
+		assert.NoError(t, err, "Expected no error proposing an adjustment")
+
+		keyCtx := adminCtx("api_key:key1")
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyAPIKeyID, key.ID)
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyScopes, key.Scopes)
+		_, err = approveUC.Execute(keyCtx, &ApproveAdjustmentRequest{ID: adjustment.ID})
+		assert.Equal(t, ErrSelfApproval, err, "Expected a key created by the proposer to be unable to approve")
+	})
+
+	t.Run("Execute_KeyChainCountsAsItsAdmin", func(t *testing.T) {
+		key := &model.APIKey{ID: "key2", CreatedBy: "api_key:key1", Scopes: []model.Scope{model.ScopeAdjustmentsApprove}}
+		err := apiKeyRepo.CreateAPIKey(proposer, key)
+		assert.NoError(t, err, "Erro ao criar API key para testes")
+
+		adjustment, err := proposeUC.Execute(proposer, &ProposeAdjustmentRequest{TargetType: model.AdjustmentTargetMachine, TargetID: "machine1", Amount: 500, ReasonCode: model.ReasonMachineRefill})
+		assert.NoError(t, err, "Expected no error proposing an adjustment")
+
+		keyCtx := adminCtx("api_key:key2")
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyAPIKeyID, key.ID)
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyScopes, key.Scopes)
+		_, err = approveUC.Execute(keyCtx, &ApproveAdjustmentRequest{ID: adjustment.ID})
+		assert.Equal(t, ErrSelfApproval, err, "Expected a key created by the proposer's key to be unable to approve")
+	})
+
+	t.Run("Execute_ApproveAppliesOnce", func(t *testing.T) {
+		adjustment, err := proposeUC.Execute(proposer, &ProposeAdjustmentRequest{TargetType: model.AdjustmentTargetPlayer, TargetID: "player1", Amount: -40, ReasonCode: model.ReasonChargeback})
+		assert.NoError(t, err, "Expected no error proposing an adjustment")
+
+		approved, err := approveUC.Execute(approver, &ApproveAdjustmentRequest{ID: adjustment.ID})
+		assert.NoError(t, err, "Expected no error approving the adjustment")
+		assert.Equal(t, model.AdjustmentApproved, approved.Status)
+		assert.Equal(t, "admin2", approved.ReviewedBy)
+
+		player, _ := playerRepo.GetPlayer(proposer, "player1")
+		assert.Equal(t, 60, player.Balance, "Expected the debit to be applied")
+
+		debited, _ := txRepo.SumTransactions(proposer, "player1", model.TransactionAdjustmentDebit, time.Time{})
+		assert.Equal(t, 40, debited, "Expected the debit to be recorded in the ledger")
+
+		_, err = approveUC.Execute(adminCtx("admin3"), &ApproveAdjustmentRequest{ID: adjustment.ID})
+		assert.Equal(t, repository.ErrAdjustmentNotPending, err, "Expected a second approval to be refused")
+	})
+
+	t.Run("Execute_NegativeBalanceRefused", func(t *testing.T) {
+		adjustment, err := proposeUC.Execute(proposer, &ProposeAdjustmentRequest{TargetType: model.AdjustmentTargetPlayer, TargetID: "player1", Amount: -1000, ReasonCode: model.ReasonErrorCorrection})
+		assert.NoError(t, err, "Expected no error proposing an adjustment")
+
+		_, err = approveUC.Execute(approver, &ApproveAdjustmentRequest{ID: adjustment.ID})
+		assert.Equal(t, repository.ErrNegativeBalance, err, "Expected ErrNegativeBalance")
+
+		stored, _ := adjustmentRepo.GetAdjustment(proposer, adjustment.ID)
+		assert.Equal(t, model.AdjustmentPending, stored.Status, "Expected the adjustment to stay pending")
+
+		rejected, err := rejectUC.Execute(approver, &RejectAdjustmentRequest{ID: adjustment.ID})
+		assert.NoError(t, err, "Expected no error rejecting the adjustment")
+		assert.Equal(t, model.AdjustmentRejected, rejected.Status)
+	})
+
+	t.Run("Execute_RecordsAudit", func(t *testing.T) {
+		entries, err := auditRepo.ListAuditEntries(proposer, repository.AuditFilter{Action: AuditActionAdjustmentApprove})
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 1, "Expected one approval entry") {
+			assert.Equal(t, "admin2", entries[0].Actor)
+			assert.Contains(t, string(entries[0].After), `"status":"approved"`)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionMachineCreate     = "machine.create"
+	AuditActionMachineBalanceGet = "machine.balance.get"
+	AuditActionMachineStatsGet   = "machine.stats.get"
+	AuditActionAPIKeyCreate      = "api_key.create"
+	AuditActionAPIKeyList        = "api_key.list"
+	AuditActionAPIKeyRevoke      = "api_key.revoke"
+	AuditActionAuditList         = "audit.list"
+	AuditActionSelfExclusionLift = "self_exclusion.lift"
+	AuditActionPlayerList        = "player.list"
+	AuditActionPlayerGet         = "player.get"
+	AuditActionPlayerBlock       = "player.block"
+	AuditActionPlayerUnblock     = "player.unblock"
+	AuditActionAdjustmentPropose = "adjustment.propose"
+	AuditActionAdjustmentApprove = "adjustment.approve"
+	AuditActionAdjustmentReject  = "adjustment.reject"
+	AuditActionAdjustmentList    = "adjustment.list"
+	AuditActionMachineRefill     = "machine.refill"
+	AuditActionMachineCashout    = "machine.cashout"
+	AuditActionTreasuryGet       = "treasury.get"
+	AuditActionJackpotCreate     = "jackpot.create"
+	AuditActionJackpotLink       = "jackpot.machine.link"
+	AuditActionJackpotUnlink     = "jackpot.machine.unlink"
+	AuditActionWebhookCreate     = "webhook.create"
+	AuditActionWebhookList       = "webhook.list"
+	AuditActionWebhookDelete     = "webhook.delete"
+	AuditActionWebhookLog        = "webhook.delivery.list"
+	AuditActionWebhookRetry      = "webhook.delivery.retry"
+	AuditActionTournamentCreate  = "tournament.create"
+)
+
+// recordAudit registra a ação administrativa com o ator, a API key, o id da
+// requisição e o IP presentes no contexto. before e after podem ser nil.
+func recordAudit(ctx context.Context, repo repository.AuditRepository, action, targetType, targetID string, before, after any, now time.Time) error {
+	beforeJSON, err := auditSnapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := auditSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	actor, _ := ctx.Value(contextkeys.ContextKeyUserID).(string)
+	apiKeyID, _ := ctx.Value(contextkeys.ContextKeyAPIKeyID).(string)
+	requestID, _ := ctx.Value(contextkeys.ContextKeyRequestID).(string)
+	ip, _ := ctx.Value(contextkeys.ContextKeyClientIP).(string)
+
+	return repo.AppendAuditEntry(ctx, &model.AuditEntry{
+		ID:         uuid.New().String(),
+		Actor:      actor,
+		APIKeyID:   apiKeyID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     beforeJSON,
+		After:      afterJSON,
+		RequestID:  requestID,
+		IP:         ip,
+		CreatedAt:  now,
+	})
+}
+
+func auditSnapshot(value any) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return json.Marshal(value)
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+var (
+	ErrInvalidAPIKey = errors.New("invalid api key")
+)
+
+type AuthenticateAPIKeyUseCase struct {
+	APIKeyRepo repository.APIKeyRepository
+	now        func() time.Time
+}
+
+func NewAuthenticateAPIKeyUseCase(apiKeyRepo repository.APIKeyRepository) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{
+		APIKeyRepo: apiKeyRepo,
+		now:        time.Now,
+	}
+}
+
+// Execute valida a chave e devolve o registro correspondente. O hash é sempre
+// comparado em tempo constante, inclusive quando o id não existe.
+func (uc *AuthenticateAPIKeyUseCase) Execute(ctx context.Context, key string) (*model.APIKey, error) {
+	id, ok := parseAPIKey(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	storedHash := hashToken("")
+	apiKey, err := uc.APIKeyRepo.GetAPIKey(ctx, id)
+	if err != nil && err != repository.ErrAPIKeyNotFound {
+		return nil, err
+	}
+	if apiKey != nil {
+		storedHash = apiKey.KeyHash
+	}
+
+	match := subtle.ConstantTimeCompare([]byte(hashToken(key)), []byte(storedHash)) == 1
+	if !match || apiKey == nil || !apiKey.IsActive(uc.now()) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return apiKey, nil
+}
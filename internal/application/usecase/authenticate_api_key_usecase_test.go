@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticateAPIKeyUseCase(t *testing.T) {
+	apiKeyRepo := repository_in_memory.NewInMemoryAPIKeyRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+
+	now := time.Date(2025, 2, 19, 14, 0, 0, 0, time.UTC)
+	createUC := NewCreateAPIKeyUseCase(apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	createUC.now = func() time.Time { return now }
+	revokeUC := NewRevokeAPIKeyUseCase(apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	revokeUC.now = func() time.Time { return now }
+	authUC := NewAuthenticateAPIKeyUseCase(apiKeyRepo)
+	authUC.now = func() time.Time { return now }
+
+	adminCtx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "admin1")
+	adminCtx = context.WithValue(adminCtx, contextkeys.ContextKeyIsAdmin, true)
+
+	t.Run("Execute_Success", func(t *testing.T) {
+		created, err := createUC.Execute(adminCtx, &CreateAPIKeyRequest{
+			Name:   "ops",
+			Scopes: []model.Scope{model.ScopeMachinesRead},
+		})
+		assert.NoError(t, err, "Erro ao criar API key")
+		assert.True(t, strings.HasPrefix(created.Key, "smk_"+created.APIKey.ID+"_"), "Expected the key to embed its id")
+		assert.NotContains(t, created.APIKey.KeyHash, created.Key, "Expected only the hash to be stored")
+		assert.Equal(t, "admin1", created.APIKey.CreatedBy)
+
+		keyCtx := context.WithValue(adminCtx, contextkeys.ContextKeyUserID, "api_key:"+created.APIKey.ID)
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyAPIKeyID, created.APIKey.ID)
+		childExpiresAt := now.Add(time.Hour)
+		child, err := createUC.Execute(keyCtx, &CreateAPIKeyRequest{
+			Name:      "ops-child",
+			Scopes:    []model.Scope{model.ScopeMachinesRead},
+			ExpiresAt: &childExpiresAt,
+		})
+		assert.NoError(t, err, "Erro ao criar API key a partir de outra chave")
+		assert.Equal(t, "admin1", child.APIKey.CreatedBy, "Expected a key created by a key to belong to the admin")
+		assert.Equal(t, &created.APIKey.ID, child.APIKey.ParentKeyID, "Expected the child key to record its parent")
+
+		apiKey, err := authUC.Execute(context.Background(), created.Key)
+		assert.NoError(t, err, "Expected the key to authenticate")
+		assert.Equal(t, created.APIKey.ID, apiKey.ID)
+	})
+
+	t.Run("Execute_InvalidSecret", func(t *testing.T) {
+		created, err := createUC.Execute(adminCtx, &CreateAPIKeyRequest{
+			Name:   "ops",
+			Scopes: []model.Scope{model.ScopeMachinesRead},
+		})
+		assert.NoError(t, err, "Erro ao criar API key")
+
+		_, err = authUC.Execute(context.Background(), formatAPIKey(created.APIKey.ID, "wrong"))
+		assert.Equal(t, ErrInvalidAPIKey, err, "Expected a wrong secret to be rejected")
+
+		_, err = authUC.Execute(context.Background(), formatAPIKey("unknown", "wrong"))
+		assert.Equal(t, ErrInvalidAPIKey, err, "Expected an unknown id to be rejected")
+
+		_, err = authUC.Execute(context.Background(), "not-a-key")
+		assert.Equal(t, ErrInvalidAPIKey, err, "Expected a malformed key to be rejected")
+	})
+
+	t.Run("Execute_Revoked", func(t *testing.T) {
+		created, err := createUC.Execute(adminCtx, &CreateAPIKeyRequest{
+			Name:   "ops",
+			Scopes: []model.Scope{model.ScopeMachinesRead},
+		})
+		assert.NoError(t, err, "Erro ao criar API key")
+
+		err = revokeUC.Execute(adminCtx, &RevokeAPIKeyRequest{ID: created.APIKey.ID})
+		assert.NoError(t, err, "Erro ao revogar API key")
+
+		_, err = authUC.Execute(context.Background(), created.Key)
+		assert.Equal(t, ErrInvalidAPIKey, err, "Expected a revoked key to be rejected")
+	})
+
+	t.Run("Execute_RevokeCascadesToChildKeys", func(t *testing.T) {
+		expiresAt := now.Add(time.Hour)
+		parent, err := createUC.Execute(adminCtx, &CreateAPIKeyRequest{
+			Name:   "ops",
+			Scopes: []model.Scope{model.ScopeAPIKeysManage, model.ScopeMachinesRead},
+		})
+		assert.NoError(t, err, "Erro ao criar API key")
+
+		parentCtx := context.WithValue(adminCtx, contextkeys.ContextKeyAPIKeyID, parent.APIKey.ID)
+		parentCtx = context.WithValue(parentCtx, contextkeys.ContextKeyScopes, parent.APIKey.Scopes)
+		child, err := createUC.Execute(parentCtx, &CreateAPIKeyRequest{
+			Name:      "ops-child",
+			Scopes:    []model.Scope{model.ScopeAPIKeysManage, model.ScopeMachinesRead},
+			ExpiresAt: &expiresAt,
+		})
+		assert.NoError(t, err, "Erro ao criar API key a partir de outra chave")
+
+		childCtx := context.WithValue(adminCtx, contextkeys.ContextKeyAPIKeyID, child.APIKey.ID)
+		childCtx = context.WithValue(childCtx, contextkeys.ContextKeyScopes, child.APIKey.Scopes)
+		grandchild, err := createUC.Execute(childCtx, &CreateAPIKeyRequest{
+			Name:      "ops-grandchild",
+			Scopes:    []model.Scope{model.ScopeMachinesRead},
+			ExpiresAt: &expiresAt,
+		})
+		assert.NoError(t, err, "Erro ao criar API key a partir de outra chave")
+
+		err = revokeUC.Execute(adminCtx, &RevokeAPIKeyRequest{ID: parent.APIKey.ID})
+		assert.NoError(t, err, "Erro ao revogar API key")
+
+		_, err = authUC.Execute(context.Background(), child.Key)
+		assert.Equal(t, ErrInvalidAPIKey, err, "Expected keys created by a revoked key to be revoked")
+		_, err = authUC.Execute(context.Background(), grandchild.Key)
+		assert.Equal(t, ErrInvalidAPIKey, err, "Expected revocation to follow the whole chain")
+	})
+
+	t.Run("Execute_ChildKeyExpiry", func(t *testing.T) {
+		parentExpiresAt := now.Add(time.Hour)
+		parent, err := createUC.Execute(adminCtx, &CreateAPIKeyRequest{
+			Name:      "ops",
+			Scopes:    []model.Scope{model.ScopeAPIKeysManage},
+			ExpiresAt: &parentExpiresAt,
+		})
+		assert.NoError(t, err, "Erro ao criar API key")
+
+		keyCtx := context.WithValue(adminCtx, contextkeys.ContextKeyAPIKeyID, parent.APIKey.ID)
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyScopes, parent.APIKey.Scopes)
+
+		_, err = createUC.Execute(keyCtx, &CreateAPIKeyRequest{
+			Name:   "forever",
+			Scopes: []model.Scope{model.ScopeAPIKeysManage},
+		})
+		assert.Equal(t, ErrValidate, err, "Expected keys created by a key to require an expiry")
+
+		later := parentExpiresAt.Add(24 * time.Hour)
+		child, err := createUC.Execute(keyCtx, &CreateAPIKeyRequest{
+			Name:      "longer",
+			Scopes:    []model.Scope{model.ScopeAPIKeysManage},
+			ExpiresAt: &later,
+		})
+		assert.NoError(t, err, "Erro ao criar API key a partir de outra chave")
+		assert.Equal(t, parentExpiresAt, *child.APIKey.ExpiresAt, "Expected the expiry to be capped at the parent key's")
+	})
+
+	t.Run("Execute_Expired", func(t *testing.T) {
+		expiresAt := now.Add(time.Hour)
+		created, err := createUC.Execute(adminCtx, &CreateAPIKeyRequest{
+			Name:      "temporary",
+			Scopes:    []model.Scope{model.ScopeMachinesRead},
+			ExpiresAt: &expiresAt,
+		})
+		assert.NoError(t, err, "Erro ao criar API key")
+
+		authUC.now = func() time.Time { return expiresAt }
+		defer func() { authUC.now = func() time.Time { return now } }()
+
+		_, err = authUC.Execute(context.Background(), created.Key)
+		assert.Equal(t, ErrInvalidAPIKey, err, "Expected an expired key to be rejected")
+	})
+
+	t.Run("Execute_ScopeEnforced", func(t *testing.T) {
+		keyCtx := context.WithValue(adminCtx, contextkeys.ContextKeyScopes, []model.Scope{model.ScopeMachinesRead})
+
+		_, err := createUC.Execute(keyCtx, &CreateAPIKeyRequest{
+			Name:   "escalation",
+			Scopes: []model.Scope{model.ScopeMachinesRead},
+		})
+		assert.Equal(t, ErrForbidden, err, "Expected keys without api_keys:manage to be forbidden")
+
+		keyCtx = context.WithValue(adminCtx, contextkeys.ContextKeyScopes, []model.Scope{model.ScopeAPIKeysManage})
+		_, err = createUC.Execute(keyCtx, &CreateAPIKeyRequest{
+			Name:   "escalation",
+			Scopes: []model.Scope{model.ScopeMachinesWrite},
+		})
+		assert.Equal(t, ErrForbidden, err, "Expected keys to be unable to grant scopes they do not hold")
+	})
+
+	t.Run("Execute_InvalidScope", func(t *testing.T) {
+		_, err := createUC.Execute(adminCtx, &CreateAPIKeyRequest{
+			Name:   "ops",
+			Scopes: []model.Scope{"everything"},
+		})
+		assert.Equal(t, ErrInvalidScope, err, "Expected unknown scopes to be rejected")
+	})
+}
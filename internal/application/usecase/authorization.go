@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"strings"
+)
+
+// Requisições feitas com API key são identificadas como api_key:<id>.
+const apiKeyActorPrefix = "api_key:"
+
+var (
+	ErrForbidden = errors.New("insufficient scope")
+)
+
+// authorize exige um contexto administrativo. Requisições feitas com API key
+// carregam os escopos da chave e só podem executar o que eles permitem.
+func authorize(ctx context.Context, scope model.Scope) error {
+	isAdmin, ok := ctx.Value(contextkeys.ContextKeyIsAdmin).(bool)
+	if !ok || !isAdmin {
+		return ErrUnauthorized
+	}
+
+	scopes, ok := ctx.Value(contextkeys.ContextKeyScopes).([]model.Scope)
+	if !ok {
+		return nil
+	}
+
+	for _, s := range scopes {
+		if s == scope {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+// responsibleAdmin identifica o administrador responsável pela ação. Uma API
+// key é atribuída a quem a criou, para que ninguém aprove com uma chave o
+// ajuste que propôs com a própria sessão. Chaves criadas por outras chaves
+// são seguidas até o administrador que iniciou a cadeia.
+func responsibleAdmin(ctx context.Context, apiKeyRepo repository.APIKeyRepository) (string, error) {
+	apiKeyID, _ := ctx.Value(contextkeys.ContextKeyAPIKeyID).(string)
+	if apiKeyID == "" {
+		userID, _ := ctx.Value(contextkeys.ContextKeyUserID).(string)
+		if userID == "" {
+			return "", ErrUnauthorized
+		}
+		return userID, nil
+	}
+
+	for {
+		key, err := apiKeyRepo.GetAPIKey(ctx, apiKeyID)
+		if err != nil {
+			return "", err
+		}
+		parentID, ok := strings.CutPrefix(key.CreatedBy, apiKeyActorPrefix)
+		if !ok {
+			return key.CreatedBy, nil
+		}
+		apiKeyID = parentID
+	}
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+)
+
+var ErrPlayerBlocked = errors.New("player is blocked")
+
+type BlockPlayerUseCase struct {
+	PlayerRepo       repository.PlayerRepository
+	RefreshTokenRepo repository.RefreshTokenRepository
+	AuditRepo        repository.AuditRepository
+	OutboxRepo       repository.OutboxRepository
+	Transactor       ports.Transactor
+	now              func() time.Time
+}
+
+type BlockPlayerRequest struct {
+	PlayerID string `json:"-"`
+	Reason   string `json:"reason"`
+}
+
+func NewBlockPlayerUseCase(playerRepo repository.PlayerRepository, refreshRepo repository.RefreshTokenRepository, auditRepo repository.AuditRepository, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *BlockPlayerUseCase {
+	return &BlockPlayerUseCase{
+		PlayerRepo:       playerRepo,
+		RefreshTokenRepo: refreshRepo,
+		AuditRepo:        auditRepo,
+		OutboxRepo:       outboxRepo,
+		Transactor:       transactor,
+		now:              time.Now,
+	}
+}
+
+// Execute bloqueia o jogador e encerra todas as suas sessões. Os access
+// tokens ainda válidos são recusados pelo JWTMiddleware.
+func (uc *BlockPlayerUseCase) Execute(ctx context.Context, req *BlockPlayerRequest) error {
+	if err := authorize(ctx, model.ScopePlayersWrite); err != nil {
+		return err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return ErrValidate
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	before := *player
+	after := *player
+	after.Blocked = true
+	after.BlockedReason = reason
+
+	now := uc.now()
+	return uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.PlayerRepo.SetBlocked(ctx, player.ID, true, reason); err != nil {
+			return err
+		}
+		if err := uc.RefreshTokenRepo.RevokeAllRefreshTokens(ctx, player.ID); err != nil {
+			return err
+		}
+		if err := recordAudit(ctx, uc.AuditRepo, AuditActionPlayerBlock, "player", player.ID, before, after, now); err != nil {
+			return err
+		}
+		return recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventPlayerBlocked, "player", player.ID, model.PlayerBlockedPayload{
+			PlayerID: player.ID,
+			Reason:   reason,
+		}, now)
+	})
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/jwt"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"slot-machine/internal/infrastructure/security"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBlockPlayerUseCase(t *testing.T) {
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	refreshRepo := repository_in_memory.NewInMemoryRefreshTokenRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	hasher := security.NewBcryptPasswordHasher(bcrypt.MinCost)
+	jwtManager := jwt.NewJWTManager("secret", time.Minute, time.Hour)
+
+	blockUC := NewBlockPlayerUseCase(playerRepo, refreshRepo, auditRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	unblockUC := NewUnblockPlayerUseCase(playerRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	listPlayersUC := NewListPlayersUseCase(playerRepo, auditRepo)
+	loginUC := NewLoginUseCase(playerRepo, refreshRepo, repository_in_memory.NewInMemoryLoginAttemptRepository(), repository_in_memory.NewInMemorySelfExclusionRepository(), hasher, jwtManager)
+
+	ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "admin1")
+	ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+
+	hashed, err := hasher.Hash("password")
+	assert.NoError(t, err, "Erro ao gerar hash da senha")
+
+	createdAt := time.Date(2025, 2, 24, 9, 0, 0, 0, time.UTC)
+	players := []*model.Player{
+		{ID: "player1", Email: "alice@email.com", Password: hashed, Balance: 300, CreatedAt: createdAt},
+		{ID: "player2", Email: "bob@email.com", Password: hashed, Balance: 100, CreatedAt: createdAt.Add(time.Hour)},
+		{ID: "player3", Email: "alice.smith@email.com", Password: hashed, Balance: 200, CreatedAt: createdAt.Add(2 * time.Hour)},
+	}
+	for _, player := range players {
+		err := playerRepo.CreatePlayer(ctx, player)
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+	}
+
+	t.Run("Execute_ListSearchAndSort", func(t *testing.T) {
+		resp, err := listPlayersUC.Execute(ctx, &ListPlayersRequest{Email: "ALICE", SortBy: "balance", Order: "desc"})
+		assert.NoError(t, err, "Expected no error listing players")
+		assert.Equal(t, 2, resp.Total, "Expected two players matching the search")
+		if assert.Len(t, resp.Players, 2) {
+			assert.Equal(t, "player1", resp.Players[0].ID, "Expected the highest balance first")
+			assert.Equal(t, "player3", resp.Players[1].ID)
+		}
+
+		resp, err = listPlayersUC.Execute(ctx, &ListPlayersRequest{Limit: 1, Offset: 1})
+		assert.NoError(t, err, "Expected no error paginating players")
+		assert.Equal(t, 3, resp.Total, "Expected the total to ignore pagination")
+		if assert.Len(t, resp.Players, 1) {
+			assert.Equal(t, "player2", resp.Players[0].ID, "Expected players ordered by creation date")
+		}
+
+		_, err = listPlayersUC.Execute(ctx, &ListPlayersRequest{SortBy: "email"})
+		assert.Equal(t, ErrValidate, err, "Expected ErrValidate for an unsupported sort field")
+	})
+
+	t.Run("Execute_RequiresReason", func(t *testing.T) {
+		err := blockUC.Execute(ctx, &BlockPlayerRequest{PlayerID: "player1", Reason: "  "})
+		assert.Equal(t, ErrValidate, err, "Expected ErrValidate without a reason")
+	})
+
+	t.Run("Execute_BlockRefusesLoginAndRevokesSessions", func(t *testing.T) {
+		login, err := loginUC.Execute(ctx, &LoginRequest{Email: "bob@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected no error logging in before the block")
+
+		err = blockUC.Execute(ctx, &BlockPlayerRequest{PlayerID: "player2", Reason: "chargeback"})
+		assert.NoError(t, err, "Expected no error blocking the player")
+
+		valid, err := refreshRepo.ValidateRefreshToken(ctx, "player2", login.RefreshToken)
+		assert.NoError(t, err)
+		assert.False(t, valid, "Expected refresh tokens to be revoked")
+
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "bob@email.com", Password: "password"})
+		assert.Equal(t, ErrPlayerBlocked, err, "Expected blocked players to be refused at login")
+
+		err = unblockUC.Execute(ctx, &UnblockPlayerRequest{PlayerID: "player2"})
+		assert.NoError(t, err, "Expected no error unblocking the player")
+
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "bob@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected login to work after unblocking")
+	})
+
+	t.Run("Execute_ScopeRequired", func(t *testing.T) {
+		keyCtx := context.WithValue(ctx, contextkeys.ContextKeyScopes, []model.Scope{model.ScopePlayersRead})
+		err := blockUC.Execute(keyCtx, &BlockPlayerRequest{PlayerID: "player1", Reason: "fraud"})
+		assert.Equal(t, ErrForbidden, err, "Expected ErrForbidden without players:write")
+	})
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type CashoutSlotMachineUseCase struct {
+	TreasuryRepo    repository.TreasuryRepository
+	SlotMachineRepo repository.SlotMachineRepository
+	AuditRepo       repository.AuditRepository
+	Policy          MachineFloatPolicy
+	Transactor      ports.Transactor
+	now             func() time.Time
+}
+
+func NewCashoutSlotMachineUseCase(treasuryRepo repository.TreasuryRepository, slotRepo repository.SlotMachineRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *CashoutSlotMachineUseCase {
+	return &CashoutSlotMachineUseCase{
+		TreasuryRepo:    treasuryRepo,
+		SlotMachineRepo: slotRepo,
+		AuditRepo:       auditRepo,
+		Policy:          DefaultMachineFloatPolicy(),
+		Transactor:      transactor,
+		now:             time.Now,
+	}
+}
+
+// Execute recolhe fundos da máquina para a tesouraria, mantendo na máquina
+// ao menos Policy.MinFloat.
+func (uc *CashoutSlotMachineUseCase) Execute(ctx context.Context, req *MachineTransferRequest) (*model.TreasuryMovement, error) {
+	if err := authorize(ctx, model.ScopeTreasuryManage); err != nil {
+		return nil, err
+	}
+
+	return transferMachineFunds(ctx, uc.TreasuryRepo, uc.SlotMachineRepo, uc.AuditRepo, uc.Transactor, uc.Policy, model.MovementCashout, req, uc.now())
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+)
+
+var (
+	ErrInvalidCurrentPassword = errors.New("current password is incorrect")
+	ErrPasswordReused         = errors.New("new password must be different from the current one")
+)
+
+type ChangePasswordUseCase struct {
+	PlayerRepo       repository.PlayerRepository
+	RefreshTokenRepo repository.RefreshTokenRepository
+	Hasher           security.PasswordHasher
+	PasswordPolicy   security.PasswordPolicy
+	JWTManager       ports.JWTManager
+}
+
+type ChangePasswordRequest struct {
+	PlayerID        string `json:"-"`
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+type ChangePasswordResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+func NewChangePasswordUseCase(playerRepo repository.PlayerRepository, refreshRepo repository.RefreshTokenRepository, hasher security.PasswordHasher, policy security.PasswordPolicy, jwtManager ports.JWTManager) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		PlayerRepo:       playerRepo,
+		RefreshTokenRepo: refreshRepo,
+		Hasher:           hasher,
+		PasswordPolicy:   policy,
+		JWTManager:       jwtManager,
+	}
+}
+
+// Execute troca a senha do jogador, revoga todas as sessões existentes e
+// devolve um novo par de tokens para a sessão que fez a troca.
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	if req.CurrentPassword == "" || req.NewPassword == "" {
+		return nil, ErrValidate
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.Hasher.CompareHashAndPassword(player.Password, req.CurrentPassword); err != nil {
+		return nil, ErrInvalidCurrentPassword
+	}
+
+	if req.NewPassword == req.CurrentPassword {
+		return nil, ErrPasswordReused
+	}
+
+	if err := uc.PasswordPolicy.Validate(req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	passwordHashed, err := uc.Hasher.Hash(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.PlayerRepo.UpdatePassword(ctx, player.ID, passwordHashed); err != nil {
+		return nil, err
+	}
+
+	if err := uc.RefreshTokenRepo.RevokeAllRefreshTokens(ctx, player.ID); err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := issueTokenPair(ctx, uc.JWTManager, uc.RefreshTokenRepo, player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangePasswordResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	domain_security "slot-machine/internal/domain/security"
+	"slot-machine/internal/infrastructure/jwt"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"slot-machine/internal/infrastructure/security"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestChangePasswordUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	refreshRepo := repository_in_memory.NewInMemoryRefreshTokenRepository()
+	hasher := security.NewBcryptPasswordHasher(bcrypt.MinCost)
+	policy := security.NewPasswordPolicy(8, 0, nil)
+	jwtManager := jwt.NewJWTManager("secret", time.Minute, time.Hour)
+
+	changePasswordUC := NewChangePasswordUseCase(playerRepo, refreshRepo, hasher, policy, jwtManager)
+
+	hashed, err := hasher.Hash("old-password")
+	assert.NoError(t, err, "Erro ao gerar hash da senha")
+
+	err = playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Email: "player@email.com", Password: hashed})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	err = refreshRepo.StoreRefreshToken(ctx, "player1", "other-session")
+	assert.NoError(t, err, "Erro ao registrar sessão existente")
+
+	t.Run("Execute_WrongCurrentPassword", func(t *testing.T) {
+		resp, err := changePasswordUC.Execute(ctx, &ChangePasswordRequest{
+			PlayerID:        "player1",
+			CurrentPassword: "wrong-password",
+			NewPassword:     "new-password",
+		})
+
+		assert.Equal(t, ErrInvalidCurrentPassword, err, "Expected ErrInvalidCurrentPassword error")
+		assert.Nil(t, resp, "Expected no response when there is an error")
+	})
+
+	t.Run("Execute_PolicyViolation", func(t *testing.T) {
+		resp, err := changePasswordUC.Execute(ctx, &ChangePasswordRequest{
+			PlayerID:        "player1",
+			CurrentPassword: "old-password",
+			NewPassword:     "short",
+		})
+
+		assert.Equal(t, domain_security.ErrPasswordTooShort, err, "Expected ErrPasswordTooShort error")
+		assert.Nil(t, resp, "Expected no response when there is an error")
+	})
+
+	t.Run("Execute_Success", func(t *testing.T) {
+		resp, err := changePasswordUC.Execute(ctx, &ChangePasswordRequest{
+			PlayerID:        "player1",
+			CurrentPassword: "old-password",
+			NewPassword:     "new-password",
+		})
+
+		assert.NoError(t, err, "Expected no error when changing the password")
+		assert.NotEmpty(t, resp.AccessToken, "Expected a new access token")
+
+		player, err := playerRepo.GetPlayer(ctx, "player1")
+		assert.NoError(t, err, "Expected to find the player")
+		assert.NoError(t, hasher.CompareHashAndPassword(player.Password, "new-password"), "Expected the new password to be stored")
+
+		valid, err := refreshRepo.ValidateRefreshToken(ctx, "player1", "other-session")
+		assert.NoError(t, err, "Expected no error when validating the refresh token")
+		assert.False(t, valid, "Expected other sessions to be revoked")
+
+		valid, err = refreshRepo.ValidateRefreshToken(ctx, "player1", resp.RefreshToken)
+		assert.NoError(t, err, "Expected no error when validating the refresh token")
+		assert.True(t, valid, "Expected the new session to remain valid")
+	})
+}
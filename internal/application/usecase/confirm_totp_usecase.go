@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+	"time"
+)
+
+type ConfirmTOTPUseCase struct {
+	PlayerRepo       repository.PlayerRepository
+	RefreshTokenRepo repository.RefreshTokenRepository
+	RecoveryCodeRepo repository.RecoveryCodeRepository
+	LoginAttemptRepo repository.LoginAttemptRepository
+	JWTManager       ports.JWTManager
+	TOTP             security.TOTPProvider
+	Throttle         LoginThrottlePolicy
+	now              func() time.Time
+}
+
+type ConfirmTOTPRequest struct {
+	PlayerID string `json:"-"`
+	MFAToken string `json:"mfa_token,omitempty"`
+	Code     string `json:"code"`
+}
+
+// ConfirmTOTPResponse traz os códigos de recuperação, exibidos uma única vez.
+// Quando a confirmação acontece durante o login, os tokens da sessão também
+// são retornados.
+type ConfirmTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+	AccessToken   string   `json:"access_token,omitempty"`
+	RefreshToken  string   `json:"refresh_token,omitempty"`
+}
+
+func NewConfirmTOTPUseCase(playerRepo repository.PlayerRepository, refreshRepo repository.RefreshTokenRepository, recoveryRepo repository.RecoveryCodeRepository, attemptRepo repository.LoginAttemptRepository, jwtManager ports.JWTManager, totp security.TOTPProvider) *ConfirmTOTPUseCase {
+	return &ConfirmTOTPUseCase{
+		PlayerRepo:       playerRepo,
+		RefreshTokenRepo: refreshRepo,
+		RecoveryCodeRepo: recoveryRepo,
+		LoginAttemptRepo: attemptRepo,
+		JWTManager:       jwtManager,
+		TOTP:             totp,
+		Throttle:         DefaultLoginThrottlePolicy(),
+		now:              time.Now,
+	}
+}
+
+func (uc *ConfirmTOTPUseCase) Execute(ctx context.Context, req *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	if req.Code == "" {
+		return nil, ErrValidate
+	}
+
+	playerID, duringLogin, err := resolveTwoFactorPlayer(uc.JWTManager, req.PlayerID, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	if err := guardMFAAttempt(ctx, uc.LoginAttemptRepo, playerID, now); err != nil {
+		return nil, err
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if player.TOTPEnabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	if player.TOTPSecret == "" {
+		return nil, ErrTOTPNotEnabled
+	}
+
+	valid, err := verifyTOTPCode(ctx, uc.PlayerRepo, uc.TOTP, player, req.Code, now)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, registerMFAFailure(ctx, uc.LoginAttemptRepo, uc.Throttle, player.ID, now)
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.RecoveryCodeRepo.ReplaceRecoveryCodes(ctx, player.ID, hashes); err != nil {
+		return nil, err
+	}
+
+	if err := uc.PlayerRepo.EnableTOTP(ctx, player.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.LoginAttemptRepo.DeleteLoginAttempt(ctx, mfaAttemptKey(player.ID)); err != nil {
+		return nil, err
+	}
+
+	resp := &ConfirmTOTPResponse{
+		RecoveryCodes: codes,
+	}
+
+	if duringLogin {
+		resp.AccessToken, resp.RefreshToken, err = issueTokenPair(ctx, uc.JWTManager, uc.RefreshTokenRepo, player.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidScope = errors.New("invalid scope")
+)
+
+// As chaves têm o formato smk_<id>_<segredo>; o id permite localizar o
+// registro sem depender do segredo.
+const apiKeyPrefix = "smk"
+
+type CreateAPIKeyUseCase struct {
+	APIKeyRepo repository.APIKeyRepository
+	AuditRepo  repository.AuditRepository
+	Transactor ports.Transactor
+	now        func() time.Time
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string        `json:"name"`
+	Scopes    []model.Scope `json:"scopes"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+}
+
+// CreateAPIKeyResponse devolve a chave em texto claro. Ela não pode ser
+// recuperada depois.
+type CreateAPIKeyResponse struct {
+	Key    string       `json:"key"`
+	APIKey model.APIKey `json:"api_key"`
+}
+
+func NewCreateAPIKeyUseCase(apiKeyRepo repository.APIKeyRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		APIKeyRepo: apiKeyRepo,
+		AuditRepo:  auditRepo,
+		Transactor: transactor,
+		now:        time.Now,
+	}
+}
+
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	if err := authorize(ctx, model.ScopeAPIKeysManage); err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(req.Scopes) == 0 {
+		return nil, ErrValidate
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return nil, ErrValidate
+	}
+
+	for _, scope := range req.Scopes {
+		if !model.IsValidScope(scope) {
+			return nil, ErrInvalidScope
+		}
+	}
+
+	// Uma chave não pode conceder escopos que quem a cria não possui.
+	for _, scope := range req.Scopes {
+		if err := authorize(ctx, scope); err != nil {
+			return nil, err
+		}
+	}
+
+	// Uma chave criada com outra chave pertence ao administrador dono da
+	// primeira, e não à chave em si.
+	createdBy, err := responsibleAdmin(ctx, uc.APIKeyRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Uma chave derivada precisa expirar e não pode durar mais que a chave
+	// que a criou.
+	expiresAt := req.ExpiresAt
+	var parentKeyID *string
+	if apiKeyID, _ := ctx.Value(contextkeys.ContextKeyAPIKeyID).(string); apiKeyID != "" {
+		if expiresAt == nil {
+			return nil, ErrValidate
+		}
+		parent, err := uc.APIKeyRepo.GetAPIKey(ctx, apiKeyID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ExpiresAt != nil && expiresAt.After(*parent.ExpiresAt) {
+			expiresAt = parent.ExpiresAt
+		}
+		parentKeyID = &parent.ID
+	}
+
+	secret, err := generateSecureToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	key := formatAPIKey(id, secret)
+
+	apiKey := &model.APIKey{
+		ID:          id,
+		Name:        name,
+		KeyHash:     hashToken(key),
+		Scopes:      req.Scopes,
+		CreatedBy:   createdBy,
+		ParentKeyID: parentKeyID,
+		CreatedAt:   now,
+		ExpiresAt:   expiresAt,
+	}
+
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.APIKeyRepo.CreateAPIKey(ctx, apiKey); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionAPIKeyCreate, "api_key", apiKey.ID, nil, apiKey, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateAPIKeyResponse{
+		Key:    key,
+		APIKey: *apiKey,
+	}, nil
+}
+
+func formatAPIKey(id, secret string) string {
+	return apiKeyPrefix + "_" + id + "_" + secret
+}
+
+func parseAPIKey(key string) (string, bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) != 3 || parts[0] != apiKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateJackpotPoolUseCase struct {
+	JackpotRepo repository.JackpotRepository
+	AuditRepo   repository.AuditRepository
+	// Events anuncia o novo pool com o valor inicial.
+	Events     ports.EventPublisher
+	Transactor ports.Transactor
+	now        func() time.Time
+}
+
+// CreateJackpotPoolRequest exige ao menos um gatilho: a combinação de três
+// símbolos ou a chance de 1 em TriggerOdds por jogada.
+type CreateJackpotPoolRequest struct {
+	Name                string   `json:"name"`
+	SeedAmount          int      `json:"seed_amount"`
+	ContributionPercent float64  `json:"contribution_percent"`
+	TriggerCombination  []string `json:"trigger_combination"`
+	TriggerOdds         int      `json:"trigger_odds"`
+}
+
+func NewCreateJackpotPoolUseCase(jackpotRepo repository.JackpotRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *CreateJackpotPoolUseCase {
+	return &CreateJackpotPoolUseCase{
+		JackpotRepo: jackpotRepo,
+		AuditRepo:   auditRepo,
+		Transactor:  transactor,
+		now:         time.Now,
+	}
+}
+
+func (uc *CreateJackpotPoolUseCase) Execute(ctx context.Context, req *CreateJackpotPoolRequest) (*model.JackpotPool, error) {
+	if err := authorize(ctx, model.ScopeJackpotsManage); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || req.SeedAmount < 0 || req.TriggerOdds < 0 {
+		return nil, ErrValidate
+	}
+	if req.ContributionPercent <= 0 || req.ContributionPercent > 100 {
+		return nil, ErrValidate
+	}
+	if !validTriggerCombination(req.TriggerCombination) || (len(req.TriggerCombination) == 0 && req.TriggerOdds == 0) {
+		return nil, ErrValidate
+	}
+
+	now := uc.now()
+	actor, _ := ctx.Value(contextkeys.ContextKeyUserID).(string)
+	pool := &model.JackpotPool{
+		ID:                  uuid.New().String(),
+		Name:                name,
+		SeedAmount:          req.SeedAmount,
+		ContributionPercent: req.ContributionPercent,
+		TriggerCombination:  req.TriggerCombination,
+		TriggerOdds:         req.TriggerOdds,
+		Amount:              req.SeedAmount,
+		TotalSeeded:         req.SeedAmount,
+		MachineIDs:          []string{},
+		CreatedAt:           now,
+	}
+	seed := &model.TreasuryMovement{
+		ID:            uuid.New().String(),
+		Type:          model.MovementJackpotSeed,
+		JackpotPoolID: pool.ID,
+		Amount:        req.SeedAmount,
+		Reason:        "jackpot seed",
+		Actor:         actor,
+		CreatedAt:     now,
+	}
+	err := uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.JackpotRepo.CreatePool(ctx, pool, seed); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionJackpotCreate, "jackpot", pool.ID, nil, pool, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	publishEvent(uc.Events, model.EventJackpotUpdated, "", jackpotUpdate(pool, nil), now)
+	return pool, nil
+}
+
+// validTriggerCombination aceita nenhuma combinação ou exatamente três
+// símbolos conhecidos pelas máquinas.
+func validTriggerCombination(combination []string) bool {
+	if len(combination) == 0 {
+		return true
+	}
+	if len(combination) != 3 {
+		return false
+	}
+	symbols := model.DefaultSymbols()
+	for _, symbol := range combination {
+		if _, ok := symbols[symbol]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"math/rand"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateJackpotPoolUseCase(t *testing.T) {
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	txRepo := repository_in_memory.NewInMemoryTransactionRepository()
+	apiKeyRepo := repository_in_memory.NewInMemoryAPIKeyRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	treasuryRepo := repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)
+	adjustmentRepo := repository_in_memory.NewInMemoryAdjustmentRepository(playerRepo, slotRepo, txRepo, treasuryRepo)
+	spinRepo := repository_in_memory.NewInMemorySpinRepository()
+	jackpotRepo := repository_in_memory.NewInMemoryJackpotRepository(treasuryRepo)
+	reconciliationRepo := repository_in_memory.NewInMemoryReconciliationRepository(playerRepo, slotRepo, txRepo, adjustmentRepo, treasuryRepo, spinRepo, jackpotRepo)
+
+	createUC := NewCreateJackpotPoolUseCase(jackpotRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	linkUC := NewLinkJackpotMachineUseCase(jackpotRepo, slotRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	unlinkUC := NewUnlinkJackpotMachineUseCase(jackpotRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+
+	adminCtx := func(userID string) context.Context {
+		ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, userID)
+		return context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+	}
+	ctx := adminCtx("admin1")
+
+	limitRepo := repository_in_memory.NewInMemoryGamblingLimitRepository()
+	exclusionRepo := repository_in_memory.NewInMemorySelfExclusionRepository()
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+	_, err = NewDepositUseCase(playerRepo, txRepo, limitRepo, exclusionRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor()).Execute(ctx, &DepositRequest{PlayerID: "player1", Amount: 1000})
+	assert.NoError(t, err, "Erro ao depositar para testes")
+	for _, id := range []string{"machine1", "machine2"} {
+		err = slotRepo.CreateSlotMachine(ctx, model.NewSlotMachine(id, 1, 1000, 2, "Máquina de testes"))
+		assert.NoError(t, err, "Erro ao criar máquina para testes")
+	}
+
+	adjustment, err := NewProposeAdjustmentUseCase(adjustmentRepo, playerRepo, slotRepo, apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor()).Execute(ctx, &ProposeAdjustmentRequest{
+		TargetType: model.AdjustmentTargetTreasury,
+		Amount:     1000,
+		ReasonCode: model.ReasonTreasuryFunding,
+	})
+	assert.NoError(t, err, "Erro ao propor ajuste da tesouraria")
+	_, err = NewApproveAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, playerRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor()).Execute(adminCtx("admin2"), &ApproveAdjustmentRequest{ID: adjustment.ID})
+	assert.NoError(t, err, "Erro ao aprovar ajuste da tesouraria")
+
+	var pool *model.JackpotPool
+
+	t.Run("Execute_RejectsInvalidPools", func(t *testing.T) {
+		for _, req := range []*CreateJackpotPoolRequest{
+			{Name: "", SeedAmount: 100, ContributionPercent: 1, TriggerOdds: 10},
+			{Name: "Mega", SeedAmount: 100, ContributionPercent: 0, TriggerOdds: 10},
+			{Name: "Mega", SeedAmount: 100, ContributionPercent: 101, TriggerOdds: 10},
+			{Name: "Mega", SeedAmount: 100, ContributionPercent: 1},
+			{Name: "Mega", SeedAmount: 100, ContributionPercent: 1, TriggerCombination: []string{"alien", "alien"}},
+			{Name: "Mega", SeedAmount: 100, ContributionPercent: 1, TriggerCombination: []string{"alien", "alien", "seven"}},
+		} {
+			_, err := createUC.Execute(ctx, req)
+			assert.Equal(t, ErrValidate, err)
+		}
+	})
+
+	t.Run("Execute_RequiresAdmin", func(t *testing.T) {
+		playerCtx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "player1")
+		_, err := createUC.Execute(playerCtx, &CreateJackpotPoolRequest{Name: "Mega", SeedAmount: 100, ContributionPercent: 1, TriggerOdds: 1})
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("Execute_RejectsSeedAboveTreasury", func(t *testing.T) {
+		_, err := createUC.Execute(ctx, &CreateJackpotPoolRequest{Name: "Mega", SeedAmount: 5000, ContributionPercent: 1, TriggerOdds: 1})
+		assert.Equal(t, repository.ErrInsufficientTreasuryBalance, err)
+	})
+
+	t.Run("Execute_SeedsFromTreasury", func(t *testing.T) {
+		pool, err = createUC.Execute(ctx, &CreateJackpotPoolRequest{Name: "Mega", SeedAmount: 300, ContributionPercent: 10, TriggerOdds: 1})
+		assert.NoError(t, err, "Expected no error creating the pool")
+		assert.Equal(t, 300, pool.Amount)
+
+		treasury, err := treasuryRepo.GetTreasury(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 700, treasury.Balance)
+	})
+
+	t.Run("LinkMachine_OnlyOnePoolPerMachine", func(t *testing.T) {
+		linked, err := linkUC.Execute(ctx, &JackpotMachineRequest{PoolID: pool.ID, MachineID: "machine1"})
+		assert.NoError(t, err, "Expected no error linking the machine")
+		assert.Equal(t, []string{"machine1"}, linked.MachineIDs)
+
+		other, err := createUC.Execute(ctx, &CreateJackpotPoolRequest{Name: "Mini", ContributionPercent: 1, TriggerCombination: []string{"alien", "alien", "alien"}})
+		assert.NoError(t, err, "Expected no error creating an unseeded pool")
+		_, err = linkUC.Execute(ctx, &JackpotMachineRequest{PoolID: other.ID, MachineID: "machine1"})
+		assert.Equal(t, repository.ErrMachineAlreadyLinked, err)
+
+		_, err = linkUC.Execute(ctx, &JackpotMachineRequest{PoolID: pool.ID, MachineID: "missing"})
+		assert.Equal(t, repository.ErrSlotMachineNotFound, err)
+		_, err = unlinkUC.Execute(ctx, &JackpotMachineRequest{PoolID: other.ID, MachineID: "machine1"})
+		assert.Equal(t, repository.ErrMachineNotLinked, err)
+	})
+
+	t.Run("Play_PaysJackpotAndReseeds", func(t *testing.T) {
+		playUC := NewPlayUseCase(playerRepo, slotRepo, txRepo, limitRepo, repository_in_memory.NewInMemoryPlaySessionRepository(), exclusionRepo, spinRepo, jackpotRepo, repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+		playUC.rng = rand.New(rand.NewSource(1))
+
+		resp, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 100})
+		assert.NoError(t, err, "Expected no error playing")
+		if assert.NotNil(t, resp.Jackpot, "Expected the jackpot to trigger") {
+			assert.Equal(t, 310, resp.Jackpot.Amount)
+		}
+
+		updated, err := jackpotRepo.GetPool(ctx, pool.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 300, updated.Amount)
+		assert.Equal(t, 310, updated.TotalPaid)
+		assert.Equal(t, 600, updated.TotalSeeded)
+
+		treasury, err := treasuryRepo.GetTreasury(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 400, treasury.Balance)
+
+		snapshot, err := reconciliationRepo.LoadLedgerSnapshot(ctx)
+		assert.NoError(t, err)
+		_, totals := snapshot.Reconcile()
+		assert.Equal(t, 0, totals.Difference, "Expected the ledger to match after a jackpot")
+	})
+
+	t.Run("ListJackpots_HidesPlayers", func(t *testing.T) {
+		resp, err := NewListJackpotsUseCase(jackpotRepo).Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, resp.Jackpots, 2)
+		assert.Equal(t, pool.ID, resp.Jackpots[0].ID)
+		if assert.Len(t, resp.RecentWinners, 1) {
+			assert.Equal(t, "machine1", resp.RecentWinners[0].MachineID)
+			assert.Equal(t, 310, resp.RecentWinners[0].Amount)
+		}
+	})
+
+	t.Run("UnlinkMachine_StopsContributions", func(t *testing.T) {
+		unlinked, err := unlinkUC.Execute(ctx, &JackpotMachineRequest{PoolID: pool.ID, MachineID: "machine1"})
+		assert.NoError(t, err, "Expected no error unlinking the machine")
+		assert.Empty(t, unlinked.MachineIDs)
+
+		_, err = jackpotRepo.GetMachinePool(ctx, "machine1")
+		assert.Equal(t, repository.ErrJackpotPoolNotFound, err)
+	})
+}
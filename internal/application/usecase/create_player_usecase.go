@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
 	"slot-machine/internal/domain/repository"
 	"slot-machine/internal/domain/security"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,11 +15,19 @@ import (
 var (
 	ErrPlayerAlreadyExists = errors.New("player already exists")
 	ErrValidate            = errors.New("error validating")
+	ErrInvalidEmail        = errors.New("invalid email address")
 )
 
 type CreatePlayerUseCase struct {
-	PlayerRepo     repository.PlayerRepository
-	PasswordHasher security.PasswordHasher
+	PlayerRepo                repository.PlayerRepository
+	PasswordHasher            security.PasswordHasher
+	PasswordPolicy            security.PasswordPolicy
+	ActionTokenRepo           repository.ActionTokenRepository
+	Notifier                  ports.Notifier
+	OutboxRepo                repository.OutboxRepository
+	Transactor                ports.Transactor
+	VerificationTokenDuration time.Duration
+	now                       func() time.Time
 }
 
 type CreatePlayerRequest struct {
@@ -30,10 +40,17 @@ type CreatePlayerResponse struct {
 	Player model.Player `json:"player"`
 }
 
-func NewCreatePlayerUseCase(repo repository.PlayerRepository, hasher security.PasswordHasher) *CreatePlayerUseCase {
+func NewCreatePlayerUseCase(repo repository.PlayerRepository, hasher security.PasswordHasher, policy security.PasswordPolicy, tokenRepo repository.ActionTokenRepository, notifier ports.Notifier, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *CreatePlayerUseCase {
 	return &CreatePlayerUseCase{
-		PlayerRepo:     repo,
-		PasswordHasher: hasher,
+		PlayerRepo:                repo,
+		PasswordHasher:            hasher,
+		PasswordPolicy:            policy,
+		ActionTokenRepo:           tokenRepo,
+		Notifier:                  notifier,
+		OutboxRepo:                outboxRepo,
+		Transactor:                transactor,
+		VerificationTokenDuration: defaultEmailVerificationTokenDuration,
+		now:                       time.Now,
 	}
 }
 
@@ -43,7 +60,16 @@ func (uc *CreatePlayerUseCase) Execute(ctx context.Context, req *CreatePlayerReq
 		return nil, ErrValidate
 	}
 
-	playerCreated, err := uc.PlayerRepo.GetPlayerByEmail(ctx, req.Email)
+	email := model.NormalizeEmail(req.Email)
+	if !model.IsValidEmail(email) {
+		return nil, ErrInvalidEmail
+	}
+
+	if err := uc.PasswordPolicy.Validate(req.Password); err != nil {
+		return nil, err
+	}
+
+	playerCreated, err := uc.PlayerRepo.GetPlayerByEmail(ctx, email)
 
 	if playerCreated != nil {
 		return nil, ErrPlayerAlreadyExists
@@ -59,14 +85,29 @@ func (uc *CreatePlayerUseCase) Execute(ctx context.Context, req *CreatePlayerReq
 	}
 
 	player := &model.Player{
-		ID:       uuid.New().String(),
-		Balance:  req.Balance,
-		Email:    req.Email,
-		Password: passwordHashed,
-		Role:     model.PlayerRole,
+		ID:        uuid.New().String(),
+		Balance:   req.Balance,
+		Email:     email,
+		Password:  passwordHashed,
+		Role:      model.PlayerRole,
+		CreatedAt: uc.now().UTC(),
+	}
+
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.PlayerRepo.CreatePlayer(ctx, player); err != nil {
+			return err
+		}
+		return recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventPlayerRegistered, "player", player.ID, model.PlayerRegisteredPayload{
+			PlayerID: player.ID,
+			Email:    player.Email,
+			Balance:  player.Balance,
+		}, player.CreatedAt)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := uc.PlayerRepo.CreatePlayer(ctx, player); err != nil {
+	if err := sendEmailVerification(ctx, uc.ActionTokenRepo, uc.Notifier, player, uc.VerificationTokenDuration, uc.now()); err != nil {
 		return nil, err
 	}
 
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"slot-machine/internal/domain/model"
+	domain_security "slot-machine/internal/domain/security"
 	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
 	"slot-machine/internal/infrastructure/security"
 	"testing"
@@ -15,7 +16,10 @@ func TestCreatePlayerUseCase(t *testing.T) {
 	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
 	hasher := security.NewBcryptPasswordHasher(bcrypt.DefaultCost)
 
-	createPlayerUC := NewCreatePlayerUseCase(playerRepo, hasher)
+	tokenRepo := repository_in_memory.NewInMemoryActionTokenRepository()
+	notifier := &capturingNotifier{}
+
+	createPlayerUC := NewCreatePlayerUseCase(playerRepo, hasher, security.NewPasswordPolicy(8, 0, []string{"123456789"}), tokenRepo, notifier, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
 
 	ctx := context.Background()
 
@@ -37,6 +41,10 @@ func TestCreatePlayerUseCase(t *testing.T) {
 		storedPlayer, err := playerRepo.GetPlayer(ctx, resp.Player.ID)
 		assert.NoError(t, err, "Expected no error when retrieving the created player")
 		assert.Equal(t, resp.Player, *storedPlayer, "Stored player should match the response")
+		assert.False(t, storedPlayer.EmailVerified, "New players should start with an unverified email")
+
+		assert.Len(t, notifier.notifications, 1, "Expected a verification email to be sent")
+		assert.Equal(t, req.Email, notifier.notifications[0].To, "Verification email should be sent to the player")
 	})
 
 	t.Run("Execute_PlayerAlreadyExists", func(t *testing.T) {
@@ -65,4 +73,59 @@ func TestCreatePlayerUseCase(t *testing.T) {
 		assert.NoError(t, err, "Expected no error when retrieving the existing player")
 		assert.Equal(t, initialPlayer.Balance, storedPlayer.Balance, "Player balance should remain unchanged")
 	})
+
+	t.Run("Execute_EmailNormalized", func(t *testing.T) {
+		req := &CreatePlayerRequest{
+			Email:    "  Mixed.Case@Email.CO ",
+			Password: "password",
+		}
+
+		resp, err := createPlayerUC.Execute(ctx, req)
+
+		assert.NoError(t, err, "Expected no error when creating a player")
+		assert.Equal(t, "mixed.case@email.co", resp.Player.Email, "Email should be stored in canonical form")
+
+		resp, err = createPlayerUC.Execute(ctx, &CreatePlayerRequest{
+			Email:    "MIXED.CASE@email.co",
+			Password: "password",
+		})
+		assert.Equal(t, ErrPlayerAlreadyExists, err, "Expected emails differing only by case to conflict")
+		assert.Nil(t, resp, "Expected no response when there is an error")
+	})
+
+	t.Run("Execute_InvalidEmail", func(t *testing.T) {
+		for _, email := range []string{"email", "John <john@email.co>", "john@localhost", "john@@email.co"} {
+			resp, err := createPlayerUC.Execute(ctx, &CreatePlayerRequest{
+				Email:    email,
+				Password: "password",
+			})
+
+			assert.Equal(t, ErrInvalidEmail, err, "Expected ErrInvalidEmail error for %q", email)
+			assert.Nil(t, resp, "Expected no response when there is an error")
+		}
+	})
+
+	t.Run("Execute_PasswordTooShort", func(t *testing.T) {
+		req := &CreatePlayerRequest{
+			Email:    "short@email.co",
+			Password: "abc",
+		}
+
+		resp, err := createPlayerUC.Execute(ctx, req)
+
+		assert.Equal(t, domain_security.ErrPasswordTooShort, err, "Expected ErrPasswordTooShort error")
+		assert.Nil(t, resp, "Expected no response when there is an error")
+	})
+
+	t.Run("Execute_PasswordBreached", func(t *testing.T) {
+		req := &CreatePlayerRequest{
+			Email:    "breached@email.co",
+			Password: "123456789",
+		}
+
+		resp, err := createPlayerUC.Execute(ctx, req)
+
+		assert.Equal(t, domain_security.ErrPasswordBreached, err, "Expected ErrPasswordBreached error")
+		assert.Nil(t, resp, "Expected no response when there is an error")
+	})
 }
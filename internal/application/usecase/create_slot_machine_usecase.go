@@ -3,9 +3,10 @@ package usecase
 import (
 	"context"
 	"errors"
-	"slot-machine/internal/domain/contextkeys"
 	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
 	"slot-machine/internal/domain/repository"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,30 +17,58 @@ var (
 
 type CreateSlotMachineUseCase struct {
 	SlotMachineRepo repository.SlotMachineRepository
+	AuditRepo       repository.AuditRepository
+	OutboxRepo      repository.OutboxRepository
+	Transactor      ports.Transactor
+	now             func() time.Time
 }
 
+const (
+	maxReels       = 5
+	maxRows        = 3
+	maxPaylines    = 25
+	maxStripStops  = 100
+	maxStripWeight = 10000
+)
+
+// CreateSlotMachineRequest aceita Bonus opcional para conceder rodadas
+// grátis quando o símbolo scatter aparece na jogada. Reels e Rows definem a
+// grade (padrão 3x1); Paylines e Paytable omitidos usam os padrões da grade.
+// Strips, uma fita por rolo, substitui as fitas equivalentes ao Level.
+// NearMiss diferente de "off" exige Jurisdiction; a apresentação só aparece
+// se a jurisdição estiver na NearMissPolicy do servidor.
 type CreateSlotMachineRequest struct {
-	Level        int    `json:"level"`
-	Balance      int    `json:"balance"`
-	MultipleGain int    `json:"multiple_gain"`
-	Description  string `json:"description"`
+	Level        int                 `json:"level"`
+	Balance      int                 `json:"balance"`
+	MultipleGain int                 `json:"multiple_gain"`
+	Description  string              `json:"description"`
+	Reels        int                 `json:"reels,omitempty"`
+	Rows         int                 `json:"rows,omitempty"`
+	Paylines     []model.Payline     `json:"paylines,omitempty"`
+	Paytable     []model.LinePay     `json:"paytable,omitempty"`
+	Strips       []model.ReelStrip   `json:"strips,omitempty"`
+	Bonus        *model.BonusFeature `json:"bonus,omitempty"`
+	Jurisdiction string              `json:"jurisdiction,omitempty"`
+	NearMiss     model.NearMissMode  `json:"near_miss,omitempty"`
 }
 
 type CreateSlotMachineResponse struct {
 	Machine model.SlotMachine `json:"machine"`
 }
 
-func NewCreateSlotMachineUseCase(smr repository.SlotMachineRepository) *CreateSlotMachineUseCase {
+func NewCreateSlotMachineUseCase(smr repository.SlotMachineRepository, auditRepo repository.AuditRepository, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *CreateSlotMachineUseCase {
 	return &CreateSlotMachineUseCase{
 		SlotMachineRepo: smr,
+		AuditRepo:       auditRepo,
+		OutboxRepo:      outboxRepo,
+		Transactor:      transactor,
+		now:             time.Now,
 	}
 }
 
 func (uc *CreateSlotMachineUseCase) Execute(ctx context.Context, req *CreateSlotMachineRequest) (*CreateSlotMachineResponse, error) {
-	isAdmin, ok := ctx.Value(contextkeys.ContextKeyIsAdmin).(bool)
-
-	if !ok || !isAdmin {
-		return nil, ErrUnauthorized
+	if err := authorize(ctx, model.ScopeMachinesWrite); err != nil {
+		return nil, err
 	}
 
 	id := uuid.New().String()
@@ -48,9 +77,61 @@ func (uc *CreateSlotMachineUseCase) Execute(ctx context.Context, req *CreateSlot
 		return nil, ErrValidate
 	}
 
+	reels, rows := req.Reels, req.Rows
+	if reels == 0 {
+		reels = model.DefaultReels
+	}
+	if rows == 0 {
+		rows = model.DefaultRows
+	}
+	if reels < model.DefaultReels || reels > maxReels || rows < 1 || rows > maxRows {
+		return nil, ErrValidate
+	}
+	if !validPaylines(req.Paylines, reels, rows) || !validPaytable(req.Paytable, reels) {
+		return nil, ErrValidate
+	}
+	if !req.NearMiss.Valid() || (req.NearMiss.Enabled() && req.Jurisdiction == "") {
+		return nil, ErrValidate
+	}
+
 	machine := model.NewSlotMachine(id, req.Level, req.Balance, req.MultipleGain, req.Description)
+	machine.ConfigureGrid(reels, rows, req.Paylines, req.Paytable)
+	if len(req.Strips) > 0 {
+		if !validReelStrips(req.Strips, reels, machine.Symbols) {
+			return nil, ErrValidate
+		}
+		machine.Strips = make([]model.ReelStrip, len(req.Strips))
+		for i, strip := range req.Strips {
+			machine.Strips[i] = model.NewReelStrip(strip.Symbols, strip.Weights)
+		}
+		machine.AlignedWeight = 0
+	}
+	if req.Bonus != nil {
+		if !validBonusFeature(req.Bonus, machine.Symbols, reels*rows) {
+			return nil, ErrValidate
+		}
+		machine.Bonus = req.Bonus
+	}
+	machine.Jurisdiction = req.Jurisdiction
+	machine.NearMiss = req.NearMiss
 
-	if err := uc.SlotMachineRepo.CreateSlotMachine(ctx, machine); err != nil {
+	now := uc.now()
+	err := uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.SlotMachineRepo.CreateSlotMachine(ctx, machine); err != nil {
+			return err
+		}
+		if err := recordAudit(ctx, uc.AuditRepo, AuditActionMachineCreate, "machine", machine.ID, nil, machine, now); err != nil {
+			return err
+		}
+		return recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventMachineCreated, "machine", machine.ID, model.MachineCreatedPayload{
+			MachineID:    machine.ID,
+			Balance:      machine.Balance,
+			Reels:        machine.Reels,
+			Rows:         machine.Rows,
+			Jurisdiction: machine.Jurisdiction,
+		}, now)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -58,3 +139,77 @@ func (uc *CreateSlotMachineUseCase) Execute(ctx context.Context, req *CreateSlot
 		Machine: *machine,
 	}, nil
 }
+
+// validPaylines exige uma linha da grade para cada rolo.
+func validPaylines(paylines []model.Payline, reels, rows int) bool {
+	if len(paylines) > maxPaylines {
+		return false
+	}
+	for _, line := range paylines {
+		if len(line) != reels {
+			return false
+		}
+		for _, row := range line {
+			if row < 0 || row >= rows {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validPaytable exige ao menos dois símbolos por prêmio, sem repetir a
+// contagem.
+func validPaytable(paytable []model.LinePay, reels int) bool {
+	counts := make(map[int]bool, len(paytable))
+	for _, pay := range paytable {
+		if pay.Count < 2 || pay.Count > reels || pay.Multiplier < 1 || counts[pay.Count] {
+			return false
+		}
+		counts[pay.Count] = true
+	}
+	return true
+}
+
+// validReelStrips exige uma fita por rolo, com símbolos da máquina e, se
+// informados, pesos positivos para cada parada.
+func validReelStrips(strips []model.ReelStrip, reels int, symbols map[string]string) bool {
+	if len(strips) != reels {
+		return false
+	}
+	for _, strip := range strips {
+		if len(strip.Symbols) == 0 || len(strip.Symbols) > maxStripStops || (len(strip.Weights) > 0 && len(strip.Weights) != len(strip.Symbols)) {
+			return false
+		}
+		for _, symbol := range strip.Symbols {
+			if _, ok := symbols[symbol]; !ok {
+				return false
+			}
+		}
+		for _, weight := range strip.Weights {
+			if weight < 1 || weight > maxStripWeight {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validBonusFeature exige um scatter entre os símbolos da máquina e prêmios
+// com contagem possível na grade, sem repetição.
+func validBonusFeature(bonus *model.BonusFeature, symbols map[string]string, cells int) bool {
+	if _, ok := symbols[bonus.ScatterSymbol]; !ok || len(bonus.Awards) == 0 {
+		return false
+	}
+	counts := make(map[int]bool, len(bonus.Awards))
+	for _, award := range bonus.Awards {
+		if award.Count < 1 || award.Count > cells || counts[award.Count] {
+			return false
+		}
+		if award.FreeSpins <= 0 || award.Multiplier < 0 {
+			return false
+		}
+		counts[award.Count] = true
+	}
+	return true
+}
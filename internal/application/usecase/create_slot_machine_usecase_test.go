@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
 	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
 	"testing"
 
@@ -12,7 +13,7 @@ import (
 func TestCreateSlotMachineUseCase(t *testing.T) {
 	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
 
-	createSlotMachineUC := NewCreateSlotMachineUseCase(slotRepo)
+	createSlotMachineUC := NewCreateSlotMachineUseCase(slotRepo, repository_in_memory.NewInMemoryAuditRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
 
 	ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "admin")
 	ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
@@ -39,4 +40,87 @@ func TestCreateSlotMachineUseCase(t *testing.T) {
 		assert.NoError(t, err, "Expected no error when retrieving the created slot machine")
 		assert.Equal(t, resp.Machine, *storedMachine, "Stored slot machine should match the response")
 	})
+
+	t.Run("Execute_WithBonus", func(t *testing.T) {
+		req := &CreateSlotMachineRequest{
+			Level:        1,
+			Balance:      10000,
+			MultipleGain: 3,
+			Description:  "teste",
+			Bonus: &model.BonusFeature{
+				ScatterSymbol: "collision",
+				Awards:        []model.ScatterAward{{Count: 3, FreeSpins: 10, Multiplier: 2}},
+			},
+		}
+
+		resp, err := createSlotMachineUC.Execute(ctx, req)
+		assert.NoError(t, err, "Expected no error when creating a slot machine with a bonus")
+		assert.Equal(t, req.Bonus, resp.Machine.Bonus)
+	})
+
+	t.Run("Execute_InvalidBonus", func(t *testing.T) {
+		for _, bonus := range []*model.BonusFeature{
+			{ScatterSymbol: "unknown", Awards: []model.ScatterAward{{Count: 3, FreeSpins: 10}}},
+			{ScatterSymbol: "collision"},
+			{ScatterSymbol: "collision", Awards: []model.ScatterAward{{Count: 4, FreeSpins: 10}}},
+			{ScatterSymbol: "collision", Awards: []model.ScatterAward{{Count: 3, FreeSpins: 0}}},
+			{ScatterSymbol: "collision", Awards: []model.ScatterAward{{Count: 2, FreeSpins: 5}, {Count: 2, FreeSpins: 8}}},
+		} {
+			_, err := createSlotMachineUC.Execute(ctx, &CreateSlotMachineRequest{Level: 1, MultipleGain: 3, Description: "teste", Bonus: bonus})
+			assert.Equal(t, ErrValidate, err)
+		}
+	})
+
+	t.Run("Execute_WithGrid", func(t *testing.T) {
+		req := &CreateSlotMachineRequest{
+			Level:        1,
+			Balance:      10000,
+			MultipleGain: 10,
+			Description:  "teste",
+			Reels:        5,
+			Rows:         3,
+			Paytable:     []model.LinePay{{Count: 3, Multiplier: 1}, {Count: 4, Multiplier: 3}, {Count: 5, Multiplier: 10}},
+		}
+
+		resp, err := createSlotMachineUC.Execute(ctx, req)
+		assert.NoError(t, err, "Expected no error when creating a 3x5 slot machine")
+		assert.Equal(t, 5, resp.Machine.Reels)
+		assert.Equal(t, 3, resp.Machine.Rows)
+		assert.Len(t, resp.Machine.Paylines, 5, "Expected the default paylines for a 3x5 grid")
+		assert.Len(t, resp.Machine.Strips, 5)
+	})
+
+	t.Run("Execute_InvalidGrid", func(t *testing.T) {
+		for _, req := range []*CreateSlotMachineRequest{
+			{Reels: 6},
+			{Reels: 2},
+			{Rows: 4},
+			{Reels: 5, Paylines: []model.Payline{{0, 0, 0}}},
+			{Rows: 2, Paylines: []model.Payline{{0, 1, 2}}},
+			{Paytable: []model.LinePay{{Count: 4, Multiplier: 2}}},
+			{Paytable: []model.LinePay{{Count: 3, Multiplier: 2}, {Count: 3, Multiplier: 5}}},
+		} {
+			req.Level, req.MultipleGain, req.Description = 1, 3, "teste"
+			_, err := createSlotMachineUC.Execute(ctx, req)
+			assert.Equal(t, ErrValidate, err)
+		}
+	})
+
+	t.Run("Execute_NearMiss", func(t *testing.T) {
+		req := &CreateSlotMachineRequest{Level: 1, MultipleGain: 3, Description: "teste", Jurisdiction: "MT", NearMiss: model.NearMissReelWindow}
+
+		resp, err := createSlotMachineUC.Execute(ctx, req)
+		assert.NoError(t, err, "Expected no error when enabling near-miss presentation")
+		assert.Equal(t, "MT", resp.Machine.Jurisdiction)
+		assert.Equal(t, model.NearMissReelWindow, resp.Machine.NearMiss)
+
+		for _, req := range []*CreateSlotMachineRequest{
+			{NearMiss: model.NearMissReelWindow},
+			{Jurisdiction: "MT", NearMiss: "rewrite"},
+		} {
+			req.Level, req.MultipleGain, req.Description = 1, 3, "teste"
+			_, err := createSlotMachineUC.Execute(ctx, req)
+			assert.Equal(t, ErrValidate, err)
+		}
+	})
 }
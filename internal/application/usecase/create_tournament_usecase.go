@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateTournamentUseCase struct {
+	TournamentRepo  repository.TournamentRepository
+	SlotMachineRepo repository.SlotMachineRepository
+	AuditRepo       repository.AuditRepository
+	Transactor      ports.Transactor
+	now             func() time.Time
+}
+
+// CreateTournamentRequest define em PrizeDistribution o percentual do prêmio
+// total de cada colocação; a soma não pode passar de 100.
+type CreateTournamentRequest struct {
+	Name              string                  `json:"name"`
+	MachineIDs        []string                `json:"machine_ids"`
+	StartsAt          time.Time               `json:"starts_at"`
+	EndsAt            time.Time               `json:"ends_at"`
+	EntryFee          int                     `json:"entry_fee"`
+	GuaranteedPrize   int                     `json:"guaranteed_prize"`
+	PrizeDistribution []int                   `json:"prize_distribution"`
+	Scoring           model.TournamentScoring `json:"scoring"`
+}
+
+func NewCreateTournamentUseCase(tournamentRepo repository.TournamentRepository, slotRepo repository.SlotMachineRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *CreateTournamentUseCase {
+	return &CreateTournamentUseCase{
+		TournamentRepo:  tournamentRepo,
+		SlotMachineRepo: slotRepo,
+		AuditRepo:       auditRepo,
+		Transactor:      transactor,
+		now:             time.Now,
+	}
+}
+
+func (uc *CreateTournamentUseCase) Execute(ctx context.Context, req *CreateTournamentRequest) (*model.Tournament, error) {
+	if err := authorize(ctx, model.ScopeTournamentsManage); err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(req.MachineIDs) == 0 || !model.IsValidTournamentScoring(req.Scoring) {
+		return nil, ErrValidate
+	}
+	if !req.EndsAt.After(req.StartsAt) || !req.EndsAt.After(now) {
+		return nil, ErrValidate
+	}
+	if req.EntryFee < 0 || req.GuaranteedPrize < 0 || !validPrizeDistribution(req.PrizeDistribution) {
+		return nil, ErrValidate
+	}
+
+	machineIDs := make([]string, 0, len(req.MachineIDs))
+	seen := make(map[string]bool, len(req.MachineIDs))
+	for _, machineID := range req.MachineIDs {
+		if seen[machineID] {
+			continue
+		}
+		seen[machineID] = true
+		if _, err := uc.SlotMachineRepo.GetSlotMachine(ctx, machineID); err != nil {
+			return nil, err
+		}
+		machineIDs = append(machineIDs, machineID)
+	}
+
+	createdBy, _ := ctx.Value(contextkeys.ContextKeyUserID).(string)
+	tournament := &model.Tournament{
+		ID:                uuid.New().String(),
+		Name:              name,
+		MachineIDs:        machineIDs,
+		StartsAt:          req.StartsAt,
+		EndsAt:            req.EndsAt,
+		EntryFee:          req.EntryFee,
+		GuaranteedPrize:   req.GuaranteedPrize,
+		PrizeDistribution: req.PrizeDistribution,
+		Scoring:           req.Scoring,
+		Status:            model.TournamentStatusOpen,
+		CreatedBy:         createdBy,
+		CreatedAt:         now,
+	}
+
+	err := uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.TournamentRepo.CreateTournament(ctx, tournament); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionTournamentCreate, "tournament", tournament.ID, nil, tournament, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+// validPrizeDistribution exige ao menos uma colocação premiada, percentuais
+// positivos e soma de no máximo 100.
+func validPrizeDistribution(distribution []int) bool {
+	if len(distribution) == 0 {
+		return false
+	}
+	total := 0
+	for _, percent := range distribution {
+		if percent <= 0 {
+			return false
+		}
+		total += percent
+	}
+	return total <= 100
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const minWebhookSecretLength = 16
+
+type CreateWebhookUseCase struct {
+	WebhookRepo repository.WebhookRepository
+	AuditRepo   repository.AuditRepository
+	Transactor  ports.Transactor
+	now         func() time.Time
+}
+
+// CreateWebhookRequest aceita um segredo próprio do parceiro;
+// se omitido, um segredo aleatório é gerado.
+type CreateWebhookRequest struct {
+	URL        string                  `json:"url"`
+	EventTypes []model.DomainEventType `json:"event_types"`
+	Secret     string                  `json:"secret,omitempty"`
+}
+
+// CreateWebhookResponse devolve o segredo de assinatura. Ele não
+// pode ser recuperado depois.
+type CreateWebhookResponse struct {
+	Secret       string                    `json:"secret"`
+	Subscription model.WebhookSubscription `json:"subscription"`
+}
+
+func NewCreateWebhookUseCase(webhookRepo repository.WebhookRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *CreateWebhookUseCase {
+	return &CreateWebhookUseCase{
+		WebhookRepo: webhookRepo,
+		AuditRepo:   auditRepo,
+		Transactor:  transactor,
+		now:         time.Now,
+	}
+}
+
+func (uc *CreateWebhookUseCase) Execute(ctx context.Context, req *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	if err := authorize(ctx, model.ScopeWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	target := strings.TrimSpace(req.URL)
+	if !validWebhookURL(target) || len(req.EventTypes) == 0 {
+		return nil, ErrValidate
+	}
+	for _, eventType := range req.EventTypes {
+		if !model.IsValidDomainEventType(eventType) {
+			return nil, ErrValidate
+		}
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := generateSecureToken(32)
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	} else if len(secret) < minWebhookSecretLength {
+		return nil, ErrValidate
+	}
+
+	createdBy, _ := ctx.Value(contextkeys.ContextKeyUserID).(string)
+	now := uc.now()
+	subscription := &model.WebhookSubscription{
+		ID:         uuid.New().String(),
+		URL:        target,
+		EventTypes: req.EventTypes,
+		Secret:     secret,
+		CreatedBy:  createdBy,
+		CreatedAt:  now,
+	}
+
+	err := uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.WebhookRepo.CreateSubscription(ctx, subscription); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionWebhookCreate, "webhook", subscription.ID, nil, subscription, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateWebhookResponse{
+		Secret:       secret,
+		Subscription: *subscription,
+	}, nil
+}
+
+// validWebhookURL exige uma URL absoluta http ou https.
+func validWebhookURL(value string) bool {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "https" || parsed.Scheme == "http") && parsed.Host != ""
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type DeleteWebhookUseCase struct {
+	WebhookRepo repository.WebhookRepository
+	AuditRepo   repository.AuditRepository
+	Transactor  ports.Transactor
+	now         func() time.Time
+}
+
+type DeleteWebhookRequest struct {
+	ID string `json:"-"`
+}
+
+func NewDeleteWebhookUseCase(webhookRepo repository.WebhookRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{
+		WebhookRepo: webhookRepo,
+		AuditRepo:   auditRepo,
+		Transactor:  transactor,
+		now:         time.Now,
+	}
+}
+
+// Execute remove a assinatura. As entregas pendentes dela vão para a lista
+// de mortas na próxima rodada de entregas; o log é mantido.
+func (uc *DeleteWebhookUseCase) Execute(ctx context.Context, req *DeleteWebhookRequest) error {
+	if err := authorize(ctx, model.ScopeWebhooksManage); err != nil {
+		return err
+	}
+
+	before, err := uc.WebhookRepo.GetSubscription(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	return uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.WebhookRepo.DeleteSubscription(ctx, req.ID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionWebhookDelete, "webhook", req.ID, before, nil, uc.now())
+	})
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const (
+	defaultWebhookBatchSize   = 100
+	defaultWebhookMaxAttempts = 8
+	defaultWebhookBaseBackoff = 30 * time.Second
+	defaultWebhookMaxBackoff  = time.Hour
+)
+
+// DeliverWebhooksUseCase envia as entregas pendentes cuja tentativa já
+// venceu. Uma falha agenda a próxima tentativa com backoff exponencial;
+// após MaxAttempts a entrega vai para a lista de mortas. Roda agendado no
+// servidor, sem contexto administrativo.
+type DeliverWebhooksUseCase struct {
+	WebhookRepo repository.WebhookRepository
+	Sender      ports.WebhookSender
+	BatchSize   int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	now         func() time.Time
+}
+
+type DeliverWebhooksResponse struct {
+	Delivered    int `json:"delivered"`
+	Retrying     int `json:"retrying"`
+	DeadLettered int `json:"dead_lettered"`
+}
+
+func NewDeliverWebhooksUseCase(webhookRepo repository.WebhookRepository, sender ports.WebhookSender) *DeliverWebhooksUseCase {
+	return &DeliverWebhooksUseCase{
+		WebhookRepo: webhookRepo,
+		Sender:      sender,
+		BatchSize:   defaultWebhookBatchSize,
+		MaxAttempts: defaultWebhookMaxAttempts,
+		BaseBackoff: defaultWebhookBaseBackoff,
+		MaxBackoff:  defaultWebhookMaxBackoff,
+		now:         time.Now,
+	}
+}
+
+func (uc *DeliverWebhooksUseCase) Execute(ctx context.Context) (*DeliverWebhooksResponse, error) {
+	deliveries, err := uc.WebhookRepo.ListDueDeliveries(ctx, uc.now(), uc.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DeliverWebhooksResponse{}
+	for _, delivery := range deliveries {
+		if err := uc.attempt(ctx, delivery); err != nil {
+			return resp, err
+		}
+		switch delivery.Status {
+		case model.WebhookDeliveryDelivered:
+			resp.Delivered++
+		case model.WebhookDeliveryDead:
+			resp.DeadLettered++
+		default:
+			resp.Retrying++
+		}
+	}
+	return resp, nil
+}
+
+// attempt envia a entrega e grava o resultado. Só erros do repositório são
+// devolvidos.
+func (uc *DeliverWebhooksUseCase) attempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	subscription, err := uc.WebhookRepo.GetSubscription(ctx, delivery.SubscriptionID)
+	if err == repository.ErrWebhookSubscriptionNotFound {
+		delivery.Status = model.WebhookDeliveryDead
+		delivery.LastError = "subscription deleted"
+		return uc.WebhookRepo.UpdateDelivery(ctx, delivery)
+	}
+	if err != nil {
+		return err
+	}
+
+	now := uc.now()
+	status, sendErr := uc.Sender.Send(ctx, ports.WebhookRequest{
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		DeliveryID: delivery.ID,
+		EventID:    delivery.EventID,
+		EventType:  string(delivery.EventType),
+		Timestamp:  now,
+		Body:       delivery.Payload,
+	})
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.LastStatusCode = status
+	switch {
+	case sendErr != nil:
+		delivery.LastError = sendErr.Error()
+	case status < 200 || status > 299:
+		delivery.LastError = fmt.Sprintf("unexpected status %d", status)
+	default:
+		delivery.Status = model.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+		return uc.WebhookRepo.UpdateDelivery(ctx, delivery)
+	}
+
+	if delivery.Attempts >= uc.MaxAttempts {
+		delivery.Status = model.WebhookDeliveryDead
+	} else {
+		delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts, uc.BaseBackoff, uc.MaxBackoff))
+	}
+	return uc.WebhookRepo.UpdateDelivery(ctx, delivery)
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/publisher"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// webhookReceiver simula o destino de um parceiro: confere a assinatura e
+// responde com o status configurado.
+type webhookReceiver struct {
+	secret string
+	status int
+	mu     sync.Mutex
+	events []model.DomainEvent
+}
+
+func (rcv *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	timestamp := r.Header.Get(publisher.HeaderWebhookTimestamp)
+	signature := r.Header.Get(publisher.HeaderWebhookSignature)
+	if !publisher.VerifyWebhookSignature(rcv.secret, timestamp, signature, body, time.Now(), 5*time.Minute) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var event model.DomainEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if rcv.status == http.StatusOK {
+		rcv.events = append(rcv.events, event)
+	}
+	w.WriteHeader(rcv.status)
+}
+
+func TestDeliverWebhooksUseCase(t *testing.T) {
+	ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "admin1")
+	ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+
+	webhookRepo := repository_in_memory.NewInMemoryWebhookRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	createUC := NewCreateWebhookUseCase(webhookRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	listDeliveriesUC := NewListWebhookDeliveriesUseCase(webhookRepo, auditRepo)
+	retryUC := NewRetryWebhookDeliveryUseCase(webhookRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+
+	receiver := &webhookReceiver{secret: "partner-secret-0001", status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	clock := time.Now()
+	deliverUC := NewDeliverWebhooksUseCase(webhookRepo, publisher.NewHTTPWebhookSender(server.Client()))
+	deliverUC.MaxAttempts = 3
+	deliverUC.BaseBackoff = time.Minute
+	deliverUC.now = func() time.Time { return clock }
+
+	fanout := NewWebhookFanout(webhookRepo).(*WebhookFanout)
+	fanout.now = func() time.Time { return clock }
+
+	t.Run("Execute_InvalidSubscription", func(t *testing.T) {
+		for _, req := range []*CreateWebhookRequest{
+			{URL: "ftp://partner.example", EventTypes: []model.DomainEventType{model.DomainEventSpinSettled}},
+			{URL: server.URL, EventTypes: []model.DomainEventType{"spin.unknown"}},
+			{URL: server.URL, EventTypes: []model.DomainEventType{model.DomainEventSpinSettled}, Secret: "short"},
+		} {
+			_, err := createUC.Execute(ctx, req)
+			assert.Equal(t, ErrValidate, err)
+		}
+	})
+
+	resp, err := createUC.Execute(ctx, &CreateWebhookRequest{
+		URL:        server.URL,
+		EventTypes: []model.DomainEventType{model.DomainEventSpinSettled, model.DomainEventDepositCompleted},
+		Secret:     receiver.secret,
+	})
+	assert.NoError(t, err, "Esperava-se nenhum erro ao criar a assinatura")
+	assert.Equal(t, receiver.secret, resp.Secret)
+
+	event := &model.DomainEvent{
+		ID:          "event1",
+		Type:        model.DomainEventSpinSettled,
+		AggregateID: "spin1",
+		Payload:     json.RawMessage(`{"spin_id":"spin1"}`),
+		OccurredAt:  clock,
+	}
+	assert.NoError(t, fanout.Publish(ctx, event))
+	assert.NoError(t, fanout.Publish(ctx, event), "O relay pode repetir eventos")
+	assert.NoError(t, fanout.Publish(ctx, &model.DomainEvent{ID: "event2", Type: model.DomainEventPlayerBlocked}))
+
+	t.Run("Execute_BackoffUntilDeadLetter", func(t *testing.T) {
+		for attempt, wait := range []time.Duration{time.Minute, 2 * time.Minute} {
+			result, err := deliverUC.Execute(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, &DeliverWebhooksResponse{Retrying: 1}, result, "Apenas uma entrega por evento e assinatura")
+
+			deliveries, err := listDeliveriesUC.Execute(ctx, &ListWebhookDeliveriesRequest{})
+			assert.NoError(t, err)
+			if assert.Len(t, deliveries.Deliveries, 1) {
+				delivery := deliveries.Deliveries[0]
+				assert.Equal(t, attempt+1, delivery.Attempts)
+				assert.Equal(t, http.StatusServiceUnavailable, delivery.LastStatusCode)
+				assert.Equal(t, clock.Add(wait), delivery.NextAttemptAt, "A espera deve dobrar a cada falha")
+			}
+
+			result, err = deliverUC.Execute(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, &DeliverWebhooksResponse{}, result, "Nada deve ser enviado antes da próxima tentativa")
+			clock = clock.Add(wait)
+		}
+
+		result, err := deliverUC.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &DeliverWebhooksResponse{DeadLettered: 1}, result)
+
+		dead, err := listDeliveriesUC.Execute(ctx, &ListWebhookDeliveriesRequest{Status: model.WebhookDeliveryDead})
+		assert.NoError(t, err)
+		assert.Len(t, dead.Deliveries, 1, "A entrega deve ir para a lista de mortas")
+	})
+
+	t.Run("Execute_RetryDeadLetter", func(t *testing.T) {
+		dead, err := listDeliveriesUC.Execute(ctx, &ListWebhookDeliveriesRequest{Status: model.WebhookDeliveryDead})
+		assert.NoError(t, err)
+		id := dead.Deliveries[0].ID
+
+		receiver.status = http.StatusOK
+		retryUC.now = func() time.Time { return clock }
+		delivery, err := retryUC.Execute(ctx, &RetryWebhookDeliveryRequest{ID: id})
+		assert.NoError(t, err)
+		assert.Equal(t, model.WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, 0, delivery.Attempts)
+
+		result, err := deliverUC.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &DeliverWebhooksResponse{Delivered: 1}, result)
+		if assert.Len(t, receiver.events, 1, "O destino deve aceitar a assinatura") {
+			assert.Equal(t, "event1", receiver.events[0].ID)
+			assert.JSONEq(t, `{"spin_id":"spin1"}`, string(receiver.events[0].Payload))
+		}
+
+		_, err = retryUC.Execute(ctx, &RetryWebhookDeliveryRequest{ID: id})
+		assert.Equal(t, ErrWebhookDeliveryNotDead, err)
+	})
+
+	t.Run("Execute_WrongSecretIsRejected", func(t *testing.T) {
+		other, err := createUC.Execute(ctx, &CreateWebhookRequest{
+			URL:        server.URL,
+			EventTypes: []model.DomainEventType{model.DomainEventDepositCompleted},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, receiver.secret, other.Secret, "Um segredo deve ser gerado quando omitido")
+
+		assert.NoError(t, fanout.Publish(ctx, &model.DomainEvent{ID: "event3", Type: model.DomainEventDepositCompleted}))
+		_, err = deliverUC.Execute(ctx)
+		assert.NoError(t, err)
+
+		deliveries, err := listDeliveriesUC.Execute(ctx, &ListWebhookDeliveriesRequest{SubscriptionID: other.Subscription.ID})
+		assert.NoError(t, err)
+		if assert.Len(t, deliveries.Deliveries, 1) {
+			assert.Equal(t, http.StatusUnauthorized, deliveries.Deliveries[0].LastStatusCode)
+			assert.Equal(t, model.WebhookDeliveryPending, deliveries.Deliveries[0].Status)
+		}
+	})
+
+	t.Run("Execute_DeletedSubscription", func(t *testing.T) {
+		assert.NoError(t, fanout.Publish(ctx, &model.DomainEvent{ID: "event4", Type: model.DomainEventSpinSettled}))
+		err := NewDeleteWebhookUseCase(webhookRepo, auditRepo, repository_in_memory.NewInMemoryTransactor()).Execute(ctx, &DeleteWebhookRequest{ID: resp.Subscription.ID})
+		assert.NoError(t, err)
+
+		result, err := deliverUC.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.DeadLettered, "A entrega pendente da assinatura removida deve ir para a lista de mortas")
+
+		assert.NoError(t, fanout.Publish(ctx, &model.DomainEvent{ID: "event5", Type: model.DomainEventSpinSettled}))
+		deliveries, err := listDeliveriesUC.Execute(ctx, &ListWebhookDeliveriesRequest{SubscriptionID: resp.Subscription.ID})
+		assert.NoError(t, err)
+		if assert.Len(t, deliveries.Deliveries, 3, "Eventos novos não devem gerar entregas para a assinatura removida") {
+			assert.Equal(t, "event4", deliveries.Deliveries[0].EventID)
+			assert.Equal(t, "subscription deleted", deliveries.Deliveries[0].LastError)
+		}
+	})
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	base, max := 30*time.Second, 10*time.Minute
+	var delays []time.Duration
+	for attempts := 1; attempts <= 7; attempts++ {
+		delays = append(delays, webhookBackoff(attempts, base, max))
+	}
+	assert.Equal(t, []time.Duration{
+		30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, max, max,
+	}, delays)
+}
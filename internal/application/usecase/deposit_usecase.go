@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type DepositUseCase struct {
+	PlayerRepo        repository.PlayerRepository
+	TransactionRepo   repository.TransactionRepository
+	GamblingLimitRepo repository.GamblingLimitRepository
+	SelfExclusionRepo repository.SelfExclusionRepository
+	OutboxRepo        repository.OutboxRepository
+	Transactor        ports.Transactor
+	// Events recebe o novo saldo do jogador.
+	Events ports.EventPublisher
+	now    func() time.Time
+}
+
+type DepositRequest struct {
+	PlayerID string `json:"-"`
+	Amount   int    `json:"amount"`
+}
+
+type DepositResponse struct {
+	Balance int `json:"balance"`
+}
+
+func NewDepositUseCase(playerRepo repository.PlayerRepository, txRepo repository.TransactionRepository, limitRepo repository.GamblingLimitRepository, exclusionRepo repository.SelfExclusionRepository, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *DepositUseCase {
+	return &DepositUseCase{
+		PlayerRepo:        playerRepo,
+		TransactionRepo:   txRepo,
+		GamblingLimitRepo: limitRepo,
+		SelfExclusionRepo: exclusionRepo,
+		OutboxRepo:        outboxRepo,
+		Transactor:        transactor,
+		now:               time.Now,
+	}
+}
+
+func (uc *DepositUseCase) Execute(ctx context.Context, req *DepositRequest) (*DepositResponse, error) {
+	if req.Amount <= 0 {
+		return nil, ErrValidate
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !player.EmailVerified {
+		return nil, ErrEmailNotVerified
+	}
+
+	now := uc.now()
+	if err := guardSelfExclusion(ctx, uc.SelfExclusionRepo, player.ID, now); err != nil {
+		return nil, err
+	}
+
+	// Os limites são somados com o jogador bloqueado, para que depósitos
+	// simultâneos não passem juntos por um limite que só cabe um deles.
+	var balance int
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.PlayerRepo.LockPlayer(ctx, player.ID); err != nil {
+			return err
+		}
+		limits, err := loadGamblingLimits(ctx, uc.GamblingLimitRepo, player.ID, now)
+		if err != nil {
+			return err
+		}
+		if err := checkDepositLimits(ctx, uc.TransactionRepo, limits, player.ID, req.Amount, now); err != nil {
+			return err
+		}
+
+		balance, err = uc.PlayerRepo.AdjustBalance(ctx, player.ID, req.Amount)
+		if err != nil {
+			return err
+		}
+		if err := recordTransaction(ctx, uc.TransactionRepo, player.ID, model.TransactionDeposit, req.Amount, "", now); err != nil {
+			return err
+		}
+		return recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventDepositCompleted, "player", player.ID, model.DepositCompletedPayload{
+			PlayerID: player.ID,
+			Amount:   req.Amount,
+			Balance:  balance,
+		}, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	publishEvent(uc.Events, model.EventBalanceUpdated, player.ID, model.BalanceUpdate{
+		Balance: balance,
+		Delta:   req.Amount,
+		Reason:  model.BalanceReasonDeposit,
+	}, now)
+
+	return &DepositResponse{
+		Balance: balance,
+	}, nil
+}
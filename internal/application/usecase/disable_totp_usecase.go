@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+	"time"
+)
+
+type DisableTOTPUseCase struct {
+	PlayerRepo       repository.PlayerRepository
+	RecoveryCodeRepo repository.RecoveryCodeRepository
+	Hasher           security.PasswordHasher
+	TOTP             security.TOTPProvider
+	now              func() time.Time
+}
+
+type DisableTOTPRequest struct {
+	PlayerID string `json:"-"`
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+func NewDisableTOTPUseCase(playerRepo repository.PlayerRepository, recoveryRepo repository.RecoveryCodeRepository, hasher security.PasswordHasher, totp security.TOTPProvider) *DisableTOTPUseCase {
+	return &DisableTOTPUseCase{
+		PlayerRepo:       playerRepo,
+		RecoveryCodeRepo: recoveryRepo,
+		Hasher:           hasher,
+		TOTP:             totp,
+		now:              time.Now,
+	}
+}
+
+func (uc *DisableTOTPUseCase) Execute(ctx context.Context, req *DisableTOTPRequest) error {
+	if req.Password == "" || req.Code == "" {
+		return ErrValidate
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	if player.Role == model.AdminRole {
+		return ErrTOTPRequiredForAdmins
+	}
+	if !player.TOTPEnabled {
+		return ErrTOTPNotEnabled
+	}
+
+	if err := uc.Hasher.CompareHashAndPassword(player.Password, req.Password); err != nil {
+		return ErrInvalidCurrentPassword
+	}
+	valid, err := verifyTOTPCode(ctx, uc.PlayerRepo, uc.TOTP, player, req.Code, uc.now())
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrInvalidTOTPCode
+	}
+
+	if err := uc.PlayerRepo.SetTOTPSecret(ctx, player.ID, ""); err != nil {
+		return err
+	}
+
+	return uc.RecoveryCodeRepo.DeleteRecoveryCodes(ctx, player.ID)
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+)
+
+type EnrollTOTPUseCase struct {
+	PlayerRepo repository.PlayerRepository
+	JWTManager ports.JWTManager
+	TOTP       security.TOTPProvider
+}
+
+type EnrollTOTPRequest struct {
+	PlayerID string `json:"-"`
+	MFAToken string `json:"mfa_token,omitempty"`
+}
+
+type EnrollTOTPResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+func NewEnrollTOTPUseCase(playerRepo repository.PlayerRepository, jwtManager ports.JWTManager, totp security.TOTPProvider) *EnrollTOTPUseCase {
+	return &EnrollTOTPUseCase{
+		PlayerRepo: playerRepo,
+		JWTManager: jwtManager,
+		TOTP:       totp,
+	}
+}
+
+// Execute gera um novo segredo pendente. O 2FA só passa a valer após a
+// confirmação com um código gerado a partir dele.
+func (uc *EnrollTOTPUseCase) Execute(ctx context.Context, req *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	playerID, _, err := resolveTwoFactorPlayer(uc.JWTManager, req.PlayerID, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if player.TOTPEnabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := uc.TOTP.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.PlayerRepo.SetTOTPSecret(ctx, player.ID, secret); err != nil {
+		return nil, err
+	}
+
+	return &EnrollTOTPResponse{
+		Secret:          secret,
+		ProvisioningURI: uc.TOTP.ProvisioningURI(secret, player.Email),
+	}, nil
+}
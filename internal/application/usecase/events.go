@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"time"
+)
+
+// defaultBigWinMultiple é quantas vezes a aposta total um prêmio deve pagar
+// para ser anunciado como vitória grande.
+const defaultBigWinMultiple = 20
+
+func newEvent(eventType model.EventType, playerID string, data any, now time.Time) model.Event {
+	return model.Event{
+		Type:      eventType,
+		PlayerID:  playerID,
+		Data:      data,
+		CreatedAt: now,
+	}
+}
+
+// publishEvent ignora a publicação quando o caso de uso não tem um
+// barramento de eventos configurado.
+func publishEvent(publisher ports.EventPublisher, eventType model.EventType, playerID string, data any, now time.Time) {
+	publishEvents(publisher, []model.Event{newEvent(eventType, playerID, data, now)})
+}
+
+func publishEvents(publisher ports.EventPublisher, events []model.Event) {
+	if publisher == nil {
+		return
+	}
+	for _, event := range events {
+		publisher.Publish(event)
+	}
+}
+
+func jackpotUpdate(pool *model.JackpotPool, jackpot *model.JackpotWin) model.JackpotUpdate {
+	update := model.JackpotUpdate{
+		PoolID: pool.ID,
+		Name:   pool.Name,
+		Amount: pool.Amount,
+	}
+	if jackpot != nil {
+		update.WonAmount = jackpot.Amount
+	}
+	return update
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDepositLimitExceeded = errors.New("deposit limit exceeded")
+	ErrLossLimitExceeded    = errors.New("loss limit exceeded")
+	ErrWagerLimitExceeded   = errors.New("wager limit exceeded")
+	ErrSessionLimitReached  = errors.New("session time limit reached")
+)
+
+const (
+	// defaultLimitIncreaseCoolOff é o tempo até um aumento (ou remoção) de
+	// limite passar a valer. Reduções valem imediatamente.
+	defaultLimitIncreaseCoolOff = 24 * time.Hour
+	// defaultSessionIdleTimeout é a pausa que encerra uma sessão de jogo.
+	defaultSessionIdleTimeout = 30 * time.Minute
+)
+
+// loadGamblingLimits devolve os limites ativos do jogador, efetivando os
+// aumentos cujo período de espera já terminou.
+func loadGamblingLimits(ctx context.Context, repo repository.GamblingLimitRepository, playerID string, now time.Time) ([]*model.GamblingLimit, error) {
+	limits, err := repo.ListGamblingLimits(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]*model.GamblingLimit, 0, len(limits))
+	for _, limit := range limits {
+		if limit.ApplyPending(now) {
+			if err := repo.SaveGamblingLimit(ctx, limit); err != nil {
+				return nil, err
+			}
+		}
+		if limit.IsActive() {
+			active = append(active, limit)
+		}
+	}
+	return active, nil
+}
+
+// checkDepositLimits verifica se o depósito cabe em todos os limites de depósito.
+func checkDepositLimits(ctx context.Context, txRepo repository.TransactionRepository, limits []*model.GamblingLimit, playerID string, amount int, now time.Time) error {
+	for _, limit := range limits {
+		if limit.Type != model.LimitDeposit {
+			continue
+		}
+		deposited, err := txRepo.SumTransactions(ctx, playerID, model.TransactionDeposit, now.Add(-limit.Period.Duration()))
+		if err != nil {
+			return err
+		}
+		if deposited+amount > limit.Amount {
+			return ErrDepositLimitExceeded
+		}
+	}
+	return nil
+}
+
+// checkBetLimits verifica os limites de aposta e de perda considerando que a
+// jogada pode perder o valor inteiro apostado. Inscrições em torneios contam
+// como apostas e os prêmios de torneio como ganhos.
+func checkBetLimits(ctx context.Context, txRepo repository.TransactionRepository, limits []*model.GamblingLimit, playerID string, bet int, now time.Time) error {
+	for _, limit := range limits {
+		if limit.Type != model.LimitWager && limit.Type != model.LimitLoss {
+			continue
+		}
+
+		since := now.Add(-limit.Period.Duration())
+		wagered, err := sumTransactions(ctx, txRepo, playerID, since, model.TransactionBet, model.TransactionTournamentEntry)
+		if err != nil {
+			return err
+		}
+
+		if limit.Type == model.LimitWager {
+			if wagered+bet > limit.Amount {
+				return ErrWagerLimitExceeded
+			}
+			continue
+		}
+
+		won, err := sumTransactions(ctx, txRepo, playerID, since, model.TransactionWin, model.TransactionTournamentPrize)
+		if err != nil {
+			return err
+		}
+		if wagered-won+bet > limit.Amount {
+			return ErrLossLimitExceeded
+		}
+	}
+	return nil
+}
+
+// sumTransactions soma os movimentos de todos os tipos informados a partir de
+// since.
+func sumTransactions(ctx context.Context, txRepo repository.TransactionRepository, playerID string, since time.Time, txTypes ...model.TransactionType) (int, error) {
+	total := 0
+	for _, txType := range txTypes {
+		sum, err := txRepo.SumTransactions(ctx, playerID, txType, since)
+		if err != nil {
+			return 0, err
+		}
+		total += sum
+	}
+	return total, nil
+}
+
+// trackPlaySession devolve a sessão em andamento, iniciando uma nova após uma
+// pausa maior que idleTimeout, e aplica o limite de tempo de sessão. Um aviso
+// de sessão ainda não confirmado passa para a nova sessão: a pausa não
+// dispensa o jogador de confirmá-lo.
+func trackPlaySession(ctx context.Context, repo repository.PlaySessionRepository, limits []*model.GamblingLimit, playerID string, idleTimeout time.Duration, now time.Time) (*model.PlaySession, error) {
+	session, err := repo.GetLatestPlaySession(ctx, playerID)
+	if err != nil && err != repository.ErrPlaySessionNotFound {
+		return nil, err
+	}
+
+	if session == nil || now.Sub(session.LastActivityAt) > idleTimeout {
+		pending := session != nil && session.RealityCheckPending
+		session = &model.PlaySession{
+			ID:                  uuid.New().String(),
+			PlayerID:            playerID,
+			StartedAt:           now,
+			RealityCheckPending: pending,
+		}
+	}
+
+	for _, limit := range limits {
+		if limit.Type == model.LimitSession && now.Sub(session.StartedAt) >= time.Duration(limit.Amount)*time.Minute {
+			return nil, ErrSessionLimitReached
+		}
+	}
+
+	session.LastActivityAt = now
+	return session, nil
+}
+
+func recordTransaction(ctx context.Context, repo repository.TransactionRepository, playerID string, txType model.TransactionType, amount int, machineID string, now time.Time) error {
+	return repo.RecordTransaction(ctx, &model.Transaction{
+		ID:        uuid.New().String(),
+		PlayerID:  playerID,
+		Type:      txType,
+		Amount:    amount,
+		MachineID: machineID,
+		CreatedAt: now,
+	})
+}
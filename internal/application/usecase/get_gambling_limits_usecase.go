@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type GetGamblingLimitsUseCase struct {
+	GamblingLimitRepo repository.GamblingLimitRepository
+	now               func() time.Time
+}
+
+type GetGamblingLimitsRequest struct {
+	PlayerID string `json:"-"`
+}
+
+type GetGamblingLimitsResponse struct {
+	Limits []*model.GamblingLimit `json:"limits"`
+}
+
+func NewGetGamblingLimitsUseCase(limitRepo repository.GamblingLimitRepository) *GetGamblingLimitsUseCase {
+	return &GetGamblingLimitsUseCase{
+		GamblingLimitRepo: limitRepo,
+		now:               time.Now,
+	}
+}
+
+func (uc *GetGamblingLimitsUseCase) Execute(ctx context.Context, req *GetGamblingLimitsRequest) (*GetGamblingLimitsResponse, error) {
+	limits, err := uc.GamblingLimitRepo.ListGamblingLimits(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inclui limites removidos com remoção ainda pendente, para que o
+	// jogador veja quando a alteração passa a valer.
+	now := uc.now()
+	result := make([]*model.GamblingLimit, 0, len(limits))
+	for _, limit := range limits {
+		if limit.ApplyPending(now) {
+			if err := uc.GamblingLimitRepo.SaveGamblingLimit(ctx, limit); err != nil {
+				return nil, err
+			}
+		}
+		if limit.IsActive() || limit.PendingAmount != nil {
+			result = append(result, limit)
+		}
+	}
+
+	return &GetGamblingLimitsResponse{
+		Limits: result,
+	}, nil
+}
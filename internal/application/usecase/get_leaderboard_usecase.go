@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+)
+
+const (
+	defaultLeaderboardLimit = 50
+	maxLeaderboardLimit     = 500
+)
+
+type GetLeaderboardUseCase struct {
+	TournamentRepo repository.TournamentRepository
+}
+
+// GetLeaderboardRequest devolve as Limit primeiras colocações e a
+// do jogador PlayerID, mesmo que fique fora delas.
+type GetLeaderboardRequest struct {
+	TournamentID string
+	PlayerID     string
+	Limit        int
+}
+
+// GetLeaderboardResponse traz em Prize o que cada colocação
+// recebeu ou, com o torneio aberto, o que receberia se ele terminasse agora.
+type GetLeaderboardResponse struct {
+	Tournament  *model.Tournament        `json:"tournament"`
+	PrizePool   int                      `json:"prize_pool"`
+	Leaderboard []*model.TournamentEntry `json:"leaderboard"`
+	PlayerEntry *model.TournamentEntry   `json:"player_entry,omitempty"`
+}
+
+func NewGetLeaderboardUseCase(tournamentRepo repository.TournamentRepository) *GetLeaderboardUseCase {
+	return &GetLeaderboardUseCase{
+		TournamentRepo: tournamentRepo,
+	}
+}
+
+func (uc *GetLeaderboardUseCase) Execute(ctx context.Context, req *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit < 0 || limit > maxLeaderboardLimit {
+		return nil, ErrValidate
+	}
+
+	tournament, err := uc.TournamentRepo.GetTournament(ctx, req.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := uc.TournamentRepo.ListEntries(ctx, tournament.ID)
+	if err != nil {
+		return nil, err
+	}
+	tournament.Rank(entries)
+
+	resp := &GetLeaderboardResponse{
+		Tournament:  tournament,
+		PrizePool:   tournament.PrizePool(),
+		Leaderboard: []*model.TournamentEntry{},
+	}
+	for _, entry := range entries {
+		if entry.PlayerID == req.PlayerID {
+			resp.PlayerEntry = entry
+		}
+		if len(resp.Leaderboard) < limit {
+			resp.Leaderboard = append(resp.Leaderboard, entry)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const recentActivityLimit = 20
+
+type GetPlayerDetailsUseCase struct {
+	PlayerRepo      repository.PlayerRepository
+	TransactionRepo repository.TransactionRepository
+	PlaySessionRepo repository.PlaySessionRepository
+	AuditRepo       repository.AuditRepository
+	now             func() time.Time
+}
+
+type GetPlayerDetailsRequest struct {
+	PlayerID string `json:"-"`
+}
+
+// PlayerWallet resume o saldo e os totais movimentados pelo jogador.
+type PlayerWallet struct {
+	Balance        int `json:"balance"`
+	TotalDeposited int `json:"total_deposited"`
+	TotalWagered   int `json:"total_wagered"`
+	TotalWon       int `json:"total_won"`
+}
+
+type GetPlayerDetailsResponse struct {
+	Player             *model.Player        `json:"player"`
+	Wallet             PlayerWallet         `json:"wallet"`
+	LastSession        *model.PlaySession   `json:"last_session,omitempty"`
+	RecentTransactions []*model.Transaction `json:"recent_transactions"`
+}
+
+func NewGetPlayerDetailsUseCase(playerRepo repository.PlayerRepository, txRepo repository.TransactionRepository, sessionRepo repository.PlaySessionRepository, auditRepo repository.AuditRepository) *GetPlayerDetailsUseCase {
+	return &GetPlayerDetailsUseCase{
+		PlayerRepo:      playerRepo,
+		TransactionRepo: txRepo,
+		PlaySessionRepo: sessionRepo,
+		AuditRepo:       auditRepo,
+		now:             time.Now,
+	}
+}
+
+func (uc *GetPlayerDetailsUseCase) Execute(ctx context.Context, req *GetPlayerDetailsRequest) (*GetPlayerDetailsResponse, error) {
+	if err := authorize(ctx, model.ScopePlayersRead); err != nil {
+		return nil, err
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet := PlayerWallet{Balance: player.Balance}
+	totals := map[model.TransactionType]*int{
+		model.TransactionDeposit: &wallet.TotalDeposited,
+		model.TransactionBet:     &wallet.TotalWagered,
+		model.TransactionWin:     &wallet.TotalWon,
+	}
+	for txType, total := range totals {
+		if *total, err = uc.TransactionRepo.SumTransactions(ctx, player.ID, txType, time.Time{}); err != nil {
+			return nil, err
+		}
+	}
+
+	transactions, err := uc.TransactionRepo.ListTransactions(ctx, player.ID, recentActivityLimit)
+	if err != nil {
+		return nil, err
+	}
+	if transactions == nil {
+		transactions = []*model.Transaction{}
+	}
+
+	session, err := uc.PlaySessionRepo.GetLatestPlaySession(ctx, player.ID)
+	if err != nil && err != repository.ErrPlaySessionNotFound {
+		return nil, err
+	}
+
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionPlayerGet, "player", player.ID, nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	return &GetPlayerDetailsResponse{
+		Player:             player,
+		Wallet:             wallet,
+		LastSession:        session,
+		RecentTransactions: transactions,
+	}, nil
+}
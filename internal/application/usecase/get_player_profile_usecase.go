@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type GetPlayerProfileUseCase struct {
+	PlayerRepo      repository.PlayerRepository
+	SpinRepo        repository.SpinRepository
+	PlaySessionRepo repository.PlaySessionRepository
+	// SessionIdleTimeout deve ser o mesmo usado pelo PlayUseCase, para que a
+	// sessão atual seja a mesma que ele continuaria.
+	SessionIdleTimeout time.Duration
+	now                func() time.Time
+}
+
+type GetPlayerProfileRequest struct {
+	PlayerID string `json:"-"`
+}
+
+// CurrentSessionSummary resume a sessão de jogo ainda ativa.
+type CurrentSessionSummary struct {
+	StartedAt time.Time `json:"started_at"`
+	Spins     int       `json:"spins"`
+	Wagered   int       `json:"wagered"`
+	Won       int       `json:"won"`
+	NetResult int       `json:"net_result"`
+}
+
+// GetPlayerProfileResponse traz as estatísticas por período em dias UTC
+// completos: Today é o dia atual e Last7Days/Last30Days incluem o dia atual.
+type GetPlayerProfileResponse struct {
+	Player         model.Player           `json:"player"`
+	Lifetime       model.PlayerStats      `json:"lifetime"`
+	Today          model.PlayerStats      `json:"today"`
+	Last7Days      model.PlayerStats      `json:"last_7_days"`
+	Last30Days     model.PlayerStats      `json:"last_30_days"`
+	CurrentSession *CurrentSessionSummary `json:"current_session,omitempty"`
+}
+
+func NewGetPlayerProfileUseCase(playerRepo repository.PlayerRepository, spinRepo repository.SpinRepository, sessionRepo repository.PlaySessionRepository) *GetPlayerProfileUseCase {
+	return &GetPlayerProfileUseCase{
+		PlayerRepo:         playerRepo,
+		SpinRepo:           spinRepo,
+		PlaySessionRepo:    sessionRepo,
+		SessionIdleTimeout: defaultSessionIdleTimeout,
+		now:                time.Now,
+	}
+}
+
+func (uc *GetPlayerProfileUseCase) Execute(ctx context.Context, req *GetPlayerProfileRequest) (*GetPlayerProfileResponse, error) {
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := uc.SpinRepo.ListPlayerSpinStats(ctx, player.ID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	today := model.GranularityDay.Truncate(now)
+	resp := &GetPlayerProfileResponse{
+		Player:     *player,
+		Lifetime:   model.SummarizePlayerSpinStats(rows, time.Time{}),
+		Today:      model.SummarizePlayerSpinStats(rows, today),
+		Last7Days:  model.SummarizePlayerSpinStats(rows, today.AddDate(0, 0, -6)),
+		Last30Days: model.SummarizePlayerSpinStats(rows, today.AddDate(0, 0, -29)),
+	}
+
+	session, err := uc.PlaySessionRepo.GetLatestPlaySession(ctx, player.ID)
+	if err != nil && err != repository.ErrPlaySessionNotFound {
+		return nil, err
+	}
+	if session != nil && now.Sub(session.LastActivityAt) <= uc.SessionIdleTimeout {
+		resp.CurrentSession = &CurrentSessionSummary{
+			StartedAt: session.StartedAt,
+			Spins:     session.Spins,
+			Wagered:   session.Wagered,
+			Won:       session.Won,
+			NetResult: session.Won - session.Wagered,
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"math/rand"
+	"slot-machine/internal/domain/model"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPlayerProfileUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	sessionRepo := repository_in_memory.NewInMemoryPlaySessionRepository()
+	spinRepo := repository_in_memory.NewInMemorySpinRepository()
+
+	now := time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	profileUC := NewGetPlayerProfileUseCase(playerRepo, spinRepo, sessionRepo)
+	profileUC.now = clock
+	playUC := NewPlayUseCase(
+		playerRepo,
+		slotRepo,
+		repository_in_memory.NewInMemoryTransactionRepository(),
+		repository_in_memory.NewInMemoryGamblingLimitRepository(),
+		sessionRepo,
+		repository_in_memory.NewInMemorySelfExclusionRepository(),
+		spinRepo,
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)),
+		repository_in_memory.NewInMemoryFreeSpinRepository(),
+		repository_in_memory.NewInMemoryOutboxRepository(),
+		repository_in_memory.NewInMemoryTransactor(),
+	)
+	playUC.now = clock
+	playUC.rng = rand.New(rand.NewSource(0))
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Balance: 1000, EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	err = slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{
+		ID:           "machine1",
+		MultipleGain: 2,
+		Balance:      5000,
+		Strips:       fixedStrips("A", "B", "C"),
+	})
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	history := []model.Spin{
+		{ID: "s1", PlayerID: "player1", MachineID: "machine2", Bet: 50, Payout: 400, CreatedAt: now.AddDate(0, 0, -40)},
+		{ID: "s2", PlayerID: "player1", MachineID: "machine1", Bet: 20, CreatedAt: now.AddDate(0, 0, -10)},
+		{ID: "s3", PlayerID: "player1", MachineID: "machine1", Bet: 20, Payout: 60, CreatedAt: now.AddDate(0, 0, -3)},
+		{ID: "s4", PlayerID: "player2", MachineID: "machine2", Bet: 500, Payout: 5000, CreatedAt: now.AddDate(0, 0, -1)},
+	}
+	for i := range history {
+		assert.NoError(t, spinRepo.RecordSpin(ctx, &history[i]), "Erro ao registrar jogada para testes")
+	}
+
+	t.Run("Execute_WithoutSession", func(t *testing.T) {
+		resp, err := profileUC.Execute(ctx, &GetPlayerProfileRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error getting the profile")
+		assert.Equal(t, "player1", resp.Player.ID)
+		assert.Nil(t, resp.CurrentSession)
+
+		assert.Equal(t, model.PlayerStats{Spins: 3, TotalWagered: 90, TotalWon: 460, NetResult: 370, BiggestWin: 400, FavouriteMachine: "machine1"}, resp.Lifetime)
+		assert.Equal(t, model.PlayerStats{Spins: 2, TotalWagered: 40, TotalWon: 60, NetResult: 20, BiggestWin: 60, FavouriteMachine: "machine1"}, resp.Last30Days)
+		assert.Equal(t, model.PlayerStats{Spins: 1, TotalWagered: 20, TotalWon: 60, NetResult: 40, BiggestWin: 60, FavouriteMachine: "machine1"}, resp.Last7Days)
+		assert.Equal(t, model.PlayerStats{}, resp.Today)
+	})
+
+	t.Run("Execute_CurrentSession", func(t *testing.T) {
+		_, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected no error playing")
+
+		resp, err := profileUC.Execute(ctx, &GetPlayerProfileRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error getting the profile")
+		assert.Equal(t, 990, resp.Player.Balance)
+		assert.Equal(t, 1, resp.Today.Spins)
+		assert.Equal(t, -10, resp.Today.NetResult)
+		assert.Equal(t, 4, resp.Lifetime.Spins)
+		if assert.NotNil(t, resp.CurrentSession) {
+			assert.Equal(t, 1, resp.CurrentSession.Spins)
+			assert.Equal(t, -10, resp.CurrentSession.NetResult)
+		}
+
+		now = now.Add(time.Hour)
+		resp, err = profileUC.Execute(ctx, &GetPlayerProfileRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error getting the profile")
+		assert.Nil(t, resp.CurrentSession, "Expected the idle session to be over")
+	})
+}
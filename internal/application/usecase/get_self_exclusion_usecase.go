@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type GetSelfExclusionUseCase struct {
+	SelfExclusionRepo repository.SelfExclusionRepository
+	now               func() time.Time
+}
+
+type GetSelfExclusionRequest struct {
+	PlayerID string `json:"-"`
+}
+
+type GetSelfExclusionResponse struct {
+	Exclusion *model.SelfExclusion `json:"exclusion"`
+}
+
+func NewGetSelfExclusionUseCase(exclusionRepo repository.SelfExclusionRepository) *GetSelfExclusionUseCase {
+	return &GetSelfExclusionUseCase{
+		SelfExclusionRepo: exclusionRepo,
+		now:               time.Now,
+	}
+}
+
+func (uc *GetSelfExclusionUseCase) Execute(ctx context.Context, req *GetSelfExclusionRequest) (*GetSelfExclusionResponse, error) {
+	exclusion, err := activeSelfExclusion(ctx, uc.SelfExclusionRepo, req.PlayerID, uc.now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetSelfExclusionResponse{
+		Exclusion: exclusion,
+	}, nil
+}
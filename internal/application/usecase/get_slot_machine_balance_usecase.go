@@ -2,13 +2,15 @@ package usecase
 
 import (
 	"context"
-	"slot-machine/internal/domain/contextkeys"
 	"slot-machine/internal/domain/model"
 	"slot-machine/internal/domain/repository"
+	"time"
 )
 
 type GetSlotMachineBalanceUseCase struct {
 	SlotMachineRepo repository.SlotMachineRepository
+	AuditRepo       repository.AuditRepository
+	now             func() time.Time
 }
 
 type GetSlotMachineBalanceRequest struct {
@@ -19,16 +21,17 @@ type GetSlotMachineBalanceResponse struct {
 	Machine model.SlotMachine `json:"machine"`
 }
 
-func NewGetSlotMachineBalanceUseCase(smr repository.SlotMachineRepository) *GetSlotMachineBalanceUseCase {
+func NewGetSlotMachineBalanceUseCase(smr repository.SlotMachineRepository, auditRepo repository.AuditRepository) *GetSlotMachineBalanceUseCase {
 	return &GetSlotMachineBalanceUseCase{
 		SlotMachineRepo: smr,
+		AuditRepo:       auditRepo,
+		now:             time.Now,
 	}
 }
 
 func (uc *GetSlotMachineBalanceUseCase) Execute(ctx context.Context, req *GetSlotMachineBalanceRequest) (*GetSlotMachineBalanceResponse, error) {
-	isAdmin, ok := ctx.Value(contextkeys.ContextKeyIsAdmin).(bool)
-	if !ok || !isAdmin {
-		return nil, ErrUnauthorized
+	if err := authorize(ctx, model.ScopeMachinesRead); err != nil {
+		return nil, err
 	}
 
 	machine, err := uc.SlotMachineRepo.GetSlotMachine(ctx, req.MachineID)
@@ -36,6 +39,10 @@ func (uc *GetSlotMachineBalanceUseCase) Execute(ctx context.Context, req *GetSlo
 		return nil, err
 	}
 
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionMachineBalanceGet, "machine", machine.ID, nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
 	return &GetSlotMachineBalanceResponse{
 		Machine: *machine,
 	}, nil
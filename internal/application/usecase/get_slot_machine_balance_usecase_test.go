@@ -14,7 +14,7 @@ import (
 func TestGetSlotMachineBalanceUseCase(t *testing.T) {
 	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
 
-	getSlotMachineBalanceUC := NewGetSlotMachineBalanceUseCase(slotRepo)
+	getSlotMachineBalanceUC := NewGetSlotMachineBalanceUseCase(slotRepo, repository_in_memory.NewInMemoryAuditRepository())
 
 	ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "admin")
 	ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
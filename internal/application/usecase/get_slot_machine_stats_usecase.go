@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const (
+	defaultHourlyStatsRange = 24 * time.Hour
+	defaultDailyStatsRange  = 30 * 24 * time.Hour
+	// Limites do período consultado, para manter a série com no máximo
+	// algumas centenas de pontos.
+	maxHourlyStatsRange = 7 * 24 * time.Hour
+	maxDailyStatsRange  = 366 * 24 * time.Hour
+)
+
+type GetSlotMachineStatsUseCase struct {
+	SlotMachineRepo repository.SlotMachineRepository
+	SpinRepo        repository.SpinRepository
+	AuditRepo       repository.AuditRepository
+	now             func() time.Time
+}
+
+// GetSlotMachineStatsRequest consulta o período [From, To). Sem To, usa o
+// momento atual; sem From, as últimas 24 horas (hour) ou 30 dias (day).
+type GetSlotMachineStatsRequest struct {
+	MachineID   string
+	From        time.Time
+	To          time.Time
+	Granularity model.StatsGranularity
+}
+
+type MachineStatsSummary struct {
+	model.SpinAggregate
+	RealisedRTP  float64 `json:"realised_rtp"`
+	HitFrequency float64 `json:"hit_frequency"`
+}
+
+type GetSlotMachineStatsResponse struct {
+	MachineID      string                 `json:"machine_id"`
+	From           time.Time              `json:"from"`
+	To             time.Time              `json:"to"`
+	Granularity    model.StatsGranularity `json:"granularity"`
+	TheoreticalRTP float64                `json:"theoretical_rtp"`
+	Totals         MachineStatsSummary    `json:"totals"`
+	Series         []MachineStatsSummary  `json:"series"`
+}
+
+func NewGetSlotMachineStatsUseCase(slotRepo repository.SlotMachineRepository, spinRepo repository.SpinRepository, auditRepo repository.AuditRepository) *GetSlotMachineStatsUseCase {
+	return &GetSlotMachineStatsUseCase{
+		SlotMachineRepo: slotRepo,
+		SpinRepo:        spinRepo,
+		AuditRepo:       auditRepo,
+		now:             time.Now,
+	}
+}
+
+func (uc *GetSlotMachineStatsUseCase) Execute(ctx context.Context, req *GetSlotMachineStatsRequest) (*GetSlotMachineStatsResponse, error) {
+	if err := authorize(ctx, model.ScopeMachinesRead); err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	granularity := req.Granularity
+	defaultRange, maxRange := defaultDailyStatsRange, maxDailyStatsRange
+	switch granularity {
+	case "":
+		granularity = model.GranularityDay
+	case model.GranularityDay:
+	case model.GranularityHour:
+		defaultRange, maxRange = defaultHourlyStatsRange, maxHourlyStatsRange
+	default:
+		return nil, ErrValidate
+	}
+
+	to := req.To
+	if to.IsZero() {
+		to = now
+	}
+	from := req.From
+	if from.IsZero() {
+		from = to.Add(-defaultRange)
+	}
+	if !from.Before(to) || to.Sub(from) > maxRange {
+		return nil, ErrValidate
+	}
+
+	machine, err := uc.SlotMachineRepo.GetSlotMachine(ctx, req.MachineID)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, buckets, err := uc.SpinRepo.AggregateMachineSpins(ctx, machine.ID, from, to, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make([]MachineStatsSummary, 0, len(buckets))
+	for _, bucket := range buckets {
+		series = append(series, summarizeSpins(bucket))
+	}
+
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionMachineStatsGet, "machine", machine.ID, nil, nil, now); err != nil {
+		return nil, err
+	}
+
+	return &GetSlotMachineStatsResponse{
+		MachineID:      machine.ID,
+		From:           from,
+		To:             to,
+		Granularity:    granularity,
+		TheoreticalRTP: machine.TheoreticalRTP(),
+		Totals:         summarizeSpins(totals),
+		Series:         series,
+	}, nil
+}
+
+func summarizeSpins(aggregate model.SpinAggregate) MachineStatsSummary {
+	return MachineStatsSummary{
+		SpinAggregate: aggregate,
+		RealisedRTP:   aggregate.RTP(),
+		HitFrequency:  aggregate.HitFrequency(),
+	}
+}
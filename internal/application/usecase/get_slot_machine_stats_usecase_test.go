@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSlotMachineStatsUseCase(t *testing.T) {
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	spinRepo := repository_in_memory.NewInMemorySpinRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+
+	now := time.Date(2025, 2, 27, 12, 30, 0, 0, time.UTC)
+	uc := NewGetSlotMachineStatsUseCase(slotRepo, spinRepo, auditRepo)
+	uc.now = func() time.Time { return now }
+
+	ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "admin1")
+	ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+
+	// Com 27 combinações independentes e peso 9 de parada alinhada, um terço
+	// das jogadas traz três símbolos iguais.
+	strip := model.NewReelStrip([]string{"cherry", "lemon", "plum"}, nil)
+	err := slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{
+		ID:            "machine1",
+		MultipleGain:  2,
+		Strips:        []model.ReelStrip{strip, strip, strip},
+		AlignedWeight: 9,
+	})
+	assert.NoError(t, err, "Erro ao criar máquina para testes")
+
+	spins := []model.Spin{
+		{ID: "s1", PlayerID: "player1", MachineID: "machine1", Bet: 10, Payout: 30, CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: "s2", PlayerID: "player1", MachineID: "machine1", Bet: 10, CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: "s3", PlayerID: "player2", MachineID: "machine1", Bet: 20, CreatedAt: now.Add(-10 * time.Minute)},
+		{ID: "s4", PlayerID: "player2", MachineID: "machine2", Bet: 50, Payout: 150, CreatedAt: now.Add(-10 * time.Minute)},
+	}
+	for i := range spins {
+		assert.NoError(t, spinRepo.RecordSpin(ctx, &spins[i]), "Erro ao registrar jogada para testes")
+	}
+
+	t.Run("Execute_RequiresAdmin", func(t *testing.T) {
+		playerCtx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "player1")
+		_, err := uc.Execute(playerCtx, &GetSlotMachineStatsRequest{MachineID: "machine1"})
+		assert.Equal(t, ErrUnauthorized, err, "Expected ErrUnauthorized for a non-admin caller")
+	})
+
+	t.Run("Execute_InvalidRange", func(t *testing.T) {
+		_, err := uc.Execute(ctx, &GetSlotMachineStatsRequest{MachineID: "machine1", Granularity: "week"})
+		assert.Equal(t, ErrValidate, err, "Expected ErrValidate for an unknown granularity")
+
+		_, err = uc.Execute(ctx, &GetSlotMachineStatsRequest{MachineID: "machine1", From: now, To: now.Add(-time.Hour)})
+		assert.Equal(t, ErrValidate, err, "Expected ErrValidate when from is after to")
+
+		_, err = uc.Execute(ctx, &GetSlotMachineStatsRequest{MachineID: "machine1", Granularity: model.GranularityHour, From: now.Add(-8 * 24 * time.Hour)})
+		assert.Equal(t, ErrValidate, err, "Expected ErrValidate for an hourly range over seven days")
+	})
+
+	t.Run("Execute_Hourly", func(t *testing.T) {
+		resp, err := uc.Execute(ctx, &GetSlotMachineStatsRequest{MachineID: "machine1", Granularity: model.GranularityHour})
+		assert.NoError(t, err, "Expected no error getting machine stats")
+		assert.Equal(t, now.Add(-24*time.Hour), resp.From)
+		assert.Equal(t, now, resp.To)
+		assert.Equal(t, 1.0, resp.TheoreticalRTP)
+
+		assert.Equal(t, 3, resp.Totals.Spins)
+		assert.Equal(t, 1, resp.Totals.Wins)
+		assert.Equal(t, 40, resp.Totals.TotalWagered)
+		assert.Equal(t, 30, resp.Totals.TotalPaid)
+		assert.Equal(t, 2, resp.Totals.UniquePlayers)
+		assert.Equal(t, 30, resp.Totals.LargestWin)
+		assert.InDelta(t, 0.75, resp.Totals.RealisedRTP, 1e-9)
+		assert.InDelta(t, 1.0/3, resp.Totals.HitFrequency, 1e-9)
+
+		if assert.Len(t, resp.Series, 2) {
+			assert.Equal(t, time.Date(2025, 2, 27, 10, 0, 0, 0, time.UTC), resp.Series[0].Start)
+			assert.Equal(t, 1.5, resp.Series[0].RealisedRTP)
+			assert.Equal(t, time.Date(2025, 2, 27, 12, 0, 0, 0, time.UTC), resp.Series[1].Start)
+			assert.Equal(t, 0.0, resp.Series[1].RealisedRTP)
+		}
+	})
+
+	t.Run("Execute_DailyDefault", func(t *testing.T) {
+		resp, err := uc.Execute(ctx, &GetSlotMachineStatsRequest{MachineID: "machine1"})
+		assert.NoError(t, err, "Expected no error getting machine stats")
+		assert.Equal(t, model.GranularityDay, resp.Granularity)
+		if assert.Len(t, resp.Series, 1) {
+			assert.Equal(t, 3, resp.Series[0].Spins)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const recentTreasuryMovementsLimit = 50
+
+type GetTreasuryUseCase struct {
+	TreasuryRepo repository.TreasuryRepository
+	AuditRepo    repository.AuditRepository
+	now          func() time.Time
+}
+
+type GetTreasuryResponse struct {
+	Treasury        *model.Treasury           `json:"treasury"`
+	RecentMovements []*model.TreasuryMovement `json:"recent_movements"`
+}
+
+func NewGetTreasuryUseCase(treasuryRepo repository.TreasuryRepository, auditRepo repository.AuditRepository) *GetTreasuryUseCase {
+	return &GetTreasuryUseCase{
+		TreasuryRepo: treasuryRepo,
+		AuditRepo:    auditRepo,
+		now:          time.Now,
+	}
+}
+
+func (uc *GetTreasuryUseCase) Execute(ctx context.Context) (*GetTreasuryResponse, error) {
+	if err := authorize(ctx, model.ScopeTreasuryRead); err != nil {
+		return nil, err
+	}
+
+	treasury, err := uc.TreasuryRepo.GetTreasury(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	movements, err := uc.TreasuryRepo.ListTreasuryMovements(ctx, recentTreasuryMovementsLimit)
+	if err != nil {
+		return nil, err
+	}
+	if movements == nil {
+		movements = []*model.TreasuryMovement{}
+	}
+
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionTreasuryGet, "treasury", treasury.ID, nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	return &GetTreasuryResponse{
+		Treasury:        treasury,
+		RecentMovements: movements,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrTournamentClosed = errors.New("tournament is not accepting entries")
+
+type JoinTournamentUseCase struct {
+	TournamentRepo    repository.TournamentRepository
+	PlayerRepo        repository.PlayerRepository
+	TransactionRepo   repository.TransactionRepository
+	TreasuryRepo      repository.TreasuryRepository
+	GamblingLimitRepo repository.GamblingLimitRepository
+	SelfExclusionRepo repository.SelfExclusionRepository
+	OutboxRepo        repository.OutboxRepository
+	Transactor        ports.Transactor
+	// Events recebe o novo saldo do jogador.
+	Events ports.EventPublisher
+	now    func() time.Time
+}
+
+type JoinTournamentRequest struct {
+	TournamentID string
+	PlayerID     string
+}
+
+type JoinTournamentResponse struct {
+	Entry         model.TournamentEntry `json:"entry"`
+	PlayerBalance int                   `json:"player_balance"`
+}
+
+func NewJoinTournamentUseCase(tournamentRepo repository.TournamentRepository, playerRepo repository.PlayerRepository, txRepo repository.TransactionRepository, treasuryRepo repository.TreasuryRepository, limitRepo repository.GamblingLimitRepository, exclusionRepo repository.SelfExclusionRepository, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *JoinTournamentUseCase {
+	return &JoinTournamentUseCase{
+		TournamentRepo:    tournamentRepo,
+		PlayerRepo:        playerRepo,
+		TransactionRepo:   txRepo,
+		TreasuryRepo:      treasuryRepo,
+		GamblingLimitRepo: limitRepo,
+		SelfExclusionRepo: exclusionRepo,
+		OutboxRepo:        outboxRepo,
+		Transactor:        transactor,
+		now:               time.Now,
+	}
+}
+
+// Execute debita a inscrição do jogador e a credita na tesouraria, de onde
+// sai o prêmio no encerramento. A inscrição é dinheiro em risco, como uma
+// aposta, e por isso respeita os limites de aposta e de perda, verificados
+// com o jogador bloqueado na mesma transação do débito.
+func (uc *JoinTournamentUseCase) Execute(ctx context.Context, req *JoinTournamentRequest) (*JoinTournamentResponse, error) {
+	tournament, err := uc.TournamentRepo.GetTournament(ctx, req.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !player.EmailVerified {
+		return nil, ErrEmailNotVerified
+	}
+
+	now := uc.now()
+	if err := guardSelfExclusion(ctx, uc.SelfExclusionRepo, player.ID, now); err != nil {
+		return nil, err
+	}
+	if !tournament.AcceptsEntries(now) {
+		return nil, ErrTournamentClosed
+	}
+	if _, err := uc.TournamentRepo.GetEntry(ctx, tournament.ID, player.ID); err == nil {
+		return nil, repository.ErrTournamentEntryExists
+	} else if err != repository.ErrTournamentEntryNotFound {
+		return nil, err
+	}
+	if player.Balance < tournament.EntryFee {
+		return nil, ErrInsufficientBalance
+	}
+
+	entry := &model.TournamentEntry{
+		TournamentID: tournament.ID,
+		PlayerID:     player.ID,
+		JoinedAt:     now,
+	}
+	balance := player.Balance
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if tournament.EntryFee > 0 {
+			if err := uc.PlayerRepo.LockPlayer(ctx, player.ID); err != nil {
+				return err
+			}
+			limits, err := loadGamblingLimits(ctx, uc.GamblingLimitRepo, player.ID, now)
+			if err != nil {
+				return err
+			}
+			if err := checkBetLimits(ctx, uc.TransactionRepo, limits, player.ID, tournament.EntryFee, now); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.TournamentRepo.AddEntry(ctx, entry); err != nil {
+			return err
+		}
+		if tournament.EntryFee > 0 {
+			var err error
+			balance, err = uc.PlayerRepo.AdjustBalance(ctx, player.ID, -tournament.EntryFee)
+			if err == repository.ErrNegativeBalance {
+				return ErrInsufficientBalance
+			}
+			if err != nil {
+				return err
+			}
+			if err := recordTransaction(ctx, uc.TransactionRepo, player.ID, model.TransactionTournamentEntry, tournament.EntryFee, "", now); err != nil {
+				return err
+			}
+			err = uc.TreasuryRepo.ApplyMovement(ctx, &model.TreasuryMovement{
+				ID:        uuid.New().String(),
+				Type:      model.MovementTournamentEntry,
+				Amount:    tournament.EntryFee,
+				Reason:    "tournament " + tournament.ID + " entry",
+				Actor:     player.ID,
+				CreatedAt: now,
+			}, 0)
+			if err != nil {
+				return err
+			}
+		}
+		return recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventTournamentJoined, "tournament", tournament.ID, model.TournamentJoinedPayload{
+			TournamentID: tournament.ID,
+			PlayerID:     player.ID,
+			EntryFee:     tournament.EntryFee,
+			Balance:      balance,
+		}, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tournament.EntryFee > 0 {
+		publishEvent(uc.Events, model.EventBalanceUpdated, player.ID, model.BalanceUpdate{
+			Balance: balance,
+			Delta:   -tournament.EntryFee,
+			Reason:  model.BalanceReasonTournament,
+		}, now)
+	}
+
+	return &JoinTournamentResponse{
+		Entry:         *entry,
+		PlayerBalance: balance,
+	}, nil
+}
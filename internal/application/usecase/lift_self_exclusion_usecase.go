@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type LiftSelfExclusionUseCase struct {
+	SelfExclusionRepo repository.SelfExclusionRepository
+	AuditRepo         repository.AuditRepository
+	Transactor        ports.Transactor
+	now               func() time.Time
+}
+
+type LiftSelfExclusionRequest struct {
+	PlayerID string `json:"-"`
+}
+
+func NewLiftSelfExclusionUseCase(exclusionRepo repository.SelfExclusionRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *LiftSelfExclusionUseCase {
+	return &LiftSelfExclusionUseCase{
+		SelfExclusionRepo: exclusionRepo,
+		AuditRepo:         auditRepo,
+		Transactor:        transactor,
+		now:               time.Now,
+	}
+}
+
+// Execute encerra antecipadamente uma exclusão por prazo determinado.
+// Exclusões permanentes não podem ser encerradas.
+func (uc *LiftSelfExclusionUseCase) Execute(ctx context.Context, req *LiftSelfExclusionRequest) error {
+	if err := authorize(ctx, model.ScopePlayersWrite); err != nil {
+		return err
+	}
+
+	now := uc.now()
+	exclusion, err := activeSelfExclusion(ctx, uc.SelfExclusionRepo, req.PlayerID, now)
+	if err != nil {
+		return err
+	}
+	if exclusion == nil {
+		return repository.ErrSelfExclusionNotFound
+	}
+	if exclusion.IsPermanent() {
+		return ErrPermanentExclusion
+	}
+
+	before := *exclusion
+	actor, _ := ctx.Value(contextkeys.ContextKeyUserID).(string)
+	exclusion.LiftedAt = &now
+	exclusion.LiftedBy = actor
+
+	return uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.SelfExclusionRepo.SaveSelfExclusion(ctx, exclusion); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionSelfExclusionLift, "player", req.PlayerID, before, exclusion, now)
+	})
+}
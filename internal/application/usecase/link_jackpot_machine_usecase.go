@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type LinkJackpotMachineUseCase struct {
+	JackpotRepo     repository.JackpotRepository
+	SlotMachineRepo repository.SlotMachineRepository
+	AuditRepo       repository.AuditRepository
+	Transactor      ports.Transactor
+	now             func() time.Time
+}
+
+// JackpotMachineRequest é usado tanto para ligar quanto para desligar uma
+// máquina de um pool.
+type JackpotMachineRequest struct {
+	PoolID    string `json:"-"`
+	MachineID string `json:"machine_id"`
+}
+
+func NewLinkJackpotMachineUseCase(jackpotRepo repository.JackpotRepository, slotRepo repository.SlotMachineRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *LinkJackpotMachineUseCase {
+	return &LinkJackpotMachineUseCase{
+		JackpotRepo:     jackpotRepo,
+		SlotMachineRepo: slotRepo,
+		AuditRepo:       auditRepo,
+		Transactor:      transactor,
+		now:             time.Now,
+	}
+}
+
+// Execute liga a máquina ao pool. Uma máquina participa de no máximo um
+// pool; para trocar, é preciso desligá-la do atual antes.
+func (uc *LinkJackpotMachineUseCase) Execute(ctx context.Context, req *JackpotMachineRequest) (*model.JackpotPool, error) {
+	if err := authorize(ctx, model.ScopeJackpotsManage); err != nil {
+		return nil, err
+	}
+	if req.MachineID == "" {
+		return nil, ErrValidate
+	}
+
+	before, err := uc.JackpotRepo.GetPool(ctx, req.PoolID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.SlotMachineRepo.GetSlotMachine(ctx, req.MachineID); err != nil {
+		return nil, err
+	}
+
+	var after *model.JackpotPool
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.JackpotRepo.LinkMachine(ctx, req.PoolID, req.MachineID); err != nil {
+			return err
+		}
+		var err error
+		after, err = uc.JackpotRepo.GetPool(ctx, req.PoolID)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionJackpotLink, "jackpot", req.PoolID, before.MachineIDs, after.MachineIDs, uc.now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
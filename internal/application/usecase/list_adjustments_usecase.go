@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type ListAdjustmentsUseCase struct {
+	AdjustmentRepo repository.AdjustmentRepository
+	AuditRepo      repository.AuditRepository
+	now            func() time.Time
+}
+
+type ListAdjustmentsRequest struct {
+	Status model.AdjustmentStatus
+}
+
+type ListAdjustmentsResponse struct {
+	Adjustments []*model.BalanceAdjustment `json:"adjustments"`
+}
+
+func NewListAdjustmentsUseCase(adjustmentRepo repository.AdjustmentRepository, auditRepo repository.AuditRepository) *ListAdjustmentsUseCase {
+	return &ListAdjustmentsUseCase{
+		AdjustmentRepo: adjustmentRepo,
+		AuditRepo:      auditRepo,
+		now:            time.Now,
+	}
+}
+
+func (uc *ListAdjustmentsUseCase) Execute(ctx context.Context, req *ListAdjustmentsRequest) (*ListAdjustmentsResponse, error) {
+	if err := authorize(ctx, model.ScopeAdjustmentsApprove); err != nil {
+		return nil, err
+	}
+
+	switch req.Status {
+	case "", model.AdjustmentPending, model.AdjustmentApproved, model.AdjustmentRejected:
+	default:
+		return nil, ErrValidate
+	}
+
+	adjustments, err := uc.AdjustmentRepo.ListAdjustments(ctx, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionAdjustmentList, "adjustment", "", nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	if adjustments == nil {
+		adjustments = []*model.BalanceAdjustment{}
+	}
+
+	return &ListAdjustmentsResponse{
+		Adjustments: adjustments,
+	}, nil
+}
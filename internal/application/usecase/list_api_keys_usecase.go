@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type ListAPIKeysUseCase struct {
+	APIKeyRepo repository.APIKeyRepository
+	AuditRepo  repository.AuditRepository
+	now        func() time.Time
+}
+
+type ListAPIKeysResponse struct {
+	APIKeys []*model.APIKey `json:"api_keys"`
+}
+
+func NewListAPIKeysUseCase(apiKeyRepo repository.APIKeyRepository, auditRepo repository.AuditRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		APIKeyRepo: apiKeyRepo,
+		AuditRepo:  auditRepo,
+		now:        time.Now,
+	}
+}
+
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context) (*ListAPIKeysResponse, error) {
+	if err := authorize(ctx, model.ScopeAPIKeysManage); err != nil {
+		return nil, err
+	}
+
+	keys, err := uc.APIKeyRepo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionAPIKeyList, "api_key", "", nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	if keys == nil {
+		keys = []*model.APIKey{}
+	}
+
+	return &ListAPIKeysResponse{
+		APIKeys: keys,
+	}, nil
+}
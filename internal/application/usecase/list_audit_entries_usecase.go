@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 500
+)
+
+type ListAuditEntriesUseCase struct {
+	AuditRepo repository.AuditRepository
+	now       func() time.Time
+}
+
+type ListAuditEntriesRequest struct {
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+type ListAuditEntriesResponse struct {
+	Entries []*model.AuditEntry `json:"entries"`
+}
+
+func NewListAuditEntriesUseCase(auditRepo repository.AuditRepository) *ListAuditEntriesUseCase {
+	return &ListAuditEntriesUseCase{
+		AuditRepo: auditRepo,
+		now:       time.Now,
+	}
+}
+
+func (uc *ListAuditEntriesUseCase) Execute(ctx context.Context, req *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	if err := authorize(ctx, model.ScopeAuditRead); err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Offset < 0 || req.Limit > maxAuditLimit {
+		return nil, ErrValidate
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && !req.From.Before(req.To) {
+		return nil, ErrValidate
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultAuditLimit
+	}
+
+	entries, err := uc.AuditRepo.ListAuditEntries(ctx, repository.AuditFilter{
+		Actor:      req.Actor,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		From:       req.From,
+		To:         req.To,
+		Limit:      limit,
+		Offset:     req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A própria consulta ao log também fica registrada.
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionAuditList, "audit", "", nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	if entries == nil {
+		entries = []*model.AuditEntry{}
+	}
+
+	return &ListAuditEntriesResponse{
+		Entries: entries,
+	}, nil
+}
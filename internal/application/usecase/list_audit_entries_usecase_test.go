@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAuditEntriesUseCase(t *testing.T) {
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	apiKeyRepo := repository_in_memory.NewInMemoryAPIKeyRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+
+	now := time.Date(2025, 2, 20, 10, 0, 0, 0, time.UTC)
+	createSlotMachineUC := NewCreateSlotMachineUseCase(slotRepo, auditRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	createSlotMachineUC.now = func() time.Time { return now }
+	createAPIKeyUC := NewCreateAPIKeyUseCase(apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	createAPIKeyUC.now = func() time.Time { return now }
+	revokeAPIKeyUC := NewRevokeAPIKeyUseCase(apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	revokeAPIKeyUC.now = func() time.Time { return now.Add(time.Hour) }
+	listAuditUC := NewListAuditEntriesUseCase(auditRepo)
+
+	ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, "admin1")
+	ctx = context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+	ctx = context.WithValue(ctx, contextkeys.ContextKeyRequestID, "req-1")
+	ctx = context.WithValue(ctx, contextkeys.ContextKeyClientIP, "10.0.0.1")
+
+	machine, err := createSlotMachineUC.Execute(ctx, &CreateSlotMachineRequest{Level: 1, Balance: 1000, MultipleGain: 2, Description: "teste"})
+	assert.NoError(t, err, "Erro ao criar máquina")
+
+	key, err := createAPIKeyUC.Execute(ctx, &CreateAPIKeyRequest{Name: "ops", Scopes: []model.Scope{model.ScopeAuditRead}})
+	assert.NoError(t, err, "Erro ao criar API key")
+
+	err = revokeAPIKeyUC.Execute(ctx, &RevokeAPIKeyRequest{ID: key.APIKey.ID})
+	assert.NoError(t, err, "Erro ao revogar API key")
+
+	t.Run("Execute_RecordsContext", func(t *testing.T) {
+		resp, err := listAuditUC.Execute(ctx, &ListAuditEntriesRequest{Action: AuditActionMachineCreate})
+		assert.NoError(t, err, "Expected no error listing the audit log")
+		assert.Len(t, resp.Entries, 1, "Expected one machine creation entry")
+
+		entry := resp.Entries[0]
+		assert.Equal(t, "admin1", entry.Actor)
+		assert.Equal(t, "machine", entry.TargetType)
+		assert.Equal(t, machine.Machine.ID, entry.TargetID)
+		assert.Equal(t, "req-1", entry.RequestID)
+		assert.Equal(t, "10.0.0.1", entry.IP)
+		assert.Empty(t, entry.Before, "Expected no before snapshot on creation")
+		assert.NotEmpty(t, entry.After, "Expected an after snapshot on creation")
+	})
+
+	t.Run("Execute_BeforeAfterSnapshots", func(t *testing.T) {
+		resp, err := listAuditUC.Execute(ctx, &ListAuditEntriesRequest{Action: AuditActionAPIKeyRevoke, TargetID: key.APIKey.ID})
+		assert.NoError(t, err, "Expected no error listing the audit log")
+		assert.Len(t, resp.Entries, 1, "Expected one revoke entry")
+
+		var before, after model.APIKey
+		assert.NoError(t, json.Unmarshal(resp.Entries[0].Before, &before))
+		assert.NoError(t, json.Unmarshal(resp.Entries[0].After, &after))
+		assert.Nil(t, before.RevokedAt, "Expected the key to be active before the revoke")
+		assert.NotNil(t, after.RevokedAt, "Expected the key to be revoked after the revoke")
+	})
+
+	t.Run("Execute_APIKeyActor", func(t *testing.T) {
+		keyCtx := context.WithValue(ctx, contextkeys.ContextKeyUserID, "api_key:key1")
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyAPIKeyID, "key1")
+		keyCtx = context.WithValue(keyCtx, contextkeys.ContextKeyScopes, []model.Scope{model.ScopeAuditRead})
+
+		_, err := listAuditUC.Execute(keyCtx, &ListAuditEntriesRequest{})
+		assert.NoError(t, err, "Expected keys with audit:read to list the audit log")
+
+		resp, err := listAuditUC.Execute(ctx, &ListAuditEntriesRequest{Actor: "api_key:key1"})
+		assert.NoError(t, err, "Expected no error listing the audit log")
+		assert.Len(t, resp.Entries, 1, "Expected the audit read itself to be recorded")
+		assert.Equal(t, "key1", resp.Entries[0].APIKeyID)
+		assert.Equal(t, AuditActionAuditList, resp.Entries[0].Action)
+	})
+
+	t.Run("Execute_ScopeEnforced", func(t *testing.T) {
+		keyCtx := context.WithValue(ctx, contextkeys.ContextKeyScopes, []model.Scope{model.ScopeMachinesRead})
+
+		_, err := listAuditUC.Execute(keyCtx, &ListAuditEntriesRequest{})
+		assert.Equal(t, ErrForbidden, err, "Expected keys without audit:read to be forbidden")
+	})
+
+	t.Run("Execute_InvalidRange", func(t *testing.T) {
+		_, err := listAuditUC.Execute(ctx, &ListAuditEntriesRequest{From: now, To: now.Add(-time.Hour)})
+		assert.Equal(t, ErrValidate, err, "Expected an inverted range to be rejected")
+
+		_, err = listAuditUC.Execute(ctx, &ListAuditEntriesRequest{Limit: maxAuditLimit + 1})
+		assert.Equal(t, ErrValidate, err, "Expected a limit above the maximum to be rejected")
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const recentJackpotWinsLimit = 20
+
+type ListJackpotsUseCase struct {
+	JackpotRepo repository.JackpotRepository
+}
+
+// JackpotSummary é a visão do pool exposta aos jogadores, sem a
+// configuração de contribuição e gatilho.
+type JackpotSummary struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Amount     int      `json:"amount"`
+	MachineIDs []string `json:"machine_ids"`
+}
+
+// JackpotWinner omite o jogador premiado.
+type JackpotWinner struct {
+	PoolID    string    `json:"pool_id"`
+	MachineID string    `json:"machine_id"`
+	Amount    int       `json:"amount"`
+	WonAt     time.Time `json:"won_at"`
+}
+
+type ListJackpotsResponse struct {
+	Jackpots      []JackpotSummary `json:"jackpots"`
+	RecentWinners []JackpotWinner  `json:"recent_winners"`
+}
+
+func NewListJackpotsUseCase(jackpotRepo repository.JackpotRepository) *ListJackpotsUseCase {
+	return &ListJackpotsUseCase{
+		JackpotRepo: jackpotRepo,
+	}
+}
+
+func (uc *ListJackpotsUseCase) Execute(ctx context.Context) (*ListJackpotsResponse, error) {
+	pools, err := uc.JackpotRepo.ListPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wins, err := uc.JackpotRepo.ListJackpotWins(ctx, recentJackpotWinsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListJackpotsResponse{
+		Jackpots:      make([]JackpotSummary, 0, len(pools)),
+		RecentWinners: make([]JackpotWinner, 0, len(wins)),
+	}
+	for _, pool := range pools {
+		resp.Jackpots = append(resp.Jackpots, summarizeJackpot(pool))
+	}
+	for _, win := range wins {
+		resp.RecentWinners = append(resp.RecentWinners, JackpotWinner{
+			PoolID:    win.PoolID,
+			MachineID: win.MachineID,
+			Amount:    win.Amount,
+			WonAt:     win.CreatedAt,
+		})
+	}
+	return resp, nil
+}
+
+func summarizeJackpot(pool *model.JackpotPool) JackpotSummary {
+	return JackpotSummary{
+		ID:         pool.ID,
+		Name:       pool.Name,
+		Amount:     pool.Amount,
+		MachineIDs: pool.MachineIDs,
+	}
+}
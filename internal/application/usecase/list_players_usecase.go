@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const (
+	defaultPlayersLimit = 50
+	maxPlayersLimit     = 200
+)
+
+type ListPlayersUseCase struct {
+	PlayerRepo repository.PlayerRepository
+	AuditRepo  repository.AuditRepository
+	now        func() time.Time
+}
+
+// ListPlayersRequest aceita SortBy "created_at" (padrão) ou "balance" e
+// Order "asc" (padrão) ou "desc".
+type ListPlayersRequest struct {
+	Email  string
+	SortBy string
+	Order  string
+	Limit  int
+	Offset int
+}
+
+type ListPlayersResponse struct {
+	Players []*model.Player `json:"players"`
+	Total   int             `json:"total"`
+}
+
+func NewListPlayersUseCase(playerRepo repository.PlayerRepository, auditRepo repository.AuditRepository) *ListPlayersUseCase {
+	return &ListPlayersUseCase{
+		PlayerRepo: playerRepo,
+		AuditRepo:  auditRepo,
+		now:        time.Now,
+	}
+}
+
+func (uc *ListPlayersUseCase) Execute(ctx context.Context, req *ListPlayersRequest) (*ListPlayersResponse, error) {
+	if err := authorize(ctx, model.ScopePlayersRead); err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Offset < 0 || req.Limit > maxPlayersLimit {
+		return nil, ErrValidate
+	}
+
+	filter := repository.PlayerFilter{
+		Email:  req.Email,
+		SortBy: repository.PlayerSortCreatedAt,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPlayersLimit
+	}
+
+	switch repository.PlayerSortField(req.SortBy) {
+	case "", repository.PlayerSortCreatedAt:
+	case repository.PlayerSortBalance:
+		filter.SortBy = repository.PlayerSortBalance
+	default:
+		return nil, ErrValidate
+	}
+
+	switch req.Order {
+	case "", "asc":
+	case "desc":
+		filter.Descending = true
+	default:
+		return nil, ErrValidate
+	}
+
+	players, total, err := uc.PlayerRepo.SearchPlayers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionPlayerList, "player", "", nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	if players == nil {
+		players = []*model.Player{}
+	}
+
+	return &ListPlayersResponse{
+		Players: players,
+		Total:   total,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+)
+
+type ListTournamentsUseCase struct {
+	TournamentRepo repository.TournamentRepository
+}
+
+// ListTournamentsRequest sem Status lista os torneios abertos e encerrados.
+type ListTournamentsRequest struct {
+	Status model.TournamentStatus
+}
+
+type ListTournamentsResponse struct {
+	Tournaments []*model.Tournament `json:"tournaments"`
+}
+
+func NewListTournamentsUseCase(tournamentRepo repository.TournamentRepository) *ListTournamentsUseCase {
+	return &ListTournamentsUseCase{
+		TournamentRepo: tournamentRepo,
+	}
+}
+
+func (uc *ListTournamentsUseCase) Execute(ctx context.Context, req *ListTournamentsRequest) (*ListTournamentsResponse, error) {
+	switch req.Status {
+	case "", model.TournamentStatusOpen, model.TournamentStatusSettled:
+	default:
+		return nil, ErrValidate
+	}
+
+	tournaments, err := uc.TournamentRepo.ListTournaments(ctx, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if tournaments == nil {
+		tournaments = []*model.Tournament{}
+	}
+
+	return &ListTournamentsResponse{
+		Tournaments: tournaments,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const (
+	defaultWebhookDeliveryLimit = 50
+	maxWebhookDeliveryLimit     = 500
+)
+
+type ListWebhookDeliveriesUseCase struct {
+	WebhookRepo repository.WebhookRepository
+	AuditRepo   repository.AuditRepository
+	now         func() time.Time
+}
+
+// ListWebhookDeliveriesRequest com Status "dead" lista as entregas que
+// esgotaram as tentativas.
+type ListWebhookDeliveriesRequest struct {
+	SubscriptionID string
+	Status         model.WebhookDeliveryStatus
+	Limit          int
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []*model.WebhookDelivery `json:"deliveries"`
+}
+
+func NewListWebhookDeliveriesUseCase(webhookRepo repository.WebhookRepository, auditRepo repository.AuditRepository) *ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCase{
+		WebhookRepo: webhookRepo,
+		AuditRepo:   auditRepo,
+		now:         time.Now,
+	}
+}
+
+func (uc *ListWebhookDeliveriesUseCase) Execute(ctx context.Context, req *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	if err := authorize(ctx, model.ScopeWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	switch req.Status {
+	case "", model.WebhookDeliveryPending, model.WebhookDeliveryDelivered, model.WebhookDeliveryDead:
+	default:
+		return nil, ErrValidate
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+	if limit < 0 || limit > maxWebhookDeliveryLimit {
+		return nil, ErrValidate
+	}
+
+	deliveries, err := uc.WebhookRepo.ListDeliveries(ctx, repository.WebhookDeliveryFilter{
+		SubscriptionID: req.SubscriptionID,
+		Status:         req.Status,
+		Limit:          limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionWebhookLog, "webhook", req.SubscriptionID, nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	if deliveries == nil {
+		deliveries = []*model.WebhookDelivery{}
+	}
+
+	return &ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type ListWebhooksUseCase struct {
+	WebhookRepo repository.WebhookRepository
+	AuditRepo   repository.AuditRepository
+	now         func() time.Time
+}
+
+type ListWebhooksResponse struct {
+	Subscriptions []*model.WebhookSubscription `json:"subscriptions"`
+}
+
+func NewListWebhooksUseCase(webhookRepo repository.WebhookRepository, auditRepo repository.AuditRepository) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{
+		WebhookRepo: webhookRepo,
+		AuditRepo:   auditRepo,
+		now:         time.Now,
+	}
+}
+
+func (uc *ListWebhooksUseCase) Execute(ctx context.Context) (*ListWebhooksResponse, error) {
+	if err := authorize(ctx, model.ScopeWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := uc.WebhookRepo.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordAudit(ctx, uc.AuditRepo, AuditActionWebhookList, "webhook", "", nil, nil, uc.now()); err != nil {
+		return nil, err
+	}
+
+	if subscriptions == nil {
+		subscriptions = []*model.WebhookSubscription{}
+	}
+
+	return &ListWebhooksResponse{
+		Subscriptions: subscriptions,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+)
+
+// LoginThrottlePolicy define quantas falhas de login são toleradas antes de
+// aplicar atrasos progressivos e bloqueio temporário da conta.
+type LoginThrottlePolicy struct {
+	FreeAttempts        int
+	IPFreeAttempts      int
+	BaseDelay           time.Duration
+	MaxDelay            time.Duration
+	AccountLockoutAfter int
+	LockoutDuration     time.Duration
+	FailureWindow       time.Duration
+}
+
+func DefaultLoginThrottlePolicy() LoginThrottlePolicy {
+	return LoginThrottlePolicy{
+		FreeAttempts:        3,
+		IPFreeAttempts:      20,
+		BaseDelay:           time.Second,
+		MaxDelay:            30 * time.Second,
+		AccountLockoutAfter: 10,
+		LockoutDuration:     15 * time.Minute,
+		FailureWindow:       15 * time.Minute,
+	}
+}
+
+// penalize calcula, a partir das falhas já contabilizadas na tentativa, até
+// quando a chave fica bloqueada ou travada. Devolve false quando a falha
+// ainda está dentro das tentativas livres.
+func (p LoginThrottlePolicy) penalize(attempt *model.LoginAttempt, freeAttempts, lockoutAfter int, now time.Time) bool {
+	excess := attempt.Failures - freeAttempts
+	if excess <= 0 {
+		return false
+	}
+	attempt.BlockedUntil = now.Add(p.delay(excess))
+
+	if lockoutAfter > 0 && attempt.Failures >= lockoutAfter {
+		attempt.LockedUntil = now.Add(p.LockoutDuration)
+		attempt.Failures = 0
+	}
+	return true
+}
+
+// recordFailure contabiliza a falha de forma atômica no repositório e decide
+// o atraso ou o travamento pelo total devolvido, para que falhas simultâneas
+// não se sobrescrevam.
+func (p LoginThrottlePolicy) recordFailure(ctx context.Context, repo repository.LoginAttemptRepository, key string, freeAttempts, lockoutAfter int, now time.Time) (*model.LoginAttempt, error) {
+	attempt, err := repo.RecordLoginFailure(ctx, key, now, p.FailureWindow)
+	if err != nil {
+		return nil, err
+	}
+	if !p.penalize(attempt, freeAttempts, lockoutAfter, now) {
+		return attempt, nil
+	}
+	if err := repo.BlockLoginAttempt(ctx, key, attempt.BlockedUntil, attempt.LockedUntil); err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// delay dobra a espera a cada falha excedente, limitado a MaxDelay.
+func (p LoginThrottlePolicy) delay(excess int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < excess; i++ {
+		d *= 2
+		if d >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	if d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
@@ -3,75 +3,212 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
+	"slot-machine/internal/domain/model"
 	"slot-machine/internal/domain/ports"
 	"slot-machine/internal/domain/repository"
 	"slot-machine/internal/domain/security"
 )
 
 var (
-	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidCredentials   = errors.New("invalid credentials")
+	ErrTooManyLoginAttempts = errors.New("too many login attempts")
+	ErrAccountLocked        = errors.New("account temporarily locked")
 )
 
+// dummyPassword é usado para gerar o hash comparado quando o email não existe,
+// mantendo o tempo de resposta igual ao de uma senha incorreta.
+const dummyPassword = "slot-machine-dummy-password"
+
 type LoginUseCase struct {
-	PlayerRepo repository.PlayerRepository
-	RefreshTokenRepo repository.RefreshTokenRepository
-	Hasher     security.PasswordHasher
-	JWTManager ports.JWTManager
+	PlayerRepo        repository.PlayerRepository
+	RefreshTokenRepo  repository.RefreshTokenRepository
+	LoginAttemptRepo  repository.LoginAttemptRepository
+	SelfExclusionRepo repository.SelfExclusionRepository
+	Hasher            security.PasswordHasher
+	JWTManager        ports.JWTManager
+	Throttle          LoginThrottlePolicy
+	dummyHash         string
+	now               func() time.Time
 }
 
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
+	IP       string `json:"-"`
 }
 
+// LoginResponse contém os tokens da sessão ou, quando a conta exige segundo
+// fator, apenas o token de MFA a ser usado em /login/2fa.
 type LoginResponse struct {
-	AccessToken  string `json:"access_token"`
-    RefreshToken string `json:"refresh_token"`
+	AccessToken           string `json:"access_token"`
+	RefreshToken          string `json:"refresh_token"`
+	MFARequired           bool   `json:"mfa_required,omitempty"`
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required,omitempty"`
+	MFAToken              string `json:"mfa_token,omitempty"`
 }
 
-func NewLoginUseCase(playerRepo repository.PlayerRepository, refreshRepo repository.RefreshTokenRepository, hasher security.PasswordHasher, jwtManager ports.JWTManager) *LoginUseCase {
+func NewLoginUseCase(playerRepo repository.PlayerRepository, refreshRepo repository.RefreshTokenRepository, attemptRepo repository.LoginAttemptRepository, exclusionRepo repository.SelfExclusionRepository, hasher security.PasswordHasher, jwtManager ports.JWTManager) *LoginUseCase {
+	dummyHash, _ := hasher.Hash(dummyPassword)
+
 	return &LoginUseCase{
-		PlayerRepo: playerRepo,
-		Hasher:     hasher,
-		JWTManager: jwtManager,
-		RefreshTokenRepo: refreshRepo,
+		PlayerRepo:        playerRepo,
+		Hasher:            hasher,
+		JWTManager:        jwtManager,
+		RefreshTokenRepo:  refreshRepo,
+		LoginAttemptRepo:  attemptRepo,
+		SelfExclusionRepo: exclusionRepo,
+		Throttle:          DefaultLoginThrottlePolicy(),
+		dummyHash:         dummyHash,
+		now:               time.Now,
 	}
 }
 
 func (uc *LoginUseCase) Execute(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
-	player, err := uc.PlayerRepo.GetPlayerByEmail(ctx, req.Email)
+	now := uc.now()
+	email := model.NormalizeEmail(req.Email)
+	accountKey := accountAttemptKey(email)
+	ipKey := ipAttemptKey(req.IP)
+
+	if err := uc.checkThrottle(ctx, accountKey, ipKey, now); err != nil {
+		return nil, err
+	}
+
+	player, err := uc.PlayerRepo.GetPlayerByEmail(ctx, email)
 
 	if err != nil {
 		if err == repository.ErrPlayerNotFound {
-			return nil, ErrInvalidCredentials
+			_ = uc.Hasher.CompareHashAndPassword(uc.dummyHash, req.Password)
+			return nil, uc.registerFailure(ctx, accountKey, ipKey, now)
 		}
 		return nil, err
 	}
 
 	err = uc.Hasher.CompareHashAndPassword(player.Password, req.Password)
 	if err != nil {
-		return nil, ErrInvalidCredentials
+		return nil, uc.registerFailure(ctx, accountKey, ipKey, now)
 	}
 
-	accessToken, err := uc.JWTManager.GenerateAccessToken(player.ID)
-    if err != nil {
-        return nil, err
-    }
+	if err := uc.LoginAttemptRepo.DeleteLoginAttempt(ctx, accountKey); err != nil {
+		return nil, err
+	}
 
-    refreshToken, err := uc.JWTManager.GenerateRefreshToken(player.ID)
-    if err != nil {
-        return nil, err
-    }
+	if player.Blocked {
+		return nil, ErrPlayerBlocked
+	}
 
-	err = uc.RefreshTokenRepo.StoreRefreshToken(ctx, player.ID, refreshToken)
+	// Autoexcluídos não entram; em uma pausa o acesso é só de leitura, pois
+	// jogadas e depósitos são recusados.
+	exclusion, err := activeSelfExclusion(ctx, uc.SelfExclusionRepo, player.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if exclusion != nil && exclusion.Type == model.ExclusionSelfExclusion {
+		return nil, ErrSelfExcluded
+	}
+
+	if player.TOTPEnabled || player.Role == model.AdminRole {
+		return uc.mfaChallenge(player)
+	}
 
+	return uc.issueTokens(ctx, player)
+}
+
+// mfaChallenge exige o segundo fator. Administradores sem TOTP configurado
+// recebem o desafio de cadastro, pois o 2FA é obrigatório para eles.
+func (uc *LoginUseCase) mfaChallenge(player *model.Player) (*LoginResponse, error) {
+	mfaToken, err := uc.JWTManager.GenerateMFAToken(player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResponse{
+		MFARequired:           player.TOTPEnabled,
+		MFAEnrollmentRequired: !player.TOTPEnabled,
+		MFAToken:              mfaToken,
+	}, nil
+}
+
+func (uc *LoginUseCase) issueTokens(ctx context.Context, player *model.Player) (*LoginResponse, error) {
+	accessToken, refreshToken, err := issueTokenPair(ctx, uc.JWTManager, uc.RefreshTokenRepo, player.ID)
 	if err != nil {
 		return nil, err
 	}
 
 	return &LoginResponse{
-        AccessToken:  accessToken,
-        RefreshToken: refreshToken,
-    }, nil
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (uc *LoginUseCase) checkThrottle(ctx context.Context, accountKey, ipKey string, now time.Time) error {
+	account, err := uc.getAttempt(ctx, accountKey)
+	if err != nil {
+		return err
+	}
+	if now.Before(account.LockedUntil) {
+		return ErrAccountLocked
+	}
+	if now.Before(account.BlockedUntil) {
+		return ErrTooManyLoginAttempts
+	}
+
+	if ipKey == "" {
+		return nil
+	}
+
+	ip, err := uc.getAttempt(ctx, ipKey)
+	if err != nil {
+		return err
+	}
+	if now.Before(ip.BlockedUntil) {
+		return ErrTooManyLoginAttempts
+	}
+
+	return nil
+}
+
+// registerFailure contabiliza a falha na conta e no IP e devolve o erro que
+// deve ser retornado ao cliente.
+func (uc *LoginUseCase) registerFailure(ctx context.Context, accountKey, ipKey string, now time.Time) error {
+	account, err := uc.Throttle.recordFailure(ctx, uc.LoginAttemptRepo, accountKey, uc.Throttle.FreeAttempts, uc.Throttle.AccountLockoutAfter, now)
+	if err != nil {
+		return err
+	}
+
+	if ipKey != "" {
+		if _, err := uc.Throttle.recordFailure(ctx, uc.LoginAttemptRepo, ipKey, uc.Throttle.IPFreeAttempts, 0, now); err != nil {
+			return err
+		}
+	}
+
+	if now.Before(account.LockedUntil) {
+		return ErrAccountLocked
+	}
+
+	return ErrInvalidCredentials
+}
+
+func (uc *LoginUseCase) getAttempt(ctx context.Context, key string) (*model.LoginAttempt, error) {
+	return loadLoginAttempt(ctx, uc.LoginAttemptRepo, key)
+}
+
+func loadLoginAttempt(ctx context.Context, repo repository.LoginAttemptRepository, key string) (*model.LoginAttempt, error) {
+	attempt, err := repo.GetLoginAttempt(ctx, key)
+	if err == repository.ErrLoginAttemptNotFound {
+		return &model.LoginAttempt{Key: key}, nil
+	}
+	return attempt, err
+}
+
+func accountAttemptKey(email string) string {
+	return "account:" + email
+}
+
+func ipAttemptKey(ip string) string {
+	if ip == "" {
+		return ""
+	}
+	return "ip:" + ip
 }
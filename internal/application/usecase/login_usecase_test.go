@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/jwt"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"slot-machine/internal/infrastructure/security"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestLoginUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	refreshRepo := repository_in_memory.NewInMemoryRefreshTokenRepository()
+	attemptRepo := repository_in_memory.NewInMemoryLoginAttemptRepository()
+	hasher := security.NewBcryptPasswordHasher(bcrypt.MinCost)
+	jwtManager := jwt.NewJWTManager("secret", time.Minute, time.Hour)
+
+	loginUC := NewLoginUseCase(playerRepo, refreshRepo, attemptRepo, repository_in_memory.NewInMemorySelfExclusionRepository(), hasher, jwtManager)
+
+	now := time.Date(2025, 2, 15, 12, 0, 0, 0, time.UTC)
+	loginUC.now = func() time.Time { return now }
+
+	hashed, err := hasher.Hash("password")
+	assert.NoError(t, err, "Erro ao gerar hash da senha")
+
+	err = playerRepo.CreatePlayer(ctx, &model.Player{
+		ID:       "player1",
+		Email:    "player@email.com",
+		Password: hashed,
+		Role:     model.PlayerRole,
+	})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	t.Run("Execute_Success", func(t *testing.T) {
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "password", IP: "10.0.0.1"})
+
+		assert.NoError(t, err, "Expected no error on valid credentials")
+		assert.NotEmpty(t, resp.AccessToken, "Expected an access token")
+		assert.NotEmpty(t, resp.RefreshToken, "Expected a refresh token")
+	})
+
+	t.Run("Execute_InvalidPassword", func(t *testing.T) {
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "wrong", IP: "10.0.0.1"})
+
+		assert.Equal(t, ErrInvalidCredentials, err, "Expected ErrInvalidCredentials")
+		assert.Nil(t, resp, "Expected no response when there is an error")
+	})
+
+	t.Run("Execute_ProgressiveDelay", func(t *testing.T) {
+		for i := 0; i < loginUC.Throttle.FreeAttempts+1; i++ {
+			now = now.Add(time.Minute)
+			_, err := loginUC.Execute(ctx, &LoginRequest{Email: "delay@email.com", Password: "wrong"})
+			assert.Equal(t, ErrInvalidCredentials, err, "Expected ErrInvalidCredentials before the delay kicks in")
+		}
+
+		_, err := loginUC.Execute(ctx, &LoginRequest{Email: "delay@email.com", Password: "wrong"})
+		assert.Equal(t, ErrTooManyLoginAttempts, err, "Expected attempts to be throttled during the delay")
+
+		now = now.Add(loginUC.Throttle.BaseDelay)
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "delay@email.com", Password: "wrong"})
+		assert.Equal(t, ErrInvalidCredentials, err, "Expected attempts to be accepted after the delay")
+	})
+
+	t.Run("Execute_AccountLockout", func(t *testing.T) {
+		var err error
+		for i := 0; i < loginUC.Throttle.AccountLockoutAfter; i++ {
+			now = now.Add(loginUC.Throttle.MaxDelay)
+			_, err = loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "wrong"})
+		}
+		assert.Equal(t, ErrAccountLocked, err, "Expected the account to be locked after too many failures")
+
+		now = now.Add(loginUC.Throttle.MaxDelay)
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "password"})
+		assert.Equal(t, ErrAccountLocked, err, "Expected the correct password to be rejected while locked")
+
+		now = now.Add(loginUC.Throttle.LockoutDuration)
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected login to succeed after the lockout expires")
+		assert.NotNil(t, resp, "Expected a response")
+	})
+
+	t.Run("Execute_IPThrottle", func(t *testing.T) {
+		var err error
+		for i := 0; i < loginUC.Throttle.IPFreeAttempts+1; i++ {
+			_, err = loginUC.Execute(ctx, &LoginRequest{Email: "user" + string(rune('a'+i)) + "@email.com", Password: "wrong", IP: "10.0.0.2"})
+		}
+		assert.Equal(t, ErrInvalidCredentials, err, "Expected the last failure to still report invalid credentials")
+
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "password", IP: "10.0.0.2"})
+		assert.Equal(t, ErrTooManyLoginAttempts, err, "Expected the IP to be throttled across accounts")
+	})
+	t.Run("RecordFailure_Concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < loginUC.Throttle.AccountLockoutAfter; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := loginUC.Throttle.recordFailure(ctx, attemptRepo, "account:race@email.com", loginUC.Throttle.FreeAttempts, loginUC.Throttle.AccountLockoutAfter, now)
+				assert.NoError(t, err, "Erro ao registrar a falha")
+			}()
+		}
+		wg.Wait()
+
+		err := loginUC.checkThrottle(ctx, "account:race@email.com", "", now)
+		assert.Equal(t, ErrAccountLocked, err, "Falhas simultâneas devem somar até travar a conta")
+	})
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrTransferLimitExceeded = errors.New("transfer exceeds the per-operation limit")
+
+// MachineFloatPolicy limita as recargas e recolhimentos das máquinas.
+// MinFloat é o saldo que a máquina deve manter após um recolhimento.
+type MachineFloatPolicy struct {
+	MaxTransfer int
+	MinFloat    int
+}
+
+func DefaultMachineFloatPolicy() MachineFloatPolicy {
+	return MachineFloatPolicy{
+		MaxTransfer: 1000000,
+		MinFloat:    0,
+	}
+}
+
+// MachineTransferRequest é usado tanto na recarga quanto no recolhimento.
+type MachineTransferRequest struct {
+	MachineID string `json:"-"`
+	Amount    int    `json:"amount"`
+	Reason    string `json:"reason"`
+}
+
+// transferMachineFunds valida a operação, move os fundos entre a tesouraria e
+// a máquina e registra a auditoria com os saldos antes e depois, na mesma
+// transação.
+func transferMachineFunds(ctx context.Context, treasuryRepo repository.TreasuryRepository, slotRepo repository.SlotMachineRepository, auditRepo repository.AuditRepository, transactor ports.Transactor, policy MachineFloatPolicy, movementType model.TreasuryMovementType, req *MachineTransferRequest, now time.Time) (*model.TreasuryMovement, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if req.Amount <= 0 || reason == "" {
+		return nil, ErrValidate
+	}
+	if policy.MaxTransfer > 0 && req.Amount > policy.MaxTransfer {
+		return nil, ErrTransferLimitExceeded
+	}
+
+	machine, err := slotRepo.GetSlotMachine(ctx, req.MachineID)
+	if err != nil {
+		return nil, err
+	}
+	treasury, err := treasuryRepo.GetTreasury(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, _ := ctx.Value(contextkeys.ContextKeyUserID).(string)
+	movement := &model.TreasuryMovement{
+		ID:        uuid.New().String(),
+		Type:      movementType,
+		MachineID: machine.ID,
+		Amount:    req.Amount,
+		Reason:    reason,
+		Actor:     actor,
+		CreatedAt: now,
+	}
+
+	action := AuditActionMachineRefill
+	if movementType == model.MovementCashout {
+		action = AuditActionMachineCashout
+	}
+
+	before := map[string]int{"machine_balance": machine.Balance, "treasury_balance": treasury.Balance}
+	err = transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := treasuryRepo.ApplyMovement(ctx, movement, policy.MinFloat); err != nil {
+			return err
+		}
+		after := map[string]int{"machine_balance": movement.MachineBalance, "treasury_balance": movement.TreasuryBalance}
+		return recordAudit(ctx, auditRepo, action, "machine", machine.ID, before, after, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return movement, nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import "slot-machine/internal/domain/model"
+
+// NearMissPolicy lista as jurisdições que permitem apresentar quase
+// acertos. Sem jurisdições, nenhuma máquina os exibe, qualquer que seja o
+// modo configurado nela.
+type NearMissPolicy struct {
+	Jurisdictions []string
+}
+
+// Allows indica se a jogada na máquina deve trazer o quase acerto.
+func (p NearMissPolicy) Allows(machine *model.SlotMachine) bool {
+	if !machine.NearMiss.Enabled() || machine.Jurisdiction == "" {
+		return false
+	}
+	for _, jurisdiction := range p.Jurisdictions {
+		if jurisdiction == machine.Jurisdiction {
+			return true
+		}
+	}
+	return false
+}
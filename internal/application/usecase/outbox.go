@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// recordDomainEvent grava o evento no outbox. Deve receber o contexto da
+// transação que altera o estado, para que o evento só exista se a mudança
+// for confirmada.
+func recordDomainEvent(ctx context.Context, repo repository.OutboxRepository, eventType model.DomainEventType, aggregateType, aggregateID string, payload any, now time.Time) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return repo.AddEvent(ctx, &model.DomainEvent{
+		ID:            uuid.New().String(),
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       data,
+		OccurredAt:    now,
+	})
+}
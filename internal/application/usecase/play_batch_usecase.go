@@ -0,0 +1,151 @@
+package usecase
+
+import "context"
+
+const maxBatchSpins = 100
+
+// BatchStopReason explica por que a sequência de jogadas terminou.
+type BatchStopReason string
+
+const (
+	BatchStopCompleted    BatchStopReason = "completed"
+	BatchStopWin          BatchStopReason = "win"
+	BatchStopBalance      BatchStopReason = "balance"
+	BatchStopJackpot      BatchStopReason = "jackpot"
+	BatchStopRealityCheck BatchStopReason = "reality_check"
+	BatchStopLimit        BatchStopReason = "limit"
+	BatchStopError        BatchStopReason = "error"
+)
+
+type PlayBatchUseCase struct {
+	PlayUseCase *PlayUseCase
+}
+
+// PlayBatchRequest executa até Spins jogadas com a mesma aposta de
+// PlayRequest. As condições de parada valem a partir do valor informado:
+// StopOnWinAbove encerra após uma jogada que pague mais que ele,
+// StopOnBalanceBelow quando o saldo ficar abaixo dele e StopOnJackpot após
+// qualquer jackpot.
+type PlayBatchRequest struct {
+	PlayerID           string `json:"-"`
+	MachineID          string `json:"machine_id"`
+	AmountBet          int    `json:"amount_bet"`
+	Lines              int    `json:"lines,omitempty"`
+	Spins              int    `json:"spins"`
+	StopOnWinAbove     int    `json:"stop_on_win_above,omitempty"`
+	StopOnBalanceBelow int    `json:"stop_on_balance_below,omitempty"`
+	StopOnJackpot      bool   `json:"stop_on_jackpot,omitempty"`
+}
+
+// BatchTotals soma as jogadas executadas. TotalBet considera apenas o valor
+// cobrado, sem as rodadas grátis; TotalWon inclui os jackpots.
+type BatchTotals struct {
+	Spins    int `json:"spins"`
+	TotalBet int `json:"total_bet"`
+	TotalWon int `json:"total_won"`
+	Net      int `json:"net"`
+}
+
+// PlayBatchResponse traz cada jogada na ordem em que foi liquidada. Quando
+// um limite de jogo ou o saldo impede uma jogada depois da primeira, a
+// sequência termina com StopReason "limit" e Error descreve o motivo; outras
+// falhas depois da primeira jogada terminam com StopReason "error".
+type PlayBatchResponse struct {
+	Spins         []*PlayResponse `json:"spins"`
+	Totals        BatchTotals     `json:"totals"`
+	StopReason    BatchStopReason `json:"stop_reason"`
+	Error         string          `json:"error,omitempty"`
+	PlayerBalance int             `json:"player_balance"`
+}
+
+func NewPlayBatchUseCase(playUC *PlayUseCase) *PlayBatchUseCase {
+	return &PlayBatchUseCase{
+		PlayUseCase: playUC,
+	}
+}
+
+// Execute liquida cada jogada por PlayUseCase, com todas as verificações de
+// uma jogada avulsa. Cada jogada tem a sua própria transação: uma falha no
+// meio da sequência não deixa jogada pela metade nem desfaz as anteriores,
+// que são devolvidas junto com o motivo da parada. Um erro na primeira jogada
+// é devolvido como em /play.
+func (uc *PlayBatchUseCase) Execute(ctx context.Context, req *PlayBatchRequest) (*PlayBatchResponse, error) {
+	if req.AmountBet <= 0 || req.Spins < 1 || req.Spins > maxBatchSpins || req.StopOnWinAbove < 0 || req.StopOnBalanceBelow < 0 {
+		return nil, ErrValidate
+	}
+
+	playReq := &PlayRequest{
+		PlayerID:  req.PlayerID,
+		MachineID: req.MachineID,
+		AmountBet: req.AmountBet,
+		Lines:     req.Lines,
+	}
+	resp := &PlayBatchResponse{
+		Spins:      make([]*PlayResponse, 0, req.Spins),
+		StopReason: BatchStopCompleted,
+	}
+	for len(resp.Spins) < req.Spins {
+		spin, err := uc.PlayUseCase.Execute(ctx, playReq)
+		if err != nil {
+			if len(resp.Spins) == 0 {
+				return nil, err
+			}
+			resp.StopReason = BatchStopError
+			if isPlayLimitError(err) {
+				resp.StopReason = BatchStopLimit
+			}
+			resp.Error = err.Error()
+			break
+		}
+
+		won := 0
+		for _, lineWin := range spin.LineWins {
+			won += lineWin.Payout
+		}
+		if spin.Jackpot != nil {
+			won += spin.Jackpot.Amount
+		}
+		resp.Spins = append(resp.Spins, spin)
+		resp.Totals.Spins++
+		if !spin.FreeSpin {
+			resp.Totals.TotalBet += spin.TotalBet
+		}
+		resp.Totals.TotalWon += won
+		resp.PlayerBalance = spin.PlayerBalance
+
+		if reason, stop := batchStop(req, spin, won); stop {
+			resp.StopReason = reason
+			break
+		}
+	}
+	resp.Totals.Net = resp.Totals.TotalWon - resp.Totals.TotalBet
+
+	return resp, nil
+}
+
+// batchStop verifica as condições de parada após uma jogada. O reality
+// check sempre interrompe a sequência, já que a próxima jogada seria
+// recusada até a confirmação.
+func batchStop(req *PlayBatchRequest, spin *PlayResponse, won int) (BatchStopReason, bool) {
+	switch {
+	case spin.RealityCheck != nil:
+		return BatchStopRealityCheck, true
+	case req.StopOnJackpot && spin.Jackpot != nil:
+		return BatchStopJackpot, true
+	case req.StopOnWinAbove > 0 && won > req.StopOnWinAbove:
+		return BatchStopWin, true
+	case req.StopOnBalanceBelow > 0 && spin.PlayerBalance < req.StopOnBalanceBelow:
+		return BatchStopBalance, true
+	}
+	return "", false
+}
+
+// isPlayLimitError indica os erros que encerram a sequência sem invalidar
+// as jogadas já liquidadas.
+func isPlayLimitError(err error) bool {
+	switch err {
+	case ErrInsufficientBalance, ErrLossLimitExceeded, ErrWagerLimitExceeded, ErrSessionLimitReached, ErrSelfExcluded:
+		return true
+	}
+	return false
+}
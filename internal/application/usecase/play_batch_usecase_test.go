@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayBatchUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	limitRepo := repository_in_memory.NewInMemoryGamblingLimitRepository()
+
+	playUC := NewPlayUseCase(playerRepo, slotRepo, repository_in_memory.NewInMemoryTransactionRepository(), limitRepo,
+		repository_in_memory.NewInMemoryPlaySessionRepository(), repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	playUC.rng = rand.New(rand.NewSource(1))
+	playUC.now = func() time.Time { return time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC) }
+	batchUC := NewPlayBatchUseCase(playUC)
+
+	player := &model.Player{ID: "player1", Balance: 1000, EmailVerified: true}
+	err := playerRepo.CreatePlayer(ctx, player)
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	machine := &model.SlotMachine{
+		ID:           "machine1",
+		MultipleGain: 2,
+		Balance:      100000,
+		Strips:       fixedStrips("A", "B", "C"),
+	}
+	err = slotRepo.CreateSlotMachine(ctx, machine)
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	t.Run("Execute_Completed", func(t *testing.T) {
+		resp, err := batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Spins: 5})
+		assert.NoError(t, err, "Esperava-se nenhum erro nas jogadas")
+		assert.Equal(t, BatchStopCompleted, resp.StopReason)
+		assert.Len(t, resp.Spins, 5)
+		assert.Equal(t, BatchTotals{Spins: 5, TotalBet: 50, TotalWon: 0, Net: -50}, resp.Totals)
+		assert.Equal(t, 950, resp.PlayerBalance)
+	})
+
+	t.Run("Execute_StopOnWinAbove", func(t *testing.T) {
+		machine.Strips = fixedStrips("A", "A", "A")
+		defer func() { machine.Strips = fixedStrips("A", "B", "C") }()
+
+		resp, err := batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Spins: 10, StopOnWinAbove: 20})
+		assert.NoError(t, err, "Esperava-se nenhum erro nas jogadas")
+		assert.Equal(t, BatchStopWin, resp.StopReason)
+		assert.Len(t, resp.Spins, 1, "A primeira vitória de 30 deveria encerrar a sequência")
+		assert.Equal(t, BatchTotals{Spins: 1, TotalBet: 10, TotalWon: 30, Net: 20}, resp.Totals)
+	})
+
+	t.Run("Execute_StopOnBalanceBelow", func(t *testing.T) {
+		resp, err := batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Spins: 10, StopOnBalanceBelow: 950})
+		assert.NoError(t, err, "Esperava-se nenhum erro nas jogadas")
+		assert.Equal(t, BatchStopBalance, resp.StopReason)
+		assert.Len(t, resp.Spins, 3)
+		assert.Equal(t, 940, resp.PlayerBalance)
+	})
+
+	t.Run("Execute_LimitStopsBatch", func(t *testing.T) {
+		// As sequências anteriores já apostaram 90 no dia.
+		err := limitRepo.SaveGamblingLimit(ctx, &model.GamblingLimit{PlayerID: "player1", Type: model.LimitWager, Period: model.PeriodDaily, Amount: 115})
+		assert.NoError(t, err)
+
+		resp, err := batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Spins: 10})
+		assert.NoError(t, err, "As jogadas liquidadas antes do limite devem ser devolvidas")
+		assert.Equal(t, BatchStopLimit, resp.StopReason)
+		assert.Equal(t, ErrWagerLimitExceeded.Error(), resp.Error)
+		assert.Len(t, resp.Spins, 2)
+
+		_, err = batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Spins: 10})
+		assert.Equal(t, ErrWagerLimitExceeded, err, "Sem nenhuma jogada possível, o erro é devolvido")
+	})
+
+	t.Run("Execute_InvalidSpins", func(t *testing.T) {
+		for _, spins := range []int{0, maxBatchSpins + 1} {
+			_, err := batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Spins: spins})
+			assert.Equal(t, ErrValidate, err)
+		}
+	})
+
+	t.Run("Execute_InvalidBet", func(t *testing.T) {
+		for _, bet := range []int{0, -10} {
+			_, err := batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: bet, Spins: 10})
+			assert.Equal(t, ErrValidate, err, "Esperava-se ErrValidate para aposta não positiva")
+		}
+	})
+
+	t.Run("Execute_OneTransactionPerSpin", func(t *testing.T) {
+		transactor := &countingTransactor{Transactor: playUC.Transactor}
+		playUC.Transactor = transactor
+		defer func() { playUC.Transactor = transactor.Transactor }()
+
+		err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player2", Balance: 1000, EmailVerified: true})
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+		_, err = batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player2", MachineID: "machine1", AmountBet: 10, Spins: 3})
+		assert.NoError(t, err, "Esperava-se nenhum erro nas jogadas")
+		assert.Equal(t, 3, transactor.count, "Cada jogada deveria ser liquidada na sua própria transação")
+		assert.Equal(t, 1, transactor.maxDepth, "As transações das jogadas não deveriam ser aninhadas")
+
+		player, _ := playerRepo.GetPlayer(ctx, "player2")
+		assert.Equal(t, 970, player.Balance)
+	})
+
+	t.Run("Execute_ErrorStopsBatch", func(t *testing.T) {
+		transactor := &failingTransactor{Transactor: playUC.Transactor, failFrom: 3, err: errors.New("connection reset")}
+		playUC.Transactor = transactor
+		defer func() { playUC.Transactor = transactor.Transactor }()
+
+		err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player3", Balance: 1000, EmailVerified: true})
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+		resp, err := batchUC.Execute(ctx, &PlayBatchRequest{PlayerID: "player3", MachineID: "machine1", AmountBet: 10, Spins: 10})
+		assert.NoError(t, err, "As jogadas liquidadas antes da falha devem ser devolvidas")
+		assert.Equal(t, BatchStopError, resp.StopReason)
+		assert.Equal(t, "connection reset", resp.Error)
+		assert.Len(t, resp.Spins, 2)
+		assert.Equal(t, 980, resp.PlayerBalance)
+	})
+}
+
+// failingTransactor devolve err a partir da transação de número failFrom.
+type failingTransactor struct {
+	ports.Transactor
+	count    int
+	failFrom int
+	err      error
+}
+
+func (f *failingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.count++
+	if f.count >= f.failFrom {
+		return f.err
+	}
+	return f.Transactor.WithinTransaction(ctx, fn)
+}
+
+// countingTransactor conta as transações abertas e o maior aninhamento entre
+// elas.
+type countingTransactor struct {
+	ports.Transactor
+	count    int
+	depth    int
+	maxDepth int
+}
+
+func (c *countingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	c.count++
+	c.depth++
+	if c.depth > c.maxDepth {
+		c.maxDepth = c.depth
+	}
+	defer func() { c.depth-- }()
+	return c.Transactor.WithinTransaction(ctx, fn)
+}
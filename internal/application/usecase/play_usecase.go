@@ -5,116 +5,409 @@ import (
 	"errors"
 	"math/rand"
 	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
 	"slot-machine/internal/domain/repository"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 var (
 	ErrInsufficientBalance = errors.New("insufficient balance")
 	ErrSlotMachineNotFound = errors.New("slot machine not found")
+	ErrEmailNotVerified    = errors.New("email not verified")
+	ErrRealityCheckPending = errors.New("reality check must be acknowledged")
 )
 
 type PlayUseCase struct {
-	PlayerRepo      repository.PlayerRepository
-	SlotMachineRepo repository.SlotMachineRepository
-	rng             *rand.Rand
+	PlayerRepo        repository.PlayerRepository
+	SlotMachineRepo   repository.SlotMachineRepository
+	TransactionRepo   repository.TransactionRepository
+	GamblingLimitRepo repository.GamblingLimitRepository
+	PlaySessionRepo   repository.PlaySessionRepository
+	SelfExclusionRepo repository.SelfExclusionRepository
+	SpinRepo          repository.SpinRepository
+	JackpotRepo       repository.JackpotRepository
+	FreeSpinRepo      repository.FreeSpinRepository
+	OutboxRepo        repository.OutboxRepository
+	Transactor        ports.Transactor
+	// SessionIdleTimeout é a pausa que encerra a sessão de jogo atual.
+	SessionIdleTimeout time.Duration
+	// NearMiss define onde a apresentação de quase acertos é permitida.
+	NearMiss NearMissPolicy
+	// Events recebe o novo saldo do jogador, as vitórias grandes (a partir
+	// de BigWinMultiple vezes a aposta total) e o valor do jackpot.
+	Events         ports.EventPublisher
+	BigWinMultiple int
+	// TournamentRepo, quando definido, pontua a jogada nos torneios em
+	// andamento na máquina.
+	TournamentRepo repository.TournamentRepository
+	rng            *rand.Rand
+	now            func() time.Time
 }
 
+// PlayRequest aposta AmountBet em cada uma das Lines primeiras linhas de
+// pagamento da máquina; sem Lines, todas são jogadas. A aposta total é
+// AmountBet × Lines.
 type PlayRequest struct {
 	PlayerID  string `json:"-"`
 	MachineID string `json:"machine_id"`
 	AmountBet int    `json:"amount_bet"`
+	Lines     int    `json:"lines,omitempty"`
 }
 
+// PlayResponse traz RealityCheck quando o intervalo escolhido pelo jogador
+// termina; a próxima jogada só é aceita depois da confirmação. Jackpot vem
+// preenchido quando a jogada ganha o jackpot progressivo da máquina.
+// FreeSpin indica que a jogada usou uma rodada grátis, sem cobrar a aposta;
+// Bonus resume a sequência de rodadas grátis em andamento ou recém-concedida.
+// NearMiss, só em jogadas sem prêmio e onde a jurisdição da máquina permite,
+// mostra os símbolos das fitas logo acima e abaixo da grade.
 type PlayResponse struct {
-	Result             [3]string `json:"result"`
-	Win                bool      `json:"win"`
-	PlayerBalance      int       `json:"player_balance"`
-	SlotMachineBalance int       `json:"slot_machine_balance"`
+	Result             model.Grid          `json:"result"`
+	Win                bool                `json:"win"`
+	Lines              int                 `json:"lines"`
+	TotalBet           int                 `json:"total_bet"`
+	LineWins           []model.LineWin     `json:"line_wins,omitempty"`
+	PlayerBalance      int                 `json:"player_balance"`
+	SlotMachineBalance int                 `json:"slot_machine_balance"`
+	RealityCheck       *model.RealityCheck `json:"reality_check,omitempty"`
+	Jackpot            *model.JackpotWin   `json:"jackpot,omitempty"`
+	FreeSpin           bool                `json:"free_spin"`
+	FreeSpinsRemaining int                 `json:"free_spins_remaining"`
+	Bonus              *BonusRoundSummary  `json:"bonus,omitempty"`
+	NearMiss           *model.NearMissPeek `json:"near_miss,omitempty"`
+}
+
+// BonusRoundSummary traz as rodadas grátis concedidas pela jogada e os
+// totais da sequência atual.
+type BonusRoundSummary struct {
+	FreeSpinsAwarded int `json:"free_spins_awarded"`
+	TotalFreeSpins   int `json:"total_free_spins"`
+	Multiplier       int `json:"multiplier"`
+	TotalWon         int `json:"total_won"`
 }
 
-func NewPlayUseCase(playerRepo repository.PlayerRepository, slotRepo repository.SlotMachineRepository) *PlayUseCase {
+func NewPlayUseCase(playerRepo repository.PlayerRepository, slotRepo repository.SlotMachineRepository, txRepo repository.TransactionRepository, limitRepo repository.GamblingLimitRepository, sessionRepo repository.PlaySessionRepository, exclusionRepo repository.SelfExclusionRepository, spinRepo repository.SpinRepository, jackpotRepo repository.JackpotRepository, freeSpinRepo repository.FreeSpinRepository, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *PlayUseCase {
 	return &PlayUseCase{
-		PlayerRepo:      playerRepo,
-		SlotMachineRepo: slotRepo,
-		rng:             rand.New(rand.NewSource(time.Now().UnixNano())),
+		PlayerRepo:         playerRepo,
+		SlotMachineRepo:    slotRepo,
+		TransactionRepo:    txRepo,
+		GamblingLimitRepo:  limitRepo,
+		PlaySessionRepo:    sessionRepo,
+		SelfExclusionRepo:  exclusionRepo,
+		SpinRepo:           spinRepo,
+		JackpotRepo:        jackpotRepo,
+		FreeSpinRepo:       freeSpinRepo,
+		OutboxRepo:         outboxRepo,
+		Transactor:         transactor,
+		SessionIdleTimeout: defaultSessionIdleTimeout,
+		BigWinMultiple:     defaultBigWinMultiple,
+		rng:                rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:                time.Now,
 	}
 }
 
+// Execute liquida a jogada em uma única transação: saldo, máquina, sessão,
+// rodadas grátis, jackpot, transações, o registro do giro e os eventos de
+// domínio do outbox são confirmados juntos ou descartados juntos. Os eventos
+// em tempo real só são publicados depois da confirmação.
 func (uc *PlayUseCase) Execute(ctx context.Context, req *PlayRequest) (*PlayResponse, error) {
-	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	var (
+		resp   *PlayResponse
+		events []model.Event
+	)
+	err := uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, events, err = uc.play(ctx, req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	publishEvents(uc.Events, events)
+	return resp, nil
+}
 
-	machine, err := uc.SlotMachineRepo.GetSlotMachine(ctx, req.MachineID)
+func (uc *PlayUseCase) play(ctx context.Context, req *PlayRequest) (*PlayResponse, []model.Event, error) {
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if player.Balance < req.AmountBet {
-		return nil, ErrInsufficientBalance
+	if !player.EmailVerified {
+		return nil, nil, ErrEmailNotVerified
 	}
 
-	result := uc.generateFinalResult(machine)
+	now := uc.now()
+	if err := guardSelfExclusion(ctx, uc.SelfExclusionRepo, player.ID, now); err != nil {
+		return nil, nil, err
+	}
 
-	win := uc.checkResultUser(result)
+	machine, err := uc.SlotMachineRepo.GetSlotMachine(ctx, req.MachineID)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if win {
-		player.Balance += req.AmountBet * machine.MultipleGain
-		machine.Balance -= req.AmountBet * machine.MultipleGain
-	} else {
-		player.Balance -= req.AmountBet
-		machine.Balance += req.AmountBet
+	freeSpins, err := uc.FreeSpinRepo.GetFreeSpins(ctx, player.ID, machine.ID)
+	if err == repository.ErrFreeSpinsNotFound {
+		freeSpins = &model.FreeSpins{PlayerID: player.ID, MachineID: machine.ID}
+	} else if err != nil {
+		return nil, nil, err
+	}
+	// Rodadas grátis são consumidas antes de qualquer cobrança e usam a
+	// aposta da jogada que as concedeu.
+	freeSpin := freeSpins.Active()
+	lineBet, lines, multiplier := req.AmountBet, req.Lines, 1
+	if freeSpin {
+		lineBet, lines, multiplier = freeSpins.Bet, freeSpins.Lines, freeSpins.Multiplier
+	} else if lineBet <= 0 {
+		return nil, nil, ErrValidate
+	}
+	paylines := machine.EffectivePaylines()
+	if lines == 0 {
+		lines = len(paylines)
+	}
+	if lines < 0 || lines > len(paylines) {
+		return nil, nil, ErrValidate
+	}
+	totalBet := lineBet * lines
+	wagered := totalBet
+	if freeSpin {
+		wagered = 0
 	}
 
-	if err := uc.PlayerRepo.UpdatePlayer(ctx, player); err != nil {
-		return nil, err
+	if player.Balance < wagered {
+		return nil, nil, ErrInsufficientBalance
 	}
-	if err := uc.SlotMachineRepo.UpdateSlotMachine(ctx, machine); err != nil {
-		return nil, err
+
+	// Os limites de aposta e perda são somados com o jogador bloqueado até o
+	// fim da transação, para que jogadas simultâneas não passem juntas por um
+	// limite que só cabe uma delas.
+	if err := uc.PlayerRepo.LockPlayer(ctx, player.ID); err != nil {
+		return nil, nil, err
+	}
+	limits, err := loadGamblingLimits(ctx, uc.GamblingLimitRepo, player.ID, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !freeSpin {
+		if err := checkBetLimits(ctx, uc.TransactionRepo, limits, player.ID, wagered, now); err != nil {
+			return nil, nil, err
+		}
+	}
+	session, err := trackPlaySession(ctx, uc.PlaySessionRepo, limits, player.ID, uc.SessionIdleTimeout, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.RealityCheckPending {
+		return nil, nil, ErrRealityCheckPending
 	}
 
-	return &PlayResponse{
-		Result:             result,
-		Win:                win,
-		PlayerBalance:      player.Balance,
-		SlotMachineBalance: machine.Balance,
-	}, nil
-}
+	// O saldo do jogador só muda por diferenças: a aposta é debitada antes do
+	// sorteio, sem deixar que jogadas simultâneas gastem o mesmo saldo, e o
+	// prêmio é creditado depois.
+	balance := player.Balance
+	if wagered > 0 {
+		balance, err = uc.PlayerRepo.AdjustBalance(ctx, player.ID, -wagered)
+		if err == repository.ErrNegativeBalance {
+			return nil, nil, ErrInsufficientBalance
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// O resultado vem apenas das fitas; o quase acerto só revela os símbolos
+	// vizinhos das paradas já sorteadas.
+	stops := machine.Stops(uc.rng.Intn)
+	result := machine.Window(stops)
 
-func (uc *PlayUseCase) generateFinalResult(machine *model.SlotMachine) [3]string {
-	permutation := machine.Permutations[uc.rng.Intn(len(machine.Permutations))]
-	result := permutation
+	lineWins := machine.EvaluateLines(result, lines, lineBet)
+	win := len(lineWins) > 0
 
-	if len(unique(result[:])) == 3 && uc.rng.Intn(6) >= 2 {
-		if uc.rng.Intn(2) == 0 {
-			result[1] = result[0]
-		} else {
-			result[2] = result[1]
+	payout := 0
+	for i := range lineWins {
+		lineWins[i].Payout *= multiplier
+		payout += lineWins[i].Payout
+	}
+
+	var bonus *BonusRoundSummary
+	if freeSpin {
+		freeSpins.Consume(payout, now)
+	}
+	if award, ok := machine.Bonus.Award(result); ok {
+		freeSpins.Grant(award, lineBet, lines, now)
+		bonus = &BonusRoundSummary{FreeSpinsAwarded: award.FreeSpins}
+	}
+	if freeSpin || bonus != nil {
+		if bonus == nil {
+			bonus = &BonusRoundSummary{}
 		}
+		bonus.TotalFreeSpins = freeSpins.Awarded
+		bonus.Multiplier = freeSpins.Multiplier
+		bonus.TotalWon = freeSpins.TotalWon
 	}
-	return result
-}
 
-func unique(slice []string) []string {
-	uniqueMap := make(map[string]struct{})
-	for _, item := range slice {
-		uniqueMap[item] = struct{}{}
+	pool, err := uc.JackpotRepo.GetMachinePool(ctx, machine.ID)
+	if err != nil && err != repository.ErrJackpotPoolNotFound {
+		return nil, nil, err
 	}
-	keys := make([]string, 0, len(uniqueMap))
-	for k := range uniqueMap {
-		keys = append(keys, k)
+	contribution := 0
+	var jackpot *model.JackpotWin
+	if pool != nil {
+		contribution = pool.Contribution(wagered)
+		// Só concorre ao jackpot a jogada que foi paga ou uma rodada grátis
+		// de verdade.
+		if (wagered > 0 || freeSpin) && pool.Triggered(result, paylines[:lines], uc.rng.Intn) {
+			jackpot = &model.JackpotWin{
+				ID:        uuid.New().String(),
+				PoolID:    pool.ID,
+				PlayerID:  player.ID,
+				MachineID: machine.ID,
+				CreatedAt: now,
+			}
+		}
+		// O valor do jackpot só é conhecido aqui, com o pool travado.
+		if pool, err = uc.JackpotRepo.Contribute(ctx, pool.ID, contribution, jackpot); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	session.Spins++
+	session.Wagered += wagered
+	session.Won += payout
+	if jackpot != nil {
+		session.Won += jackpot.Amount
 	}
-	return keys
-}
 
-func (uc *PlayUseCase) checkResultUser(result [3]string) bool {
-	first := result[0]
-	for _, sym := range result[1:] {
-		if sym != first {
-			return false
+	var realityCheck *model.RealityCheck
+	if session.RealityCheckDue(time.Duration(player.RealityCheckMinutes)*time.Minute, now) {
+		check := session.RealityCheck(now)
+		realityCheck = &check
+		session.RealityCheckPending = true
+		session.LastRealityCheckAt = now
+	}
+
+	won := payout
+	if jackpot != nil {
+		won += jackpot.Amount
+	}
+	if won > 0 {
+		if balance, err = uc.PlayerRepo.AdjustBalance(ctx, player.ID, won); err != nil {
+			return nil, nil, err
+		}
+	}
+	// A máquina recebe só a diferença desta jogada, para não desfazer
+	// recargas feitas enquanto ela era liquidada.
+	machineBalance, err := uc.SlotMachineRepo.AdjustSlotMachineBalance(ctx, machine.ID, wagered-payout-contribution)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := uc.PlaySessionRepo.SavePlaySession(ctx, session); err != nil {
+		return nil, nil, err
+	}
+	if bonus != nil {
+		if err := uc.FreeSpinRepo.SaveFreeSpins(ctx, freeSpins); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !freeSpin {
+		if err := recordTransaction(ctx, uc.TransactionRepo, player.ID, model.TransactionBet, wagered, machine.ID, now); err != nil {
+			return nil, nil, err
+		}
+	}
+	if win {
+		if err := recordTransaction(ctx, uc.TransactionRepo, player.ID, model.TransactionWin, payout, machine.ID, now); err != nil {
+			return nil, nil, err
+		}
+	}
+	if jackpot != nil {
+		if err := recordTransaction(ctx, uc.TransactionRepo, player.ID, model.TransactionJackpotWin, jackpot.Amount, machine.ID, now); err != nil {
+			return nil, nil, err
 		}
 	}
-	return true
+	spin := &model.Spin{
+		ID:                  uuid.New().String(),
+		PlayerID:            player.ID,
+		MachineID:           machine.ID,
+		Bet:                 wagered,
+		Payout:              payout,
+		JackpotContribution: contribution,
+		Result:              result.Flatten(),
+		CreatedAt:           now,
+	}
+	if pool != nil {
+		spin.JackpotPoolID = pool.ID
+	}
+	if jackpot != nil {
+		spin.JackpotWin = jackpot.Amount
+	}
+	if err := uc.SpinRepo.RecordSpin(ctx, spin); err != nil {
+		return nil, nil, err
+	}
+	if uc.TournamentRepo != nil {
+		if err := scoreTournaments(ctx, uc.TournamentRepo, player.ID, machine.ID, totalBet, wagered, payout, now); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventSpinSettled, "spin", spin.ID, model.SpinSettledPayload{
+		SpinID:     spin.ID,
+		PlayerID:   player.ID,
+		MachineID:  machine.ID,
+		Bet:        wagered,
+		Payout:     payout,
+		JackpotWin: spin.JackpotWin,
+		FreeSpin:   freeSpin,
+		Result:     spin.Result,
+		Balance:    balance,
+	}, now); err != nil {
+		return nil, nil, err
+	}
+	if jackpot != nil {
+		if err := recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventJackpotWon, "jackpot", pool.ID, model.JackpotWonPayload{
+			JackpotWinID: jackpot.ID,
+			PoolID:       pool.ID,
+			PlayerID:     player.ID,
+			MachineID:    machine.ID,
+			Amount:       jackpot.Amount,
+		}, now); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	events := []model.Event{newEvent(model.EventBalanceUpdated, player.ID, model.BalanceUpdate{
+		Balance: balance,
+		Delta:   won - wagered,
+		Reason:  model.BalanceReasonPlay,
+	}, now)}
+	if payout > 0 && won >= uc.BigWinMultiple*totalBet {
+		events = append(events, newEvent(model.EventBigWin, "", model.BigWin{MachineID: machine.ID, Bet: totalBet, Payout: won}, now))
+	}
+	if pool != nil {
+		events = append(events, newEvent(model.EventJackpotUpdated, "", jackpotUpdate(pool, jackpot), now))
+	}
+
+	var nearMiss *model.NearMissPeek
+	if !win && uc.NearMiss.Allows(machine) {
+		peek := machine.Peek(stops)
+		nearMiss = &peek
+	}
+
+	return &PlayResponse{
+		Result:             result,
+		Win:                win,
+		Lines:              lines,
+		TotalBet:           totalBet,
+		LineWins:           lineWins,
+		PlayerBalance:      balance,
+		SlotMachineBalance: machineBalance,
+		RealityCheck:       realityCheck,
+		Jackpot:            jackpot,
+		FreeSpin:           freeSpin,
+		FreeSpinsRemaining: freeSpins.Remaining,
+		Bonus:              bonus,
+		NearMiss:           nearMiss,
+	}, events, nil
 }
@@ -17,15 +17,20 @@ func TestPlayUseCase(t *testing.T) {
 	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
 	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
 
-	playUC := NewPlayUseCase(playerRepo, slotRepo)
+	txRepo := repository_in_memory.NewInMemoryTransactionRepository()
+	limitRepo := repository_in_memory.NewInMemoryGamblingLimitRepository()
+	sessionRepo := repository_in_memory.NewInMemoryPlaySessionRepository()
+
+	playUC := NewPlayUseCase(playerRepo, slotRepo, txRepo, limitRepo, sessionRepo, repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemorySpinRepository(), repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
 
 	// Cria um RNG com seed fixa para testes
 	fixedSeed := int64(42)
 	playUC.rng = rand.New(rand.NewSource(fixedSeed))
 
 	player := &model.Player{
-		ID:      "player1",
-		Balance: 1000,
+		ID:            "player1",
+		Balance:       1000,
+		EmailVerified: true,
 	}
 	err := playerRepo.CreatePlayer(ctx, player)
 	assert.NoError(t, err, "Erro ao criar jogador para testes")
@@ -34,17 +39,14 @@ func TestPlayUseCase(t *testing.T) {
 		ID:           "machine1",
 		MultipleGain: 2,
 		Balance:      5000,
-		Permutations: [][3]string{
-			{"A", "B", "C"}, // sem vitória
-			{"A", "A", "A"}, // vitória
-		},
+		Strips:       fixedStrips("A", "B", "C"),
 	}
 	err = slotRepo.CreateSlotMachine(ctx, machine)
 	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
 
 	t.Run("Execute_Success_Win", func(t *testing.T) {
-		// Ajusta o RNG para escolher a segunda permutação (vitória)
-		playUC.rng = rand.New(rand.NewSource(1)) // Escolhe index 1
+		// Fitas de um único símbolo garantem a vitória
+		machine.Strips = fixedStrips("A", "A", "A")
 
 		req := &PlayRequest{
 			PlayerID:  "player1",
@@ -58,7 +60,7 @@ func TestPlayUseCase(t *testing.T) {
 		assert.NotNil(t, resp, "Esperava-se uma resposta")
 
 		assert.True(t, resp.Win, "Esperava-se que o jogador ganhasse")
-		assert.Equal(t, [3]string{"A", "A", "A"}, resp.Result, "Esperava-se que todos os símbolos fossem 'A'")
+		assert.Equal(t, model.Grid{{"A", "A", "A"}}, resp.Result, "Esperava-se que todos os símbolos fossem 'A'")
 		assert.Equal(t, 1000+100*2, resp.PlayerBalance, "Saldo do jogador deveria ter sido incrementado corretamente")
 		assert.Equal(t, 5000-100*2, resp.SlotMachineBalance, "Saldo da máquina de slot deveria ter sido decrementado corretamente")
 
@@ -80,8 +82,8 @@ func TestPlayUseCase(t *testing.T) {
 		err = slotRepo.UpdateSlotMachine(ctx, machine)
 		assert.NoError(t, err, "Erro ao resetar saldo da máquina de slot")
 
-		// Ajusta o RNG para escolher a primeira permutação (sem vitória)
-		playUC.rng = rand.New(rand.NewSource(0)) // Escolhe index 0
+		// Fitas sem símbolos repetidos garantem a derrota
+		machine.Strips = fixedStrips("A", "B", "C")
 
 		req := &PlayRequest{
 			PlayerID:  "player1",
@@ -95,7 +97,7 @@ func TestPlayUseCase(t *testing.T) {
 		assert.NotNil(t, resp, "Esperava-se uma resposta")
 
 		assert.False(t, resp.Win, "Esperava-se que o jogador perdesse")
-		assert.Equal(t, [3]string{"A", "B", "C"}, resp.Result, "Esperava-se os símbolos 'A', 'B', 'C'")
+		assert.Equal(t, model.Grid{{"A", "B", "C"}}, resp.Result, "Esperava-se os símbolos 'A', 'B', 'C'")
 		assert.Equal(t, 900, resp.PlayerBalance, "Saldo do jogador deveria ter sido decrementado corretamente")
 		assert.Equal(t, 5100, resp.SlotMachineBalance, "Saldo da máquina de slot deveria ter sido incrementado corretamente")
 
@@ -111,8 +113,9 @@ func TestPlayUseCase(t *testing.T) {
 
 	t.Run("Execute_InsufficientBalance", func(t *testing.T) {
 		playerInsufficient := &model.Player{
-			ID:      "player2",
-			Balance: 50,
+			ID:            "player2",
+			Balance:       50,
+			EmailVerified: true,
 		}
 		err := playerRepo.CreatePlayer(ctx, playerInsufficient)
 		assert.NoError(t, err, "Erro ao criar jogador com saldo insuficiente")
@@ -134,6 +137,35 @@ func TestPlayUseCase(t *testing.T) {
 		assert.Equal(t, 50, updatedPlayer.Balance, "Saldo do jogador deveria permanecer inalterado")
 	})
 
+	t.Run("Execute_InvalidBet", func(t *testing.T) {
+		for _, bet := range []int{0, -100} {
+			resp, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player2", MachineID: "machine1", AmountBet: bet})
+
+			assert.Equal(t, ErrValidate, err, "Esperava-se ErrValidate para aposta não positiva")
+			assert.Nil(t, resp, "Esperava-se nenhuma resposta quando há erro")
+		}
+
+		updatedPlayer, err := playerRepo.GetPlayer(ctx, "player2")
+		assert.NoError(t, err, "Esperava-se encontrar o jogador após tentativa de jogada")
+		assert.Equal(t, 50, updatedPlayer.Balance, "Saldo do jogador deveria permanecer inalterado")
+	})
+
+	t.Run("Execute_StaleBalanceCannotOverspend", func(t *testing.T) {
+		err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player_stale", Balance: 50, EmailVerified: true})
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+		// Simula outra jogada que gastou o saldo depois da leitura.
+		playUC.PlayerRepo = stalePlayerRepository{PlayerRepository: playerRepo, balance: 1000}
+		defer func() { playUC.PlayerRepo = playerRepo }()
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player_stale", MachineID: "machine1", AmountBet: 100})
+		assert.Equal(t, ErrInsufficientBalance, err, "Esperava-se que o débito atômico recusasse o saldo já gasto")
+
+		updatedPlayer, err := playerRepo.GetPlayer(ctx, "player_stale")
+		assert.NoError(t, err, "Esperava-se encontrar o jogador após tentativa de jogada")
+		assert.Equal(t, 50, updatedPlayer.Balance, "Saldo do jogador deveria permanecer inalterado")
+	})
+
 	t.Run("Execute_SlotMachineNotFound", func(t *testing.T) {
 		req := &PlayRequest{
 			PlayerID:  "player1",
@@ -148,6 +180,30 @@ func TestPlayUseCase(t *testing.T) {
 		assert.Nil(t, resp, "Esperava-se nenhuma resposta quando há erro")
 	})
 
+	t.Run("Execute_EmailNotVerified", func(t *testing.T) {
+		unverified := &model.Player{
+			ID:      "player3",
+			Balance: 1000,
+		}
+		err := playerRepo.CreatePlayer(ctx, unverified)
+		assert.NoError(t, err, "Erro ao criar jogador sem email verificado")
+
+		req := &PlayRequest{
+			PlayerID:  "player3",
+			MachineID: "machine1",
+			AmountBet: 100,
+		}
+
+		resp, err := playUC.Execute(ctx, req)
+
+		assert.Equal(t, ErrEmailNotVerified, err, "Esperava-se o erro ErrEmailNotVerified")
+		assert.Nil(t, resp, "Esperava-se nenhuma resposta quando há erro")
+
+		updatedPlayer, err := playerRepo.GetPlayer(ctx, "player3")
+		assert.NoError(t, err, "Esperava-se encontrar o jogador após tentativa de jogada")
+		assert.Equal(t, 1000, updatedPlayer.Balance, "Saldo do jogador deveria permanecer inalterado")
+	})
+
 	t.Run("Execute_PlayerNotFound", func(t *testing.T) {
 		req := &PlayRequest{
 			PlayerID:  "nonexistent_player",
@@ -162,3 +218,362 @@ func TestPlayUseCase(t *testing.T) {
 		assert.Nil(t, resp, "Esperava-se nenhuma resposta quando há erro")
 	})
 }
+
+// stalePlayerRepository devolve o jogador com um saldo desatualizado, como
+// uma leitura feita antes de uma jogada simultânea.
+type stalePlayerRepository struct {
+	repository.PlayerRepository
+	balance int
+}
+
+func (r stalePlayerRepository) GetPlayer(ctx context.Context, id string) (*model.Player, error) {
+	player, err := r.PlayerRepository.GetPlayer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	stale := *player
+	stale.Balance = r.balance
+	return &stale, nil
+}
+
+func TestPlayUseCase_FreeSpins(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	freeSpinRepo := repository_in_memory.NewInMemoryFreeSpinRepository()
+
+	playUC := NewPlayUseCase(playerRepo, slotRepo, repository_in_memory.NewInMemoryTransactionRepository(), repository_in_memory.NewInMemoryGamblingLimitRepository(),
+		repository_in_memory.NewInMemoryPlaySessionRepository(), repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), freeSpinRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	playUC.rng = rand.New(rand.NewSource(1))
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Balance: 1000, EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	machine := &model.SlotMachine{
+		ID:           "machine1",
+		MultipleGain: 2,
+		Balance:      5000,
+		Bonus: &model.BonusFeature{
+			ScatterSymbol: "S",
+			Awards: []model.ScatterAward{
+				{Count: 2, FreeSpins: 2, Multiplier: 2},
+				{Count: 3, FreeSpins: 10, Multiplier: 3},
+			},
+		},
+	}
+	err = slotRepo.CreateSlotMachine(ctx, machine)
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	req := &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 50}
+
+	t.Run("Execute_ScatterAwardsFreeSpins", func(t *testing.T) {
+		machine.Strips = fixedStrips("S", "A", "S")
+
+		resp, err := playUC.Execute(ctx, req)
+		assert.NoError(t, err, "Esperava-se nenhum erro na jogada")
+		assert.False(t, resp.FreeSpin, "A jogada que concede rodadas grátis é paga")
+		assert.Equal(t, 950, resp.PlayerBalance)
+		assert.Equal(t, 2, resp.FreeSpinsRemaining)
+		assert.Equal(t, &BonusRoundSummary{FreeSpinsAwarded: 2, TotalFreeSpins: 2, Multiplier: 2}, resp.Bonus)
+	})
+
+	t.Run("Execute_FreeSpinUsesOriginalBetAndMultiplier", func(t *testing.T) {
+		machine.Strips = fixedStrips("A", "A", "A")
+
+		resp, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 500})
+		assert.NoError(t, err, "Esperava-se nenhum erro na rodada grátis")
+		assert.True(t, resp.FreeSpin)
+		assert.True(t, resp.Win)
+		assert.Equal(t, 950+50*3*2, resp.PlayerBalance, "O prêmio da rodada grátis é multiplicado e a aposta não é cobrada")
+		assert.Equal(t, 5000+50-50*3*2, resp.SlotMachineBalance)
+		assert.Equal(t, 1, resp.FreeSpinsRemaining)
+		assert.Equal(t, &BonusRoundSummary{TotalFreeSpins: 2, Multiplier: 2, TotalWon: 300}, resp.Bonus)
+	})
+
+	t.Run("Execute_LastFreeSpin", func(t *testing.T) {
+		machine.Strips = fixedStrips("A", "A", "B")
+
+		resp, err := playUC.Execute(ctx, req)
+		assert.NoError(t, err, "Esperava-se nenhum erro na rodada grátis")
+		assert.True(t, resp.FreeSpin)
+		assert.False(t, resp.Win)
+		assert.Equal(t, 1250, resp.PlayerBalance, "Uma rodada grátis perdida não altera o saldo")
+		assert.Equal(t, 0, resp.FreeSpinsRemaining)
+		assert.Equal(t, 300, resp.Bonus.TotalWon)
+	})
+
+	t.Run("Execute_ChargesBetAfterFreeSpins", func(t *testing.T) {
+		resp, err := playUC.Execute(ctx, req)
+		assert.NoError(t, err, "Esperava-se nenhum erro na jogada")
+		assert.False(t, resp.FreeSpin)
+		assert.Nil(t, resp.Bonus)
+		assert.Equal(t, 1200, resp.PlayerBalance)
+
+		freeSpins, err := freeSpinRepo.GetFreeSpins(ctx, "player1", "machine1")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, freeSpins.Remaining)
+	})
+}
+
+func TestPlayUseCase_Paylines(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+
+	playUC := NewPlayUseCase(playerRepo, slotRepo, repository_in_memory.NewInMemoryTransactionRepository(), repository_in_memory.NewInMemoryGamblingLimitRepository(),
+		repository_in_memory.NewInMemoryPlaySessionRepository(), repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	playUC.rng = rand.New(rand.NewSource(1))
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Balance: 1000, EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	machine := &model.SlotMachine{
+		ID:           "machine1",
+		MultipleGain: 4,
+		Balance:      5000,
+		Reels:        5,
+		Rows:         3,
+		Paylines:     model.DefaultPaylines(5, 3),
+		Paytable:     []model.LinePay{{Count: 3, Multiplier: 1}, {Count: 5, Multiplier: 4}},
+		Strips:       fixedStrips("A", "A", "A", "B", "C"),
+	}
+	err = slotRepo.CreateSlotMachine(ctx, machine)
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	t.Run("DefaultPaylines", func(t *testing.T) {
+		assert.Equal(t, []model.Payline{
+			{1, 1, 1, 1, 1},
+			{2, 2, 2, 2, 2},
+			{0, 0, 0, 0, 0},
+			{0, 1, 2, 1, 0},
+			{2, 1, 0, 1, 2},
+		}, machine.Paylines)
+		assert.Equal(t, []model.Payline{{0, 0, 0}}, model.DefaultPaylines(3, 1))
+	})
+
+	t.Run("Execute_ChosenLines", func(t *testing.T) {
+		resp, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Lines: 3})
+		assert.NoError(t, err, "Esperava-se nenhum erro na jogada")
+		assert.Len(t, resp.Result, 3, "A grade deveria ter três linhas")
+		assert.Equal(t, []string{"A", "A", "A", "B", "C"}, resp.Result[2])
+		assert.Equal(t, 3, resp.Lines)
+		assert.Equal(t, 30, resp.TotalBet, "A aposta total é a aposta por linha vezes as linhas")
+		assert.True(t, resp.Win)
+		assert.Equal(t, []model.LineWin{
+			{Line: 1, Symbol: "A", Count: 3, Payout: 20},
+			{Line: 2, Symbol: "A", Count: 3, Payout: 20},
+			{Line: 3, Symbol: "A", Count: 3, Payout: 20},
+		}, resp.LineWins)
+		assert.Equal(t, 1000-30+60, resp.PlayerBalance)
+		assert.Equal(t, 5000+30-60, resp.SlotMachineBalance)
+	})
+
+	t.Run("Execute_AllLinesByDefault", func(t *testing.T) {
+		resp, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Esperava-se nenhum erro na jogada")
+		assert.Equal(t, 5, resp.Lines)
+		assert.Equal(t, 50, resp.TotalBet)
+		assert.Len(t, resp.LineWins, 5)
+	})
+
+	t.Run("Execute_TooManyLines", func(t *testing.T) {
+		_, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10, Lines: 6})
+		assert.Equal(t, ErrValidate, err)
+	})
+
+	t.Run("Execute_InsufficientBalanceForAllLines", func(t *testing.T) {
+		_, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 300})
+		assert.Equal(t, ErrInsufficientBalance, err, "A aposta total de 1500 excede o saldo")
+	})
+
+	t.Run("TheoreticalRTP_ReelWindows", func(t *testing.T) {
+		rtpMachine := *machine
+		rtpMachine.Strips = []model.ReelStrip{
+			model.NewReelStrip([]string{"A", "B"}, []int{3, 1}),
+			model.NewReelStrip([]string{"A", "B"}, []int{3, 1}),
+			model.NewReelStrip([]string{"A", "B"}, []int{3, 1}),
+			model.NewReelStrip([]string{"B"}, nil),
+			model.NewReelStrip([]string{"C"}, nil),
+		}
+
+		// Em cada rolo, uma linha da grade mostra A com chance 3/4 nas
+		// linhas pares da janela e 1/4 nas ímpares; B, o inverso.
+		lineRTP := func(rows ...int) float64 {
+			a, b := 1.0, 1.0
+			for _, row := range rows {
+				if row%2 == 0 {
+					a, b = a*0.75, b*0.25
+				} else {
+					a, b = a*0.25, b*0.75
+				}
+			}
+			// Os dois últimos rolos nunca completam a linha: só paga a trinca.
+			return (a + b) * 2
+		}
+		expected := (lineRTP(1, 1, 1) + lineRTP(2, 2, 2) + lineRTP(0, 0, 0) + lineRTP(0, 1, 2) + lineRTP(2, 1, 0)) / 5
+		assert.InDelta(t, expected, rtpMachine.TheoreticalRTP(), 1e-9)
+	})
+}
+
+// fixedStrips monta fitas de um único símbolo, que sempre param nele.
+func fixedStrips(symbols ...string) []model.ReelStrip {
+	strips := make([]model.ReelStrip, len(symbols))
+	for i, symbol := range symbols {
+		strips[i] = model.NewReelStrip([]string{symbol}, nil)
+	}
+	return strips
+}
+
+// newStatsPlayUseCase monta uma máquina de Level 3 com saldo suficiente
+// para muitas jogadas, usada nos testes estatísticos.
+func newStatsPlayUseCase(t *testing.T, jurisdiction string, nearMiss model.NearMissMode) *PlayUseCase {
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+
+	playUC := NewPlayUseCase(playerRepo, slotRepo, repository_in_memory.NewInMemoryTransactionRepository(), repository_in_memory.NewInMemoryGamblingLimitRepository(),
+		repository_in_memory.NewInMemoryPlaySessionRepository(), repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	playUC.rng = rand.New(rand.NewSource(42))
+
+	err := playerRepo.CreatePlayer(context.Background(), &model.Player{ID: "player1", Balance: 1000000, EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	machine := model.NewSlotMachine("machine1", 3, 1000000, 2, "Máquina estatística")
+	machine.Jurisdiction = jurisdiction
+	machine.NearMiss = nearMiss
+	err = slotRepo.CreateSlotMachine(context.Background(), machine)
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	return playUC
+}
+
+func TestPlayUseCase_NearMiss(t *testing.T) {
+	ctx := context.Background()
+	req := &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 1}
+	const spins = 3000
+
+	t.Run("Execute_PresentationDoesNotChangeOutcomes", func(t *testing.T) {
+		plain := newStatsPlayUseCase(t, "MT", model.NearMissOff)
+		teaser := newStatsPlayUseCase(t, "MT", model.NearMissReelWindow)
+		teaser.NearMiss = NearMissPolicy{Jurisdictions: []string{"MT"}}
+
+		peeks := 0
+		for i := 0; i < spins; i++ {
+			want, err := plain.Execute(ctx, req)
+			assert.NoError(t, err)
+			got, err := teaser.Execute(ctx, req)
+			assert.NoError(t, err)
+
+			// A mesma seed deve produzir exatamente as mesmas jogadas.
+			assert.Equal(t, want.Result, got.Result)
+			assert.Equal(t, want.PlayerBalance, got.PlayerBalance)
+			assert.Nil(t, want.NearMiss)
+			assert.Equal(t, !got.Win, got.NearMiss != nil, "O quase acerto só aparece nas jogadas sem prêmio")
+			if got.NearMiss != nil {
+				peeks++
+				assert.Len(t, got.NearMiss.Above, model.DefaultReels)
+				assert.Len(t, got.NearMiss.Below, model.DefaultReels)
+			}
+		}
+		assert.Greater(t, peeks, 0)
+	})
+
+	t.Run("Execute_JurisdictionNotAllowed", func(t *testing.T) {
+		playUC := newStatsPlayUseCase(t, "XX", model.NearMissReelWindow)
+		playUC.NearMiss = NearMissPolicy{Jurisdictions: []string{"MT"}}
+
+		for i := 0; i < 50; i++ {
+			resp, err := playUC.Execute(ctx, req)
+			assert.NoError(t, err)
+			assert.Nil(t, resp.NearMiss, "A jurisdição da máquina não permite quase acertos")
+		}
+	})
+
+	t.Run("Execute_OutcomeFrequenciesMatchReels", func(t *testing.T) {
+		playUC := newStatsPlayUseCase(t, "MT", model.NearMissReelWindow)
+		playUC.NearMiss = NearMissPolicy{Jurisdictions: []string{"MT"}}
+
+		// Com 5 símbolos, 3 rolos e Level 3, o peso total é 125 + 15: 20
+		// trincas, 60 pares e 60 linhas com três símbolos diferentes. O
+		// ajuste de quase acerto antigo reduzia as diferentes a um terço.
+		expected := []float64{20.0 / 140, 60.0 / 140, 60.0 / 140}
+		observed := make([]float64, 3)
+		for i := 0; i < spins; i++ {
+			resp, err := playUC.Execute(ctx, req)
+			assert.NoError(t, err)
+
+			distinct := make(map[string]struct{})
+			for _, symbol := range resp.Result[0] {
+				distinct[symbol] = struct{}{}
+			}
+			observed[len(distinct)-1]++
+		}
+
+		// Qui-quadrado com 2 graus de liberdade; 13.82 é o valor crítico
+		// para p = 0.001.
+		chiSquare := 0.0
+		for i, p := range expected {
+			diff := observed[i] - p*spins
+			chiSquare += diff * diff / (p * spins)
+		}
+		assert.Less(t, chiSquare, 13.82, "Frequências observadas: %v", observed)
+	})
+}
+
+// recordingPublisher guarda os eventos publicados para as verificações.
+type recordingPublisher struct {
+	events []model.Event
+}
+
+func (p *recordingPublisher) Publish(event model.Event) {
+	p.events = append(p.events, event)
+}
+
+func TestPlayUseCase_Events(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+
+	playUC := NewPlayUseCase(playerRepo, slotRepo, repository_in_memory.NewInMemoryTransactionRepository(), repository_in_memory.NewInMemoryGamblingLimitRepository(),
+		repository_in_memory.NewInMemoryPlaySessionRepository(), repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	publisher := &recordingPublisher{}
+	playUC.Events = publisher
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Balance: 1000, EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+	machine := &model.SlotMachine{ID: "machine1", MultipleGain: 30, Balance: 5000, Strips: fixedStrips("A", "B", "C")}
+	err = slotRepo.CreateSlotMachine(ctx, machine)
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	req := &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10}
+
+	t.Run("Execute_BalanceUpdated", func(t *testing.T) {
+		publisher.events = nil
+
+		_, err := playUC.Execute(ctx, req)
+		assert.NoError(t, err)
+		assert.Len(t, publisher.events, 1, "Uma derrota só altera o saldo")
+		assert.Equal(t, model.EventBalanceUpdated, publisher.events[0].Type)
+		assert.Equal(t, "player1", publisher.events[0].PlayerID)
+		assert.Equal(t, model.BalanceUpdate{Balance: 990, Delta: -10, Reason: model.BalanceReasonPlay}, publisher.events[0].Data)
+	})
+
+	t.Run("Execute_BigWinBroadcast", func(t *testing.T) {
+		publisher.events = nil
+		machine.Strips = fixedStrips("A", "A", "A")
+
+		_, err := playUC.Execute(ctx, req)
+		assert.NoError(t, err)
+		assert.Len(t, publisher.events, 2)
+		assert.Equal(t, model.BalanceUpdate{Balance: 1290, Delta: 300, Reason: model.BalanceReasonPlay}, publisher.events[0].Data)
+		assert.Equal(t, model.EventBigWin, publisher.events[1].Type)
+		assert.Empty(t, publisher.events[1].PlayerID, "Vitórias grandes são enviadas a todos")
+		assert.Equal(t, model.BigWin{MachineID: "machine1", Bet: 10, Payout: 310}, publisher.events[1].Data)
+	})
+}
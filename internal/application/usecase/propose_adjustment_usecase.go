@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidReasonCode = errors.New("invalid adjustment reason code")
+	ErrSelfApproval      = errors.New("adjustment must be reviewed by a different admin")
+)
+
+type ProposeAdjustmentUseCase struct {
+	AdjustmentRepo  repository.AdjustmentRepository
+	PlayerRepo      repository.PlayerRepository
+	SlotMachineRepo repository.SlotMachineRepository
+	APIKeyRepo      repository.APIKeyRepository
+	AuditRepo       repository.AuditRepository
+	Transactor      ports.Transactor
+	now             func() time.Time
+}
+
+// ProposeAdjustmentRequest credita (Amount positivo) ou debita (negativo)
+// o saldo do alvo.
+type ProposeAdjustmentRequest struct {
+	TargetType model.AdjustmentTarget `json:"target_type"`
+	TargetID   string                 `json:"target_id"`
+	Amount     int                    `json:"amount"`
+	ReasonCode model.AdjustmentReason `json:"reason_code"`
+	Note       string                 `json:"note"`
+}
+
+func NewProposeAdjustmentUseCase(adjustmentRepo repository.AdjustmentRepository, playerRepo repository.PlayerRepository, slotRepo repository.SlotMachineRepository, apiKeyRepo repository.APIKeyRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *ProposeAdjustmentUseCase {
+	return &ProposeAdjustmentUseCase{
+		AdjustmentRepo:  adjustmentRepo,
+		PlayerRepo:      playerRepo,
+		SlotMachineRepo: slotRepo,
+		APIKeyRepo:      apiKeyRepo,
+		AuditRepo:       auditRepo,
+		Transactor:      transactor,
+		now:             time.Now,
+	}
+}
+
+func (uc *ProposeAdjustmentUseCase) Execute(ctx context.Context, req *ProposeAdjustmentRequest) (*model.BalanceAdjustment, error) {
+	if err := authorize(ctx, model.ScopeAdjustmentsPropose); err != nil {
+		return nil, err
+	}
+
+	if req.Amount == 0 {
+		return nil, ErrValidate
+	}
+	if !model.IsValidAdjustmentReason(req.ReasonCode) {
+		return nil, ErrInvalidReasonCode
+	}
+
+	switch req.TargetType {
+	case model.AdjustmentTargetPlayer:
+		if _, err := uc.PlayerRepo.GetPlayer(ctx, req.TargetID); err != nil {
+			return nil, err
+		}
+	case model.AdjustmentTargetMachine:
+		if _, err := uc.SlotMachineRepo.GetSlotMachine(ctx, req.TargetID); err != nil {
+			return nil, err
+		}
+	case model.AdjustmentTargetTreasury:
+		req.TargetID = model.HouseTreasuryID
+	default:
+		return nil, ErrValidate
+	}
+
+	proposer, err := responsibleAdmin(ctx, uc.APIKeyRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	adjustment := &model.BalanceAdjustment{
+		ID:         uuid.New().String(),
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Amount:     req.Amount,
+		ReasonCode: req.ReasonCode,
+		Note:       strings.TrimSpace(req.Note),
+		Status:     model.AdjustmentPending,
+		ProposedBy: proposer,
+		ProposedAt: now,
+	}
+
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.AdjustmentRepo.CreateAdjustment(ctx, adjustment); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionAdjustmentPropose, "adjustment", adjustment.ID, nil, adjustment, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return adjustment, nil
+}
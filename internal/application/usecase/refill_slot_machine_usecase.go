@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type RefillSlotMachineUseCase struct {
+	TreasuryRepo    repository.TreasuryRepository
+	SlotMachineRepo repository.SlotMachineRepository
+	AuditRepo       repository.AuditRepository
+	Policy          MachineFloatPolicy
+	Transactor      ports.Transactor
+	now             func() time.Time
+}
+
+func NewRefillSlotMachineUseCase(treasuryRepo repository.TreasuryRepository, slotRepo repository.SlotMachineRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *RefillSlotMachineUseCase {
+	return &RefillSlotMachineUseCase{
+		TreasuryRepo:    treasuryRepo,
+		SlotMachineRepo: slotRepo,
+		AuditRepo:       auditRepo,
+		Policy:          DefaultMachineFloatPolicy(),
+		Transactor:      transactor,
+		now:             time.Now,
+	}
+}
+
+// Execute transfere fundos da tesouraria para a máquina.
+func (uc *RefillSlotMachineUseCase) Execute(ctx context.Context, req *MachineTransferRequest) (*model.TreasuryMovement, error) {
+	if err := authorize(ctx, model.ScopeTreasuryManage); err != nil {
+		return nil, err
+	}
+
+	return transferMachineFunds(ctx, uc.TreasuryRepo, uc.SlotMachineRepo, uc.AuditRepo, uc.Transactor, uc.Policy, model.MovementRefill, req, uc.now())
+}
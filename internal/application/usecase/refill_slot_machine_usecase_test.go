@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefillSlotMachineUseCase(t *testing.T) {
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	apiKeyRepo := repository_in_memory.NewInMemoryAPIKeyRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	treasuryRepo := repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)
+	adjustmentRepo := repository_in_memory.NewInMemoryAdjustmentRepository(playerRepo, slotRepo, repository_in_memory.NewInMemoryTransactionRepository(), treasuryRepo)
+
+	proposeUC := NewProposeAdjustmentUseCase(adjustmentRepo, playerRepo, slotRepo, apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	approveUC := NewApproveAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, playerRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	refillUC := NewRefillSlotMachineUseCase(treasuryRepo, slotRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	refillUC.Policy = MachineFloatPolicy{MaxTransfer: 5000}
+	cashoutUC := NewCashoutSlotMachineUseCase(treasuryRepo, slotRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	cashoutUC.Policy = MachineFloatPolicy{MaxTransfer: 5000, MinFloat: 500}
+	getTreasuryUC := NewGetTreasuryUseCase(treasuryRepo, auditRepo)
+
+	adminCtx := func(userID string) context.Context {
+		ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, userID)
+		return context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+	}
+	ctx := adminCtx("admin1")
+
+	err := slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{ID: "machine1", Balance: 1000})
+	assert.NoError(t, err, "Erro ao criar máquina para testes")
+
+	t.Run("Execute_TreasuryEmpty", func(t *testing.T) {
+		_, err := refillUC.Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 100, Reason: "float"})
+		assert.Equal(t, repository.ErrInsufficientTreasuryBalance, err, "Expected the refill to fail without treasury funds")
+	})
+
+	t.Run("Execute_FundTreasuryWithApprovedAdjustment", func(t *testing.T) {
+		adjustment, err := proposeUC.Execute(ctx, &ProposeAdjustmentRequest{TargetType: model.AdjustmentTargetTreasury, Amount: 3000, ReasonCode: model.ReasonTreasuryFunding})
+		assert.NoError(t, err, "Expected no error proposing a treasury adjustment")
+		assert.Equal(t, model.HouseTreasuryID, adjustment.TargetID)
+
+		_, err = approveUC.Execute(adminCtx("admin2"), &ApproveAdjustmentRequest{ID: adjustment.ID})
+		assert.NoError(t, err, "Expected no error approving the treasury adjustment")
+
+		treasury, _ := treasuryRepo.GetTreasury(ctx)
+		assert.Equal(t, 3000, treasury.Balance)
+	})
+
+	t.Run("Execute_Refill", func(t *testing.T) {
+		_, err := refillUC.Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 100})
+		assert.Equal(t, ErrValidate, err, "Expected ErrValidate without a reason")
+
+		_, err = refillUC.Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 6000, Reason: "float"})
+		assert.Equal(t, ErrTransferLimitExceeded, err, "Expected the per-operation limit to apply")
+
+		movement, err := refillUC.Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 2000, Reason: "weekend float"})
+		assert.NoError(t, err, "Expected no error refilling the machine")
+		assert.Equal(t, 3000, movement.MachineBalance)
+		assert.Equal(t, 1000, movement.TreasuryBalance)
+		assert.Equal(t, "admin1", movement.Actor)
+	})
+
+	t.Run("Execute_CashoutKeepsMinimumFloat", func(t *testing.T) {
+		_, err := cashoutUC.Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 2600, Reason: "skim"})
+		assert.Equal(t, repository.ErrInsufficientMachineBalance, err, "Expected the cashout to keep the minimum float")
+
+		movement, err := cashoutUC.Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 2500, Reason: "skim"})
+		assert.NoError(t, err, "Expected no error cashing out")
+		assert.Equal(t, 500, movement.MachineBalance)
+		assert.Equal(t, 3500, movement.TreasuryBalance)
+
+		machine, _ := slotRepo.GetSlotMachine(ctx, "machine1")
+		assert.Equal(t, 500, machine.Balance)
+	})
+
+	t.Run("Execute_TreasuryReport", func(t *testing.T) {
+		resp, err := getTreasuryUC.Execute(ctx)
+		assert.NoError(t, err, "Expected no error reading the treasury")
+		assert.Equal(t, 3500, resp.Treasury.Balance)
+		if assert.Len(t, resp.RecentMovements, 3) {
+			assert.Equal(t, model.MovementCashout, resp.RecentMovements[0].Type, "Expected the newest movement first")
+		}
+
+		entries, _ := auditRepo.ListAuditEntries(ctx, repository.AuditFilter{Action: AuditActionMachineCashout})
+		if assert.Len(t, entries, 1) {
+			assert.JSONEq(t, `{"machine_balance":3000,"treasury_balance":1000}`, string(entries[0].Before))
+		}
+	})
+
+	t.Run("Execute_ScopeRequired", func(t *testing.T) {
+		keyCtx := context.WithValue(ctx, contextkeys.ContextKeyScopes, []model.Scope{model.ScopeTreasuryRead})
+		_, err := refillUC.Execute(keyCtx, &MachineTransferRequest{MachineID: "machine1", Amount: 100, Reason: "float"})
+		assert.Equal(t, ErrForbidden, err, "Expected ErrForbidden without treasury:manage")
+	})
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type RejectAdjustmentUseCase struct {
+	AdjustmentRepo repository.AdjustmentRepository
+	APIKeyRepo     repository.APIKeyRepository
+	AuditRepo      repository.AuditRepository
+	Transactor     ports.Transactor
+	now            func() time.Time
+}
+
+type RejectAdjustmentRequest struct {
+	ID string `json:"-"`
+}
+
+func NewRejectAdjustmentUseCase(adjustmentRepo repository.AdjustmentRepository, apiKeyRepo repository.APIKeyRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *RejectAdjustmentUseCase {
+	return &RejectAdjustmentUseCase{
+		AdjustmentRepo: adjustmentRepo,
+		APIKeyRepo:     apiKeyRepo,
+		AuditRepo:      auditRepo,
+		Transactor:     transactor,
+		now:            time.Now,
+	}
+}
+
+func (uc *RejectAdjustmentUseCase) Execute(ctx context.Context, req *RejectAdjustmentRequest) (*model.BalanceAdjustment, error) {
+	if err := authorize(ctx, model.ScopeAdjustmentsApprove); err != nil {
+		return nil, err
+	}
+
+	adjustment, err := uc.AdjustmentRepo.GetAdjustment(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if adjustment.Status != model.AdjustmentPending {
+		return nil, repository.ErrAdjustmentNotPending
+	}
+
+	reviewer, err := responsibleAdmin(ctx, uc.APIKeyRepo)
+	if err != nil {
+		return nil, err
+	}
+	if reviewer == adjustment.ProposedBy {
+		return nil, ErrSelfApproval
+	}
+
+	before := *adjustment
+	now := uc.now()
+	adjustment.ReviewedBy = reviewer
+	adjustment.ReviewedAt = &now
+
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.AdjustmentRepo.RejectAdjustment(ctx, adjustment); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionAdjustmentReject, "adjustment", adjustment.ID, before, adjustment, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return adjustment, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const (
+	defaultOutboxBatchSize   = 100
+	defaultOutboxMaxAttempts = 20
+)
+
+// RelayOutboxUseCase entrega os eventos pendentes do outbox a todos os
+// publicadores. A entrega é ao menos uma vez: um evento só é marcado como
+// publicado depois que todos os publicadores aceitaram, e uma falha
+// interrompe o lote para que os eventos seguintes não passem à frente. Cada
+// publicador que aceitou fica registrado no evento, e a nova tentativa só
+// vai aos que faltam. Após MaxAttempts falhas o evento vai para a lista de
+// mortos e deixa de segurar a fila. Roda agendado no servidor, sem contexto
+// administrativo.
+type RelayOutboxUseCase struct {
+	OutboxRepo  repository.OutboxRepository
+	Publishers  []OutboxPublisher
+	BatchSize   int
+	MaxAttempts int
+	now         func() time.Time
+}
+
+// OutboxPublisher identifica o publicador no registro de entregas do evento;
+// o nome precisa ser estável entre reinícios do servidor.
+type OutboxPublisher struct {
+	Name      string
+	Publisher ports.DomainEventPublisher
+}
+
+type RelayOutboxResponse struct {
+	Published    int    `json:"published"`
+	DeadLettered int    `json:"dead_lettered"`
+	Failed       string `json:"failed,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+func NewRelayOutboxUseCase(outboxRepo repository.OutboxRepository, publishers ...OutboxPublisher) *RelayOutboxUseCase {
+	return &RelayOutboxUseCase{
+		OutboxRepo:  outboxRepo,
+		Publishers:  publishers,
+		BatchSize:   defaultOutboxBatchSize,
+		MaxAttempts: defaultOutboxMaxAttempts,
+		now:         time.Now,
+	}
+}
+
+// Execute processa um lote. A falha de um publicador é registrada no evento
+// e devolvida em Failed/Error; o erro retornado indica falha do próprio
+// outbox.
+func (uc *RelayOutboxUseCase) Execute(ctx context.Context) (*RelayOutboxResponse, error) {
+	events, err := uc.OutboxRepo.ListPendingEvents(ctx, uc.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &RelayOutboxResponse{}
+events:
+	for _, event := range events {
+		for _, publisher := range uc.Publishers {
+			if event.DeliveredBy(publisher.Name) {
+				continue
+			}
+			if err := publisher.Publisher.Publish(ctx, event); err != nil {
+				resp.Failed = event.ID
+				resp.Error = err.Error()
+				dead, err := uc.fail(ctx, event, err)
+				if err != nil || !dead {
+					return resp, err
+				}
+				resp.DeadLettered++
+				continue events
+			}
+			if err := uc.OutboxRepo.MarkEventDelivered(ctx, event.ID, publisher.Name); err != nil {
+				return resp, err
+			}
+		}
+		if err := uc.OutboxRepo.MarkEventPublished(ctx, event.ID, uc.now().UTC()); err != nil {
+			return resp, err
+		}
+		resp.Published++
+	}
+	return resp, nil
+}
+
+// fail registra a falha do publicador e informa se o evento esgotou as
+// tentativas.
+func (uc *RelayOutboxUseCase) fail(ctx context.Context, event *model.DomainEvent, publishErr error) (bool, error) {
+	var deadAt *time.Time
+	if uc.MaxAttempts > 0 && event.Attempts+1 >= uc.MaxAttempts {
+		now := uc.now().UTC()
+		deadAt = &now
+	}
+	return deadAt != nil, uc.OutboxRepo.MarkEventFailed(ctx, event.ID, publishErr.Error(), deadAt)
+}
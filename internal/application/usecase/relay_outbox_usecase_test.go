@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"slot-machine/internal/domain/model"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingDomainPublisher guarda os eventos recebidos. Com err, recusa
+// todos os eventos ou, com failOn, só os desse tipo.
+type recordingDomainPublisher struct {
+	events []*model.DomainEvent
+	err    error
+	failOn model.DomainEventType
+}
+
+func (p *recordingDomainPublisher) Publish(ctx context.Context, event *model.DomainEvent) error {
+	if p.err != nil && (p.failOn == "" || p.failOn == event.Type) {
+		return p.err
+	}
+	p.events = append(p.events, event)
+	return nil
+}
+
+func TestRelayOutboxUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	txRepo := repository_in_memory.NewInMemoryTransactionRepository()
+	limitRepo := repository_in_memory.NewInMemoryGamblingLimitRepository()
+	exclusionRepo := repository_in_memory.NewInMemorySelfExclusionRepository()
+	outboxRepo := repository_in_memory.NewInMemoryOutboxRepository()
+	transactor := repository_in_memory.NewInMemoryTransactor()
+
+	depositUC := NewDepositUseCase(playerRepo, txRepo, limitRepo, exclusionRepo, outboxRepo, transactor)
+	playUC := NewPlayUseCase(playerRepo, slotRepo, txRepo, limitRepo, repository_in_memory.NewInMemoryPlaySessionRepository(), exclusionRepo, repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), outboxRepo, transactor)
+	playUC.rng = rand.New(rand.NewSource(1))
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Balance: 0, EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+	err = slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{ID: "machine1", MultipleGain: 2, Balance: 10000, Strips: fixedStrips("A", "A", "A")})
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	t.Run("Execute_RecordsEventsWithState", func(t *testing.T) {
+		_, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.Equal(t, ErrInsufficientBalance, err)
+
+		_, err = depositUC.Execute(ctx, &DepositRequest{PlayerID: "player1", Amount: 100})
+		assert.NoError(t, err, "Esperava-se nenhum erro no depósito")
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Esperava-se nenhum erro na jogada")
+
+		events, err := outboxRepo.ListPendingEvents(ctx, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, events, 2, "A jogada recusada não deve gravar eventos") {
+			assert.Equal(t, model.DomainEventDepositCompleted, events[0].Type)
+			assert.Equal(t, model.DomainEventSpinSettled, events[1].Type)
+
+			var spin model.SpinSettledPayload
+			assert.NoError(t, json.Unmarshal(events[1].Payload, &spin))
+			assert.Equal(t, "player1", spin.PlayerID)
+			assert.Equal(t, 10, spin.Bet)
+			assert.Equal(t, 30, spin.Payout)
+			assert.Equal(t, 120, spin.Balance)
+		}
+	})
+
+	t.Run("Execute_FailureStopsBatch", func(t *testing.T) {
+		file := &recordingDomainPublisher{}
+		failing := &recordingDomainPublisher{err: errors.New("destino indisponível")}
+		relayUC := NewRelayOutboxUseCase(outboxRepo, OutboxPublisher{Name: "file", Publisher: file}, OutboxPublisher{Name: "webhook", Publisher: failing})
+
+		resp, err := relayUC.Execute(ctx)
+		assert.NoError(t, err, "A falha do publicador não é erro do relay")
+		assert.Equal(t, 0, resp.Published)
+		assert.Equal(t, "destino indisponível", resp.Error)
+		assert.Len(t, file.events, 1, "O publicador anterior à falha recebe o evento")
+
+		events, err := outboxRepo.ListPendingEvents(ctx, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, events, 2, "Nenhum evento deve ser marcado como publicado") {
+			assert.Equal(t, events[0].ID, resp.Failed, "O lote deve parar no evento mais antigo")
+			assert.Equal(t, 1, events[0].Attempts)
+			assert.Equal(t, "destino indisponível", events[0].LastError)
+			assert.Equal(t, []string{"file"}, events[0].DeliveredTo)
+			assert.Equal(t, 0, events[1].Attempts, "Os eventos seguintes não devem ser tentados")
+		}
+	})
+
+	t.Run("Execute_PublishesInOrder", func(t *testing.T) {
+		file, webhook := &recordingDomainPublisher{}, &recordingDomainPublisher{}
+		relayUC := NewRelayOutboxUseCase(outboxRepo, OutboxPublisher{Name: "file", Publisher: file}, OutboxPublisher{Name: "webhook", Publisher: webhook})
+		relayUC.now = func() time.Time { return time.Date(2025, 3, 6, 9, 0, 0, 0, time.UTC) }
+
+		resp, err := relayUC.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.Published)
+		assert.Empty(t, resp.Failed)
+		if assert.Len(t, webhook.events, 2) {
+			assert.Equal(t, model.DomainEventDepositCompleted, webhook.events[0].Type)
+			assert.Equal(t, model.DomainEventSpinSettled, webhook.events[1].Type)
+		}
+		if assert.Len(t, file.events, 1, "A nova tentativa não deve repetir o evento a quem já o recebeu") {
+			assert.Equal(t, model.DomainEventSpinSettled, file.events[0].Type)
+		}
+
+		events, err := outboxRepo.ListPendingEvents(ctx, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, events, "Os eventos entregues não devem voltar ao lote")
+
+		resp, err = relayUC.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, resp.Published)
+	})
+
+	t.Run("Execute_DeadLettersAfterMaxAttempts", func(t *testing.T) {
+		_, err := depositUC.Execute(ctx, &DepositRequest{PlayerID: "player1", Amount: 100})
+		assert.NoError(t, err, "Esperava-se nenhum erro no depósito")
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Esperava-se nenhum erro na jogada")
+
+		webhook := &recordingDomainPublisher{failOn: model.DomainEventDepositCompleted, err: errors.New("payload recusado")}
+		relayUC := NewRelayOutboxUseCase(outboxRepo, OutboxPublisher{Name: "webhook", Publisher: webhook})
+		relayUC.MaxAttempts = 2
+
+		resp, err := relayUC.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, resp.Published)
+		assert.Equal(t, 0, resp.DeadLettered, "O evento ainda tem tentativas")
+
+		resp, err = relayUC.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, resp.DeadLettered, "O evento deve ir para a lista de mortos ao esgotar as tentativas")
+		assert.Equal(t, 1, resp.Published, "O evento morto não deve segurar os seguintes")
+		if assert.Len(t, webhook.events, 1) {
+			assert.Equal(t, model.DomainEventSpinSettled, webhook.events[0].Type)
+		}
+
+		events, err := outboxRepo.ListPendingEvents(ctx, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, events, "O evento morto sai da fila")
+	})
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type RequestPasswordResetUseCase struct {
+	PlayerRepo      repository.PlayerRepository
+	ActionTokenRepo repository.ActionTokenRepository
+	Notifier        ports.Notifier
+	TokenDuration   time.Duration
+	now             func() time.Time
+}
+
+type RequestPasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+func NewRequestPasswordResetUseCase(playerRepo repository.PlayerRepository, tokenRepo repository.ActionTokenRepository, notifier ports.Notifier, tokenDuration time.Duration) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		PlayerRepo:      playerRepo,
+		ActionTokenRepo: tokenRepo,
+		Notifier:        notifier,
+		TokenDuration:   tokenDuration,
+		now:             time.Now,
+	}
+}
+
+// Execute envia um token de redefinição ao jogador. Emails desconhecidos não
+// geram erro para não revelar quais contas existem.
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, req *RequestPasswordResetRequest) error {
+	if req.Email == "" {
+		return ErrValidate
+	}
+
+	player, err := uc.PlayerRepo.GetPlayerByEmail(ctx, model.NormalizeEmail(req.Email))
+	if err != nil {
+		if err == repository.ErrPlayerNotFound {
+			return nil
+		}
+		return err
+	}
+
+	token, err := issueActionToken(ctx, uc.ActionTokenRepo, player.ID, model.PasswordResetPurpose, uc.TokenDuration, uc.now())
+	if err != nil {
+		return err
+	}
+
+	return uc.Notifier.Notify(ctx, ports.Notification{
+		To:      player.Email,
+		Subject: "Redefinição de senha",
+		Body: fmt.Sprintf(
+			"Use o código a seguir para redefinir sua senha: %s\nO código expira em %d minutos.",
+			token, int(uc.TokenDuration.Minutes()),
+		),
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+	"time"
+)
+
+var (
+	ErrInvalidResetToken = errors.New("invalid or expired reset token")
+)
+
+type ResetPasswordUseCase struct {
+	PlayerRepo       repository.PlayerRepository
+	ActionTokenRepo  repository.ActionTokenRepository
+	RefreshTokenRepo repository.RefreshTokenRepository
+	Hasher           security.PasswordHasher
+	PasswordPolicy   security.PasswordPolicy
+	Transactor       ports.Transactor
+	now              func() time.Time
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+func NewResetPasswordUseCase(playerRepo repository.PlayerRepository, tokenRepo repository.ActionTokenRepository, refreshRepo repository.RefreshTokenRepository, hasher security.PasswordHasher, policy security.PasswordPolicy, transactor ports.Transactor) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		PlayerRepo:       playerRepo,
+		ActionTokenRepo:  tokenRepo,
+		RefreshTokenRepo: refreshRepo,
+		Hasher:           hasher,
+		PasswordPolicy:   policy,
+		Transactor:       transactor,
+		now:              time.Now,
+	}
+}
+
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, req *ResetPasswordRequest) error {
+	if req.Token == "" || req.NewPassword == "" {
+		return ErrValidate
+	}
+
+	if err := uc.PasswordPolicy.Validate(req.NewPassword); err != nil {
+		return err
+	}
+
+	passwordHashed, err := uc.Hasher.Hash(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	// O token é consumido na mesma transação da troca de senha: um segundo
+	// uso concorrente não encontra mais o token.
+	return uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		token, err := uc.ActionTokenRepo.ConsumeActionToken(ctx, hashToken(req.Token), model.PasswordResetPurpose, uc.now())
+		if err != nil {
+			if err == repository.ErrActionTokenNotFound {
+				return ErrInvalidResetToken
+			}
+			return err
+		}
+
+		if err := uc.PlayerRepo.UpdatePassword(ctx, token.PlayerID, passwordHashed); err != nil {
+			return err
+		}
+
+		if err := uc.ActionTokenRepo.DeletePlayerActionTokens(ctx, token.PlayerID, model.PasswordResetPurpose); err != nil {
+			return err
+		}
+
+		return uc.RefreshTokenRepo.RevokeAllRefreshTokens(ctx, token.PlayerID)
+	})
+}
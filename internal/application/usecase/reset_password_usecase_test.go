@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"slot-machine/internal/infrastructure/security"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type capturingNotifier struct {
+	notifications []ports.Notification
+}
+
+func (n *capturingNotifier) Notify(ctx context.Context, notification ports.Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+var tokenPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+func TestResetPasswordUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	tokenRepo := repository_in_memory.NewInMemoryActionTokenRepository()
+	refreshRepo := repository_in_memory.NewInMemoryRefreshTokenRepository()
+	hasher := security.NewBcryptPasswordHasher(bcrypt.MinCost)
+	policy := security.NewPasswordPolicy(8, 0, nil)
+	notifier := &capturingNotifier{}
+
+	requestResetUC := NewRequestPasswordResetUseCase(playerRepo, tokenRepo, notifier, 30*time.Minute)
+	resetPasswordUC := NewResetPasswordUseCase(playerRepo, tokenRepo, refreshRepo, hasher, policy, repository_in_memory.NewInMemoryTransactor())
+
+	now := time.Date(2025, 2, 16, 10, 0, 0, 0, time.UTC)
+	requestResetUC.now = func() time.Time { return now }
+	resetPasswordUC.now = func() time.Time { return now }
+
+	hashed, err := hasher.Hash("old-password")
+	assert.NoError(t, err, "Erro ao gerar hash da senha")
+
+	err = playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Email: "player@email.com", Password: hashed})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	t.Run("Request_UnknownEmail", func(t *testing.T) {
+		err := requestResetUC.Execute(ctx, &RequestPasswordResetRequest{Email: "unknown@email.com"})
+
+		assert.NoError(t, err, "Expected no error for unknown emails")
+		assert.Empty(t, notifier.notifications, "Expected no notification for unknown emails")
+	})
+
+	t.Run("Reset_InvalidToken", func(t *testing.T) {
+		err := resetPasswordUC.Execute(ctx, &ResetPasswordRequest{Token: "invalid", NewPassword: "new-password"})
+
+		assert.Equal(t, ErrInvalidResetToken, err, "Expected ErrInvalidResetToken error")
+	})
+
+	t.Run("Reset_ExpiredToken", func(t *testing.T) {
+		err := requestResetUC.Execute(ctx, &RequestPasswordResetRequest{Email: "player@email.com"})
+		assert.NoError(t, err, "Expected no error when requesting a reset")
+
+		token := tokenPattern.FindString(notifier.notifications[len(notifier.notifications)-1].Body)
+
+		resetPasswordUC.now = func() time.Time { return now.Add(31 * time.Minute) }
+		defer func() { resetPasswordUC.now = func() time.Time { return now } }()
+
+		err = resetPasswordUC.Execute(ctx, &ResetPasswordRequest{Token: token, NewPassword: "new-password"})
+		assert.Equal(t, ErrInvalidResetToken, err, "Expected expired tokens to be rejected")
+	})
+
+	t.Run("Reset_Success", func(t *testing.T) {
+		err := refreshRepo.StoreRefreshToken(ctx, "player1", "session")
+		assert.NoError(t, err, "Erro ao registrar sessão existente")
+
+		err = requestResetUC.Execute(ctx, &RequestPasswordResetRequest{Email: "player@email.com"})
+		assert.NoError(t, err, "Expected no error when requesting a reset")
+
+		notification := notifier.notifications[len(notifier.notifications)-1]
+		assert.Equal(t, "player@email.com", notification.To, "Expected the notification to be sent to the player")
+		token := tokenPattern.FindString(notification.Body)
+		assert.NotEmpty(t, token, "Expected the notification to contain the token")
+
+		err = resetPasswordUC.Execute(ctx, &ResetPasswordRequest{Token: token, NewPassword: "new-password"})
+		assert.NoError(t, err, "Expected no error when resetting the password")
+
+		player, err := playerRepo.GetPlayer(ctx, "player1")
+		assert.NoError(t, err, "Expected to find the player")
+		assert.NoError(t, hasher.CompareHashAndPassword(player.Password, "new-password"), "Expected the new password to be stored")
+
+		valid, err := refreshRepo.ValidateRefreshToken(ctx, "player1", "session")
+		assert.NoError(t, err, "Expected no error when validating the refresh token")
+		assert.False(t, valid, "Expected existing sessions to be revoked")
+
+		err = resetPasswordUC.Execute(ctx, &ResetPasswordRequest{Token: token, NewPassword: "another-password"})
+		assert.Equal(t, ErrInvalidResetToken, err, "Expected the token to be single use")
+	})
+
+	t.Run("Reset_ConcurrentUse", func(t *testing.T) {
+		err := requestResetUC.Execute(ctx, &RequestPasswordResetRequest{Email: "player@email.com"})
+		assert.NoError(t, err, "Expected no error when requesting a reset")
+
+		token := tokenPattern.FindString(notifier.notifications[len(notifier.notifications)-1].Body)
+
+		var (
+			wg        sync.WaitGroup
+			succeeded atomic.Int32
+		)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if resetPasswordUC.Execute(ctx, &ResetPasswordRequest{Token: token, NewPassword: "concurrent-password"}) == nil {
+					succeeded.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), succeeded.Load(), "Expected only one concurrent reset to use the token")
+	})
+}
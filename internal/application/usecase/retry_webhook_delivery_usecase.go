@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+var ErrWebhookDeliveryNotDead = errors.New("webhook delivery is not dead-lettered")
+
+type RetryWebhookDeliveryUseCase struct {
+	WebhookRepo repository.WebhookRepository
+	AuditRepo   repository.AuditRepository
+	Transactor  ports.Transactor
+	now         func() time.Time
+}
+
+type RetryWebhookDeliveryRequest struct {
+	ID string `json:"-"`
+}
+
+func NewRetryWebhookDeliveryUseCase(webhookRepo repository.WebhookRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *RetryWebhookDeliveryUseCase {
+	return &RetryWebhookDeliveryUseCase{
+		WebhookRepo: webhookRepo,
+		AuditRepo:   auditRepo,
+		Transactor:  transactor,
+		now:         time.Now,
+	}
+}
+
+// Execute devolve uma entrega morta à fila com as tentativas zeradas. A
+// assinatura precisa existir.
+func (uc *RetryWebhookDeliveryUseCase) Execute(ctx context.Context, req *RetryWebhookDeliveryRequest) (*model.WebhookDelivery, error) {
+	if err := authorize(ctx, model.ScopeWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.WebhookRepo.GetDelivery(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status != model.WebhookDeliveryDead {
+		return nil, ErrWebhookDeliveryNotDead
+	}
+	if _, err := uc.WebhookRepo.GetSubscription(ctx, delivery.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	before := *delivery
+	now := uc.now()
+	delivery.Status = model.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.WebhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionWebhookRetry, "webhook_delivery", delivery.ID, before, delivery, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type RevokeAPIKeyUseCase struct {
+	APIKeyRepo repository.APIKeyRepository
+	AuditRepo  repository.AuditRepository
+	Transactor ports.Transactor
+	now        func() time.Time
+}
+
+type RevokeAPIKeyRequest struct {
+	ID string `json:"-"`
+}
+
+func NewRevokeAPIKeyUseCase(apiKeyRepo repository.APIKeyRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		APIKeyRepo: apiKeyRepo,
+		AuditRepo:  auditRepo,
+		Transactor: transactor,
+		now:        time.Now,
+	}
+}
+
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, req *RevokeAPIKeyRequest) error {
+	if err := authorize(ctx, model.ScopeAPIKeysManage); err != nil {
+		return err
+	}
+
+	if _, err := uc.APIKeyRepo.GetAPIKey(ctx, req.ID); err != nil {
+		return err
+	}
+
+	keys, err := uc.APIKeyRepo.ListAPIKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A chave e as derivadas dela são revogadas juntas, com a auditoria de
+	// cada uma.
+	now := uc.now()
+	return uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return uc.revoke(ctx, req.ID, keys, now)
+	})
+}
+
+// revoke revoga a chave e, em seguida, todas as chaves criadas a partir dela.
+// Chaves antigas sem parent_key_id são reconhecidas pelo created_by
+// api_key:<id>.
+func (uc *RevokeAPIKeyUseCase) revoke(ctx context.Context, id string, keys []*model.APIKey, now time.Time) error {
+	before, err := uc.APIKeyRepo.GetAPIKey(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if before.RevokedAt == nil {
+		if err := uc.APIKeyRepo.RevokeAPIKey(ctx, id, now); err != nil {
+			return err
+		}
+
+		after, err := uc.APIKeyRepo.GetAPIKey(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := recordAudit(ctx, uc.AuditRepo, AuditActionAPIKeyRevoke, "api_key", id, before, after, now); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range keys {
+		isChild := (key.ParentKeyID != nil && *key.ParentKeyID == id) || key.CreatedBy == apiKeyActorPrefix+id
+		if !isChild {
+			continue
+		}
+		if err := uc.revoke(ctx, key.ID, keys, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
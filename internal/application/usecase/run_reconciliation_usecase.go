@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunReconciliationUseCase recalcula o saldo esperado de cada jogador,
+// máquina e da tesouraria a partir dos lançamentos e grava o relatório do
+// dia. Roda pelo cmd/reconcile ou agendado no servidor, sem contexto
+// administrativo.
+type RunReconciliationUseCase struct {
+	ReconciliationRepo repository.ReconciliationRepository
+	ReportWriter       ports.ReconciliationReportWriter
+	now                func() time.Time
+}
+
+type RunReconciliationResponse struct {
+	Report *model.ReconciliationReport `json:"report"`
+	Files  []string                    `json:"files"`
+}
+
+func NewRunReconciliationUseCase(reconciliationRepo repository.ReconciliationRepository, reportWriter ports.ReconciliationReportWriter) *RunReconciliationUseCase {
+	return &RunReconciliationUseCase{
+		ReconciliationRepo: reconciliationRepo,
+		ReportWriter:       reportWriter,
+		now:                time.Now,
+	}
+}
+
+func (uc *RunReconciliationUseCase) Execute(ctx context.Context) (*RunReconciliationResponse, error) {
+	generatedAt := uc.now().UTC()
+	snapshot, err := uc.ReconciliationRepo.LoadLedgerSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, totals := snapshot.Reconcile()
+	report := &model.ReconciliationReport{
+		ID:          uuid.New().String(),
+		GeneratedAt: generatedAt,
+		Accounts:    len(entries),
+		Totals:      totals,
+		Entries:     entries,
+	}
+	for _, entry := range entries {
+		if !entry.Balanced() {
+			report.Discrepancies++
+		}
+	}
+	report.Balanced = report.Discrepancies == 0 && totals.Difference == 0
+
+	files, err := uc.ReportWriter.WriteReconciliationReport(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RunReconciliationResponse{
+		Report: report,
+		Files:  files,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/report"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunReconciliationUseCase(t *testing.T) {
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	txRepo := repository_in_memory.NewInMemoryTransactionRepository()
+	apiKeyRepo := repository_in_memory.NewInMemoryAPIKeyRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	limitRepo := repository_in_memory.NewInMemoryGamblingLimitRepository()
+	exclusionRepo := repository_in_memory.NewInMemorySelfExclusionRepository()
+	treasuryRepo := repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)
+	adjustmentRepo := repository_in_memory.NewInMemoryAdjustmentRepository(playerRepo, slotRepo, txRepo, treasuryRepo)
+	spinRepo := repository_in_memory.NewInMemorySpinRepository()
+	jackpotRepo := repository_in_memory.NewInMemoryJackpotRepository(treasuryRepo)
+
+	signingKey := []byte("test-signing-key")
+	dir := t.TempDir()
+	uc := NewRunReconciliationUseCase(
+		repository_in_memory.NewInMemoryReconciliationRepository(playerRepo, slotRepo, txRepo, adjustmentRepo, treasuryRepo, spinRepo, jackpotRepo),
+		report.NewFileReportWriter(dir, signingKey),
+	)
+	uc.now = func() time.Time { return time.Date(2025, 2, 28, 0, 5, 0, 0, time.UTC) }
+
+	adminCtx := func(userID string) context.Context {
+		ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, userID)
+		return context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+	}
+	ctx := adminCtx("admin1")
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", EmailVerified: true})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+	err = slotRepo.CreateSlotMachine(ctx, model.NewSlotMachine("machine1", 1, 500, 2, "Máquina de testes"))
+	assert.NoError(t, err, "Erro ao criar máquina para testes")
+
+	t.Run("Execute_LedgerMatchesBalances", func(t *testing.T) {
+		_, err := NewDepositUseCase(playerRepo, txRepo, limitRepo, exclusionRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor()).Execute(ctx, &DepositRequest{PlayerID: "player1", Amount: 1000})
+		assert.NoError(t, err, "Expected no error depositing")
+
+		playUC := NewPlayUseCase(playerRepo, slotRepo, txRepo, limitRepo, repository_in_memory.NewInMemoryPlaySessionRepository(), exclusionRepo, spinRepo, jackpotRepo, repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+		playUC.rng = rand.New(rand.NewSource(1))
+		for i := 0; i < 20; i++ {
+			_, err := playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+			assert.NoError(t, err, "Expected no error playing")
+		}
+
+		proposeUC := NewProposeAdjustmentUseCase(adjustmentRepo, playerRepo, slotRepo, apiKeyRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+		approveUC := NewApproveAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, playerRepo, repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+		for _, req := range []*ProposeAdjustmentRequest{
+			{TargetType: model.AdjustmentTargetTreasury, Amount: 3000, ReasonCode: model.ReasonTreasuryFunding},
+			{TargetType: model.AdjustmentTargetMachine, TargetID: "machine1", Amount: 100, ReasonCode: model.ReasonMachineRefill},
+			{TargetType: model.AdjustmentTargetPlayer, TargetID: "player1", Amount: 50, ReasonCode: model.ReasonGoodwill},
+		} {
+			adjustment, err := proposeUC.Execute(ctx, req)
+			assert.NoError(t, err, "Expected no error proposing an adjustment")
+			_, err = approveUC.Execute(adminCtx("admin2"), &ApproveAdjustmentRequest{ID: adjustment.ID})
+			assert.NoError(t, err, "Expected no error approving an adjustment")
+		}
+
+		_, err = NewRefillSlotMachineUseCase(treasuryRepo, slotRepo, auditRepo, repository_in_memory.NewInMemoryTransactor()).Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 1000, Reason: "float"})
+		assert.NoError(t, err, "Expected no error refilling the machine")
+		_, err = NewCashoutSlotMachineUseCase(treasuryRepo, slotRepo, auditRepo, repository_in_memory.NewInMemoryTransactor()).Execute(ctx, &MachineTransferRequest{MachineID: "machine1", Amount: 200, Reason: "skim"})
+		assert.NoError(t, err, "Expected no error cashing out the machine")
+
+		resp, err := uc.Execute(context.Background())
+		assert.NoError(t, err, "Expected no error reconciling")
+		assert.True(t, resp.Report.Balanced, "Expected the ledger to match the balances")
+		assert.Equal(t, 3, resp.Report.Accounts)
+		assert.Equal(t, 0, resp.Report.Discrepancies)
+		assert.Equal(t, 1000, resp.Report.Totals.Deposits)
+		assert.Equal(t, 500, resp.Report.Totals.MachineOpeningFloat)
+		assert.Equal(t, 4650, resp.Report.Totals.Expected)
+		assert.Equal(t, 4650, resp.Report.Totals.Actual)
+
+		assert.Len(t, resp.Files, 2)
+		for _, file := range resp.Files {
+			assert.NoError(t, report.VerifyFile(file, signingKey), "Expected a valid signature for %s", file)
+		}
+	})
+
+	t.Run("Execute_FlagsTamperedBalance", func(t *testing.T) {
+		player, _ := playerRepo.GetPlayer(ctx, "player1")
+		player.Balance += 7
+		assert.NoError(t, playerRepo.UpdatePlayer(ctx, player))
+
+		resp, err := uc.Execute(context.Background())
+		assert.NoError(t, err, "Expected no error reconciling")
+		assert.False(t, resp.Report.Balanced)
+		assert.Equal(t, 1, resp.Report.Discrepancies)
+		assert.Equal(t, 7, resp.Report.Totals.Difference)
+
+		first := resp.Report.Entries[0]
+		assert.Equal(t, model.ReconciliationAccountPlayer, first.AccountType)
+		assert.Equal(t, "player1", first.AccountID)
+		assert.Equal(t, 7, first.Difference)
+	})
+
+	t.Run("VerifyFile_RejectsEditedReport", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background())
+		assert.NoError(t, err, "Expected no error reconciling")
+
+		csvPath := resp.Files[1]
+		data, err := os.ReadFile(csvPath)
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(csvPath, append(data, []byte("player,ghost,0,0,0,true\n")...), 0o600))
+
+		assert.Equal(t, report.ErrInvalidSignature, report.VerifyFile(csvPath, signingKey))
+		assert.Equal(t, report.ErrInvalidSignature, report.VerifyFile(resp.Files[0], []byte("wrong-key")))
+	})
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+const (
+	minCoolOffDays       = 1
+	maxCoolOffDays       = 42
+	minSelfExclusionDays = 180
+	maxSelfExclusionDays = 5 * 365
+)
+
+type SelfExcludeUseCase struct {
+	SelfExclusionRepo repository.SelfExclusionRepository
+	RefreshTokenRepo  repository.RefreshTokenRepository
+	now               func() time.Time
+}
+
+// SelfExcludeRequest pede uma pausa (cool_off, de 1 a 42 dias) ou uma
+// autoexclusão (self_exclusion, de 180 dias a 5 anos ou permanente).
+type SelfExcludeRequest struct {
+	PlayerID  string              `json:"-"`
+	Type      model.ExclusionType `json:"type"`
+	Days      int                 `json:"days"`
+	Permanent bool                `json:"permanent"`
+}
+
+type SelfExcludeResponse struct {
+	Exclusion model.SelfExclusion `json:"exclusion"`
+}
+
+func NewSelfExcludeUseCase(exclusionRepo repository.SelfExclusionRepository, refreshRepo repository.RefreshTokenRepository) *SelfExcludeUseCase {
+	return &SelfExcludeUseCase{
+		SelfExclusionRepo: exclusionRepo,
+		RefreshTokenRepo:  refreshRepo,
+		now:               time.Now,
+	}
+}
+
+func (uc *SelfExcludeUseCase) Execute(ctx context.Context, req *SelfExcludeRequest) (*SelfExcludeResponse, error) {
+	if !isValidExclusion(req) {
+		return nil, ErrValidate
+	}
+
+	now := uc.now()
+	exclusion := &model.SelfExclusion{
+		PlayerID:  req.PlayerID,
+		Type:      req.Type,
+		StartedAt: now,
+	}
+	if !req.Permanent {
+		endsAt := now.AddDate(0, 0, req.Days)
+		exclusion.EndsAt = &endsAt
+	}
+
+	current, err := activeSelfExclusion(ctx, uc.SelfExclusionRepo, req.PlayerID, now)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && !extendsExclusion(current, exclusion) {
+		return nil, ErrExclusionCannotBeShortened
+	}
+
+	if err := uc.SelfExclusionRepo.SaveSelfExclusion(ctx, exclusion); err != nil {
+		return nil, err
+	}
+
+	// Encerra as sessões abertas; durante uma pausa o jogador pode entrar
+	// novamente, mas só com acesso de leitura.
+	if err := uc.RefreshTokenRepo.RevokeAllRefreshTokens(ctx, req.PlayerID); err != nil {
+		return nil, err
+	}
+
+	return &SelfExcludeResponse{
+		Exclusion: *exclusion,
+	}, nil
+}
+
+func isValidExclusion(req *SelfExcludeRequest) bool {
+	switch req.Type {
+	case model.ExclusionCoolOff:
+		return !req.Permanent && req.Days >= minCoolOffDays && req.Days <= maxCoolOffDays
+	case model.ExclusionSelfExclusion:
+		if req.Permanent {
+			return req.Days == 0
+		}
+		return req.Days >= minSelfExclusionDays && req.Days <= maxSelfExclusionDays
+	}
+	return false
+}
+
+// extendsExclusion indica se a nova exclusão termina depois da atual e não
+// troca uma autoexclusão por uma simples pausa.
+func extendsExclusion(current, next *model.SelfExclusion) bool {
+	if current.Type == model.ExclusionSelfExclusion && next.Type == model.ExclusionCoolOff {
+		return false
+	}
+	if current.IsPermanent() {
+		return next.IsPermanent()
+	}
+	return next.IsPermanent() || !next.EndsAt.Before(*current.EndsAt)
+}
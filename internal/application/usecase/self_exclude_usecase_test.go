@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/jwt"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"slot-machine/internal/infrastructure/security"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestSelfExcludeUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	refreshRepo := repository_in_memory.NewInMemoryRefreshTokenRepository()
+	attemptRepo := repository_in_memory.NewInMemoryLoginAttemptRepository()
+	exclusionRepo := repository_in_memory.NewInMemorySelfExclusionRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	hasher := security.NewBcryptPasswordHasher(bcrypt.MinCost)
+	jwtManager := jwt.NewJWTManager("secret", time.Minute, time.Hour)
+
+	now := time.Date(2025, 2, 22, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	selfExcludeUC := NewSelfExcludeUseCase(exclusionRepo, refreshRepo)
+	selfExcludeUC.now = clock
+	liftUC := NewLiftSelfExclusionUseCase(exclusionRepo, auditRepo, repository_in_memory.NewInMemoryTransactor())
+	liftUC.now = clock
+	loginUC := NewLoginUseCase(playerRepo, refreshRepo, attemptRepo, exclusionRepo, hasher, jwtManager)
+	loginUC.now = clock
+	playUC := NewPlayUseCase(playerRepo, slotRepo, repository_in_memory.NewInMemoryTransactionRepository(),
+		repository_in_memory.NewInMemoryGamblingLimitRepository(), repository_in_memory.NewInMemoryPlaySessionRepository(), exclusionRepo, repository_in_memory.NewInMemorySpinRepository(),
+		repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	playUC.now = clock
+
+	hashed, err := hasher.Hash("password")
+	assert.NoError(t, err, "Erro ao gerar hash da senha")
+
+	for _, id := range []string{"player1", "player2", "player3"} {
+		err := playerRepo.CreatePlayer(ctx, &model.Player{
+			ID:            id,
+			Email:         id + "@email.com",
+			Password:      hashed,
+			Role:          model.PlayerRole,
+			Balance:       1000,
+			EmailVerified: true,
+		})
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+	}
+
+	err = slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{
+		ID:           "machine1",
+		MultipleGain: 2,
+		Balance:      5000,
+		Strips:       fixedStrips("A", "B", "C"),
+	})
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	adminCtx := context.WithValue(ctx, contextkeys.ContextKeyUserID, "admin1")
+	adminCtx = context.WithValue(adminCtx, contextkeys.ContextKeyIsAdmin, true)
+
+	t.Run("Execute_CoolOffIsReadOnly", func(t *testing.T) {
+		err := refreshRepo.StoreRefreshToken(ctx, "player1", "refresh-token")
+		assert.NoError(t, err)
+
+		_, err = selfExcludeUC.Execute(ctx, &SelfExcludeRequest{PlayerID: "player1", Type: model.ExclusionCoolOff, Days: 1})
+		assert.NoError(t, err, "Expected no error starting a cool-off")
+
+		valid, err := refreshRepo.ValidateRefreshToken(ctx, "player1", "refresh-token")
+		assert.NoError(t, err)
+		assert.False(t, valid, "Expected refresh tokens to be revoked")
+
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "player1@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected login to be allowed during a cool-off")
+		assert.NotEmpty(t, resp.AccessToken)
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.Equal(t, ErrSelfExcluded, err, "Expected spins to be rejected during a cool-off")
+
+		now = now.Add(24 * time.Hour)
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected spins to be accepted once the cool-off ends")
+	})
+
+	t.Run("Execute_SelfExclusionBlocksLogin", func(t *testing.T) {
+		_, err := selfExcludeUC.Execute(ctx, &SelfExcludeRequest{PlayerID: "player2", Type: model.ExclusionSelfExclusion, Days: 180})
+		assert.NoError(t, err, "Expected no error self-excluding")
+
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "player2@email.com", Password: "password"})
+		assert.Equal(t, ErrSelfExcluded, err, "Expected login to be refused during self-exclusion")
+
+		_, err = selfExcludeUC.Execute(ctx, &SelfExcludeRequest{PlayerID: "player2", Type: model.ExclusionCoolOff, Days: 42})
+		assert.Equal(t, ErrExclusionCannotBeShortened, err, "Expected a self-exclusion not to be replaced by a cool-off")
+
+		err = liftUC.Execute(adminCtx, &LiftSelfExclusionRequest{PlayerID: "player2"})
+		assert.NoError(t, err, "Expected admins to lift fixed-term exclusions")
+
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "player2@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected login to be allowed after the exclusion is lifted")
+	})
+
+	t.Run("Execute_PermanentExclusion", func(t *testing.T) {
+		_, err := selfExcludeUC.Execute(ctx, &SelfExcludeRequest{PlayerID: "player3", Type: model.ExclusionSelfExclusion, Permanent: true})
+		assert.NoError(t, err, "Expected no error self-excluding permanently")
+
+		err = liftUC.Execute(adminCtx, &LiftSelfExclusionRequest{PlayerID: "player3"})
+		assert.Equal(t, ErrPermanentExclusion, err, "Expected admins not to lift permanent exclusions")
+
+		_, err = selfExcludeUC.Execute(ctx, &SelfExcludeRequest{PlayerID: "player3", Type: model.ExclusionSelfExclusion, Days: 365})
+		assert.Equal(t, ErrExclusionCannotBeShortened, err, "Expected a permanent exclusion not to be shortened")
+
+		now = now.AddDate(10, 0, 0)
+		_, err = loginUC.Execute(ctx, &LoginRequest{Email: "player3@email.com", Password: "password"})
+		assert.Equal(t, ErrSelfExcluded, err, "Expected a permanent exclusion never to expire")
+	})
+
+	t.Run("Execute_InvalidDuration", func(t *testing.T) {
+		_, err := selfExcludeUC.Execute(ctx, &SelfExcludeRequest{PlayerID: "player1", Type: model.ExclusionCoolOff, Days: 60})
+		assert.Equal(t, ErrValidate, err, "Expected cool-offs above 42 days to be rejected")
+
+		_, err = selfExcludeUC.Execute(ctx, &SelfExcludeRequest{PlayerID: "player1", Type: model.ExclusionCoolOff, Permanent: true})
+		assert.Equal(t, ErrValidate, err, "Expected permanent cool-offs to be rejected")
+	})
+}
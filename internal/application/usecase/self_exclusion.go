@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+var (
+	ErrSelfExcluded               = errors.New("account is self-excluded")
+	ErrExclusionCannotBeShortened = errors.New("an active exclusion cannot be shortened")
+	ErrPermanentExclusion         = errors.New("permanent exclusion cannot be lifted")
+)
+
+// activeSelfExclusion devolve a exclusão vigente do jogador ou nil.
+func activeSelfExclusion(ctx context.Context, repo repository.SelfExclusionRepository, playerID string, now time.Time) (*model.SelfExclusion, error) {
+	exclusion, err := repo.GetSelfExclusion(ctx, playerID)
+	if err == repository.ErrSelfExclusionNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !exclusion.IsActive(now) {
+		return nil, nil
+	}
+	return exclusion, nil
+}
+
+// guardSelfExclusion bloqueia jogadas e depósitos durante qualquer exclusão.
+func guardSelfExclusion(ctx context.Context, repo repository.SelfExclusionRepository, playerID string, now time.Time) error {
+	exclusion, err := activeSelfExclusion(ctx, repo, playerID, now)
+	if err != nil {
+		return err
+	}
+	if exclusion != nil {
+		return ErrSelfExcluded
+	}
+	return nil
+}
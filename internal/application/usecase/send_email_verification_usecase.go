@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+var (
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+)
+
+const defaultEmailVerificationTokenDuration = 24 * time.Hour
+
+type SendEmailVerificationUseCase struct {
+	PlayerRepo      repository.PlayerRepository
+	ActionTokenRepo repository.ActionTokenRepository
+	Notifier        ports.Notifier
+	TokenDuration   time.Duration
+	now             func() time.Time
+}
+
+type SendEmailVerificationRequest struct {
+	PlayerID string `json:"-"`
+}
+
+func NewSendEmailVerificationUseCase(playerRepo repository.PlayerRepository, tokenRepo repository.ActionTokenRepository, notifier ports.Notifier) *SendEmailVerificationUseCase {
+	return &SendEmailVerificationUseCase{
+		PlayerRepo:      playerRepo,
+		ActionTokenRepo: tokenRepo,
+		Notifier:        notifier,
+		TokenDuration:   defaultEmailVerificationTokenDuration,
+		now:             time.Now,
+	}
+}
+
+// Execute reenvia o token de verificação, invalidando o anterior.
+func (uc *SendEmailVerificationUseCase) Execute(ctx context.Context, req *SendEmailVerificationRequest) error {
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	if player.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+
+	return sendEmailVerification(ctx, uc.ActionTokenRepo, uc.Notifier, player, uc.TokenDuration, uc.now())
+}
+
+func sendEmailVerification(ctx context.Context, tokenRepo repository.ActionTokenRepository, notifier ports.Notifier, player *model.Player, duration time.Duration, now time.Time) error {
+	token, err := issueActionToken(ctx, tokenRepo, player.ID, model.EmailVerificationPurpose, duration, now)
+	if err != nil {
+		return err
+	}
+
+	return notifier.Notify(ctx, ports.Notification{
+		To:      player.Email,
+		Subject: "Confirme seu email",
+		Body: fmt.Sprintf(
+			"Use o código a seguir para confirmar seu email: %s\nO código expira em %d horas.",
+			token, int(duration.Hours()),
+		),
+	})
+}
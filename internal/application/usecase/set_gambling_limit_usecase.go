@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type SetGamblingLimitUseCase struct {
+	GamblingLimitRepo repository.GamblingLimitRepository
+	// IncreaseCoolOff é o tempo até um aumento ou remoção passar a valer.
+	IncreaseCoolOff time.Duration
+	now             func() time.Time
+}
+
+// SetGamblingLimitRequest define um limite. Para o tipo session, Amount é a
+// duração em minutos e Period deve ficar vazio. Amount zero remove o limite.
+type SetGamblingLimitRequest struct {
+	PlayerID string            `json:"-"`
+	Type     model.LimitType   `json:"type"`
+	Period   model.LimitPeriod `json:"period"`
+	Amount   int               `json:"amount"`
+}
+
+type SetGamblingLimitResponse struct {
+	Limit model.GamblingLimit `json:"limit"`
+}
+
+func NewSetGamblingLimitUseCase(limitRepo repository.GamblingLimitRepository) *SetGamblingLimitUseCase {
+	return &SetGamblingLimitUseCase{
+		GamblingLimitRepo: limitRepo,
+		IncreaseCoolOff:   defaultLimitIncreaseCoolOff,
+		now:               time.Now,
+	}
+}
+
+func (uc *SetGamblingLimitUseCase) Execute(ctx context.Context, req *SetGamblingLimitRequest) (*SetGamblingLimitResponse, error) {
+	if !isValidLimit(req.Type, req.Period) || req.Amount < 0 {
+		return nil, ErrValidate
+	}
+
+	now := uc.now()
+	limit, err := uc.GamblingLimitRepo.GetGamblingLimit(ctx, req.PlayerID, req.Type, req.Period)
+	if err != nil && err != repository.ErrGamblingLimitNotFound {
+		return nil, err
+	}
+	if limit == nil {
+		limit = &model.GamblingLimit{
+			PlayerID: req.PlayerID,
+			Type:     req.Type,
+			Period:   req.Period,
+		}
+	}
+	limit.ApplyPending(now)
+
+	// Tornar o limite mais restritivo vale na hora; afrouxá-lo só depois do
+	// período de espera.
+	if req.Amount > 0 && (!limit.IsActive() || req.Amount <= limit.Amount) {
+		limit.Amount = req.Amount
+		limit.PendingAmount = nil
+		limit.PendingEffectiveAt = nil
+	} else if limit.IsActive() {
+		amount := req.Amount
+		effectiveAt := now.Add(uc.IncreaseCoolOff)
+		limit.PendingAmount = &amount
+		limit.PendingEffectiveAt = &effectiveAt
+	}
+	limit.UpdatedAt = now
+
+	if err := uc.GamblingLimitRepo.SaveGamblingLimit(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	return &SetGamblingLimitResponse{
+		Limit: *limit,
+	}, nil
+}
+
+func isValidLimit(limitType model.LimitType, period model.LimitPeriod) bool {
+	switch limitType {
+	case model.LimitDeposit, model.LimitLoss, model.LimitWager:
+		return period.Duration() > 0
+	case model.LimitSession:
+		return period == ""
+	}
+	return false
+}
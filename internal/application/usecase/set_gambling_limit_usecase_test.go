@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"math/rand"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetGamblingLimitUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	txRepo := repository_in_memory.NewInMemoryTransactionRepository()
+	limitRepo := repository_in_memory.NewInMemoryGamblingLimitRepository()
+	sessionRepo := repository_in_memory.NewInMemoryPlaySessionRepository()
+
+	now := time.Date(2025, 2, 21, 9, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	setLimitUC := NewSetGamblingLimitUseCase(limitRepo)
+	setLimitUC.now = clock
+	getLimitsUC := NewGetGamblingLimitsUseCase(limitRepo)
+	getLimitsUC.now = clock
+	depositUC := NewDepositUseCase(playerRepo, txRepo, limitRepo, repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	depositUC.now = clock
+	playUC := NewPlayUseCase(playerRepo, slotRepo, txRepo, limitRepo, sessionRepo, repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemorySpinRepository(), repository_in_memory.NewInMemoryJackpotRepository(repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)), repository_in_memory.NewInMemoryFreeSpinRepository(), repository_in_memory.NewInMemoryOutboxRepository(), repository_in_memory.NewInMemoryTransactor())
+	playUC.now = clock
+	playUC.rng = rand.New(rand.NewSource(0))
+
+	for _, id := range []string{"player1", "player2", "player3", "player4"} {
+		err := playerRepo.CreatePlayer(ctx, &model.Player{ID: id, Balance: 1000, EmailVerified: true})
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+	}
+
+	err := slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{
+		ID:           "machine1",
+		MultipleGain: 2,
+		Balance:      5000,
+		Strips:       fixedStrips("A", "B", "C"),
+	})
+	assert.NoError(t, err, "Erro ao criar máquina de slot para testes")
+
+	t.Run("Execute_DecreaseAppliesImmediately", func(t *testing.T) {
+		_, err := setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player1", Type: model.LimitDeposit, Period: model.PeriodDaily, Amount: 500})
+		assert.NoError(t, err, "Expected no error setting a new limit")
+
+		resp, err := setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player1", Type: model.LimitDeposit, Period: model.PeriodDaily, Amount: 300})
+		assert.NoError(t, err, "Expected no error decreasing a limit")
+		assert.Equal(t, 300, resp.Limit.Amount, "Expected the decrease to apply immediately")
+		assert.Nil(t, resp.Limit.PendingAmount, "Expected no pending change")
+	})
+
+	t.Run("Execute_IncreaseWaitsForCoolOff", func(t *testing.T) {
+		resp, err := setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player1", Type: model.LimitDeposit, Period: model.PeriodDaily, Amount: 800})
+		assert.NoError(t, err, "Expected no error increasing a limit")
+		assert.Equal(t, 300, resp.Limit.Amount, "Expected the current limit to be kept during the cool-off")
+		assert.Equal(t, 800, *resp.Limit.PendingAmount, "Expected the increase to be pending")
+
+		_, err = depositUC.Execute(ctx, &DepositRequest{PlayerID: "player1", Amount: 400})
+		assert.Equal(t, ErrDepositLimitExceeded, err, "Expected the old limit to be enforced during the cool-off")
+
+		now = now.Add(setLimitUC.IncreaseCoolOff)
+
+		limits, err := getLimitsUC.Execute(ctx, &GetGamblingLimitsRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error listing limits")
+		assert.Len(t, limits.Limits, 1)
+		assert.Equal(t, 800, limits.Limits[0].Amount, "Expected the increase to apply after the cool-off")
+
+		resp2, err := depositUC.Execute(ctx, &DepositRequest{PlayerID: "player1", Amount: 400})
+		assert.NoError(t, err, "Expected the deposit to fit the new limit")
+		assert.Equal(t, 1400, resp2.Balance)
+
+		_, err = depositUC.Execute(ctx, &DepositRequest{PlayerID: "player1", Amount: 401})
+		assert.Equal(t, ErrDepositLimitExceeded, err, "Expected deposits within the window to be summed")
+	})
+
+	t.Run("Execute_InvalidLimit", func(t *testing.T) {
+		_, err := setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player1", Type: model.LimitLoss, Amount: 100})
+		assert.Equal(t, ErrValidate, err, "Expected money limits to require a period")
+
+		_, err = setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player1", Type: model.LimitSession, Period: model.PeriodDaily, Amount: 60})
+		assert.Equal(t, ErrValidate, err, "Expected session limits to reject a period")
+	})
+
+	t.Run("Deposit_ConcurrentLimit", func(t *testing.T) {
+		err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player5", Balance: 0, EmailVerified: true})
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+		_, err = setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player5", Type: model.LimitDeposit, Period: model.PeriodDaily, Amount: 300})
+		assert.NoError(t, err, "Erro ao definir limite de depósito")
+
+		concurrentUC := NewDepositUseCase(playerRepo, &slowSumTransactionRepository{TransactionRepository: txRepo}, limitRepo, repository_in_memory.NewInMemorySelfExclusionRepository(), repository_in_memory.NewInMemoryOutboxRepository(), &serialTransactor{})
+		concurrentUC.now = clock
+
+		var (
+			wg       sync.WaitGroup
+			accepted atomic.Int32
+		)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := concurrentUC.Execute(ctx, &DepositRequest{PlayerID: "player5", Amount: 100}); err == nil {
+					accepted.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(3), accepted.Load(), "Expected concurrent deposits to stay within the limit")
+		player, _ := playerRepo.GetPlayer(ctx, "player5")
+		assert.Equal(t, 300, player.Balance)
+	})
+
+	t.Run("Play_LossLimit", func(t *testing.T) {
+		_, err := setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player2", Type: model.LimitLoss, Period: model.PeriodWeekly, Amount: 250})
+		assert.NoError(t, err, "Erro ao definir limite de perda")
+
+		for i := 0; i < 2; i++ {
+			_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player2", MachineID: "machine1", AmountBet: 100})
+			assert.NoError(t, err, "Expected spins within the loss limit to be accepted")
+		}
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player2", MachineID: "machine1", AmountBet: 100})
+		assert.Equal(t, ErrLossLimitExceeded, err, "Expected a spin that could exceed the loss limit to be rejected")
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player2", MachineID: "machine1", AmountBet: 50})
+		assert.NoError(t, err, "Expected a smaller bet that fits the limit to be accepted")
+	})
+
+	t.Run("Play_WagerLimit", func(t *testing.T) {
+		_, err := setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player3", Type: model.LimitWager, Period: model.PeriodDaily, Amount: 150})
+		assert.NoError(t, err, "Erro ao definir limite de apostas")
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player3", MachineID: "machine1", AmountBet: 100})
+		assert.NoError(t, err)
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player3", MachineID: "machine1", AmountBet: 100})
+		assert.Equal(t, ErrWagerLimitExceeded, err, "Expected the wager limit to be enforced")
+	})
+
+	t.Run("Play_SessionLimit", func(t *testing.T) {
+		_, err := setLimitUC.Execute(ctx, &SetGamblingLimitRequest{PlayerID: "player4", Type: model.LimitSession, Amount: 30})
+		assert.NoError(t, err, "Erro ao definir limite de sessão")
+
+		for i := 0; i < 3; i++ {
+			_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player4", MachineID: "machine1", AmountBet: 10})
+			assert.NoError(t, err, "Expected spins within the session limit to be accepted")
+			now = now.Add(10 * time.Minute)
+		}
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player4", MachineID: "machine1", AmountBet: 10})
+		assert.Equal(t, ErrSessionLimitReached, err, "Expected the session to be over after 30 minutes")
+
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player4", MachineID: "machine1", AmountBet: 10})
+		assert.Equal(t, ErrSessionLimitReached, err, "Expected spins to stay blocked until the player takes a break")
+
+		now = now.Add(playUC.SessionIdleTimeout + time.Minute)
+		_, err = playUC.Execute(ctx, &PlayRequest{PlayerID: "player4", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err, "Expected a new session after a break")
+	})
+}
+
+// serialTransactor executa uma transação por vez, como o bloqueio da linha
+// do jogador faz no Postgres.
+type serialTransactor struct {
+	mu sync.Mutex
+}
+
+func (s *serialTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(ctx)
+}
+
+// slowSumTransactionRepository atrasa as somas para que verificações de limite
+// concorrentes de fato se sobreponham.
+type slowSumTransactionRepository struct {
+	repository.TransactionRepository
+}
+
+func (r *slowSumTransactionRepository) SumTransactions(ctx context.Context, playerID string, txType model.TransactionType, since time.Time) (int, error) {
+	sum, err := r.TransactionRepository.SumTransactions(ctx, playerID, txType, since)
+	time.Sleep(time.Millisecond)
+	return sum, err
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/repository"
+)
+
+const (
+	minRealityCheckMinutes = 5
+	maxRealityCheckMinutes = 240
+)
+
+type SetRealityCheckUseCase struct {
+	PlayerRepo repository.PlayerRepository
+}
+
+// SetRealityCheckRequest define o intervalo dos avisos em minutos; zero
+// desativa os avisos.
+type SetRealityCheckRequest struct {
+	PlayerID        string `json:"-"`
+	IntervalMinutes int    `json:"interval_minutes"`
+}
+
+type SetRealityCheckResponse struct {
+	IntervalMinutes int `json:"interval_minutes"`
+}
+
+func NewSetRealityCheckUseCase(playerRepo repository.PlayerRepository) *SetRealityCheckUseCase {
+	return &SetRealityCheckUseCase{
+		PlayerRepo: playerRepo,
+	}
+}
+
+func (uc *SetRealityCheckUseCase) Execute(ctx context.Context, req *SetRealityCheckRequest) (*SetRealityCheckResponse, error) {
+	if req.IntervalMinutes != 0 && (req.IntervalMinutes < minRealityCheckMinutes || req.IntervalMinutes > maxRealityCheckMinutes) {
+		return nil, ErrValidate
+	}
+
+	if err := uc.PlayerRepo.SetRealityCheckMinutes(ctx, req.PlayerID, req.IntervalMinutes); err != nil {
+		return nil, err
+	}
+
+	return &SetRealityCheckResponse{
+		IntervalMinutes: req.IntervalMinutes,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SettleTournamentsUseCase struct {
+	TournamentRepo  repository.TournamentRepository
+	PlayerRepo      repository.PlayerRepository
+	TransactionRepo repository.TransactionRepository
+	TreasuryRepo    repository.TreasuryRepository
+	OutboxRepo      repository.OutboxRepository
+	Transactor      ports.Transactor
+	// Events recebe o novo saldo dos premiados.
+	Events ports.EventPublisher
+	now    func() time.Time
+}
+
+// SettleTournamentsResponse conta os torneios encerrados e o total pago em
+// prêmios nesta execução. Unfunded lista os torneios que a tesouraria ainda
+// não cobre; eles continuam abertos e são tentados de novo na próxima.
+type SettleTournamentsResponse struct {
+	Settled    int      `json:"settled"`
+	PrizesPaid int      `json:"prizes_paid"`
+	Unfunded   []string `json:"unfunded,omitempty"`
+}
+
+func NewSettleTournamentsUseCase(tournamentRepo repository.TournamentRepository, playerRepo repository.PlayerRepository, txRepo repository.TransactionRepository, treasuryRepo repository.TreasuryRepository, outboxRepo repository.OutboxRepository, transactor ports.Transactor) *SettleTournamentsUseCase {
+	return &SettleTournamentsUseCase{
+		TournamentRepo:  tournamentRepo,
+		PlayerRepo:      playerRepo,
+		TransactionRepo: txRepo,
+		TreasuryRepo:    treasuryRepo,
+		OutboxRepo:      outboxRepo,
+		Transactor:      transactor,
+		now:             time.Now,
+	}
+}
+
+// Execute encerra os torneios que já terminaram, cada um em uma transação:
+// marca o torneio, paga os prêmios da tesouraria para os saldos dos
+// jogadores e grava o evento de domínio. Um torneio sem fundos na tesouraria
+// é pulado sem impedir os demais; outros erros interrompem a execução e os
+// torneios restantes ficam para a próxima.
+func (uc *SettleTournamentsUseCase) Execute(ctx context.Context) (*SettleTournamentsResponse, error) {
+	now := uc.now()
+	tournaments, err := uc.TournamentRepo.ListDueTournaments(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SettleTournamentsResponse{}
+	for _, tournament := range tournaments {
+		var events []model.Event
+		paid := 0
+		err := uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			events, paid, err = uc.settle(ctx, tournament, now)
+			return err
+		})
+		if err == repository.ErrTournamentSettled {
+			continue
+		}
+		if err == repository.ErrInsufficientTreasuryBalance {
+			resp.Unfunded = append(resp.Unfunded, tournament.ID)
+			continue
+		}
+		if err != nil {
+			return resp, err
+		}
+		publishEvents(uc.Events, events)
+		resp.Settled++
+		resp.PrizesPaid += paid
+	}
+	return resp, nil
+}
+
+func (uc *SettleTournamentsUseCase) settle(ctx context.Context, tournament *model.Tournament, now time.Time) ([]model.Event, int, error) {
+	entries, err := uc.TournamentRepo.ListEntries(ctx, tournament.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	tournament.Rank(entries)
+
+	var winners []model.TournamentWinner
+	total := 0
+	for _, entry := range entries {
+		if entry.Prize > 0 {
+			winners = append(winners, model.TournamentWinner{PlayerID: entry.PlayerID, Rank: entry.Rank, Prize: entry.Prize})
+			total += entry.Prize
+		}
+	}
+	// Confere a tesouraria antes de qualquer escrita, para não encerrar um
+	// torneio que não pode ser pago.
+	treasury, err := uc.TreasuryRepo.GetTreasury(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if treasury.Balance < total {
+		return nil, 0, repository.ErrInsufficientTreasuryBalance
+	}
+
+	if err := uc.TournamentRepo.SettleTournament(ctx, tournament.ID, now); err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]model.Event, 0, len(winners))
+	for _, winner := range winners {
+		err := uc.TreasuryRepo.ApplyMovement(ctx, &model.TreasuryMovement{
+			ID:        uuid.New().String(),
+			Type:      model.MovementTournamentPrize,
+			Amount:    winner.Prize,
+			Reason:    "tournament " + tournament.ID + " prize",
+			Actor:     winner.PlayerID,
+			CreatedAt: now,
+		}, 0)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		balance, err := uc.PlayerRepo.AdjustBalance(ctx, winner.PlayerID, winner.Prize)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := recordTransaction(ctx, uc.TransactionRepo, winner.PlayerID, model.TransactionTournamentPrize, winner.Prize, "", now); err != nil {
+			return nil, 0, err
+		}
+		events = append(events, newEvent(model.EventBalanceUpdated, winner.PlayerID, model.BalanceUpdate{
+			Balance: balance,
+			Delta:   winner.Prize,
+			Reason:  model.BalanceReasonTournament,
+		}, now))
+	}
+
+	if winners == nil {
+		winners = []model.TournamentWinner{}
+	}
+	err = recordDomainEvent(ctx, uc.OutboxRepo, model.DomainEventTournamentSettled, "tournament", tournament.ID, model.TournamentSettledPayload{
+		TournamentID: tournament.ID,
+		PrizePool:    tournament.PrizePool(),
+		Entrants:     tournament.Entrants,
+		Winners:      winners,
+	}, now)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
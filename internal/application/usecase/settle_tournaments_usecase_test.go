@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"math/rand"
+	"slot-machine/internal/domain/contextkeys"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettleTournamentsUseCase(t *testing.T) {
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	slotRepo := repository_in_memory.NewInMemorySlotMachineRepository()
+	txRepo := repository_in_memory.NewInMemoryTransactionRepository()
+	apiKeyRepo := repository_in_memory.NewInMemoryAPIKeyRepository()
+	auditRepo := repository_in_memory.NewInMemoryAuditRepository()
+	limitRepo := repository_in_memory.NewInMemoryGamblingLimitRepository()
+	exclusionRepo := repository_in_memory.NewInMemorySelfExclusionRepository()
+	treasuryRepo := repository_in_memory.NewInMemoryTreasuryRepository(slotRepo)
+	adjustmentRepo := repository_in_memory.NewInMemoryAdjustmentRepository(playerRepo, slotRepo, txRepo, treasuryRepo)
+	spinRepo := repository_in_memory.NewInMemorySpinRepository()
+	jackpotRepo := repository_in_memory.NewInMemoryJackpotRepository(treasuryRepo)
+	outboxRepo := repository_in_memory.NewInMemoryOutboxRepository()
+	tournamentRepo := repository_in_memory.NewInMemoryTournamentRepository()
+	transactor := repository_in_memory.NewInMemoryTransactor()
+
+	now := time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	createUC := NewCreateTournamentUseCase(tournamentRepo, slotRepo, auditRepo, transactor)
+	createUC.now = clock
+	joinUC := NewJoinTournamentUseCase(tournamentRepo, playerRepo, txRepo, treasuryRepo, limitRepo, exclusionRepo, outboxRepo, transactor)
+	joinUC.now = clock
+	leaderboardUC := NewGetLeaderboardUseCase(tournamentRepo)
+	settleUC := NewSettleTournamentsUseCase(tournamentRepo, playerRepo, txRepo, treasuryRepo, outboxRepo, transactor)
+	settleUC.now = clock
+	events := &recordingPublisher{}
+	settleUC.Events = events
+	playUC := NewPlayUseCase(playerRepo, slotRepo, txRepo, limitRepo, repository_in_memory.NewInMemoryPlaySessionRepository(), exclusionRepo, spinRepo, jackpotRepo,
+		repository_in_memory.NewInMemoryFreeSpinRepository(), outboxRepo, transactor)
+	playUC.rng = rand.New(rand.NewSource(1))
+	playUC.now = clock
+	playUC.TournamentRepo = tournamentRepo
+
+	adminCtx := func(userID string) context.Context {
+		ctx := context.WithValue(context.Background(), contextkeys.ContextKeyUserID, userID)
+		return context.WithValue(ctx, contextkeys.ContextKeyIsAdmin, true)
+	}
+	ctx := adminCtx("admin1")
+
+	depositUC := NewDepositUseCase(playerRepo, txRepo, limitRepo, exclusionRepo, outboxRepo, transactor)
+	for _, id := range []string{"player1", "player2", "player3", "player4"} {
+		err := playerRepo.CreatePlayer(ctx, &model.Player{ID: id, EmailVerified: true})
+		assert.NoError(t, err, "Erro ao criar jogador para testes")
+		_, err = depositUC.Execute(ctx, &DepositRequest{PlayerID: id, Amount: 1000})
+		assert.NoError(t, err, "Erro ao depositar para testes")
+	}
+	for _, id := range []string{"machine1", "machine2"} {
+		err := slotRepo.CreateSlotMachine(ctx, &model.SlotMachine{ID: id, MultipleGain: 2, Balance: 100000, InitialBalance: 100000, Strips: fixedStrips("A", "B", "C")})
+		assert.NoError(t, err, "Erro ao criar máquina para testes")
+	}
+
+	adjustment, err := NewProposeAdjustmentUseCase(adjustmentRepo, playerRepo, slotRepo, apiKeyRepo, auditRepo, transactor).Execute(ctx, &ProposeAdjustmentRequest{
+		TargetType: model.AdjustmentTargetTreasury,
+		Amount:     100,
+		ReasonCode: model.ReasonTreasuryFunding,
+	})
+	assert.NoError(t, err, "Erro ao propor ajuste da tesouraria")
+	_, err = NewApproveAdjustmentUseCase(adjustmentRepo, apiKeyRepo, auditRepo, playerRepo, outboxRepo, transactor).Execute(adminCtx("admin2"), &ApproveAdjustmentRequest{ID: adjustment.ID})
+	assert.NoError(t, err, "Erro ao aprovar ajuste da tesouraria")
+
+	valid := CreateTournamentRequest{
+		Name:              "Torneio de sábado",
+		MachineIDs:        []string{"machine1"},
+		StartsAt:          now.Add(-time.Hour),
+		EndsAt:            now.Add(time.Hour),
+		EntryFee:          50,
+		GuaranteedPrize:   100,
+		PrizeDistribution: []int{70, 30},
+		Scoring:           model.TournamentScoringTotalWagered,
+	}
+	var tournament *model.Tournament
+
+	t.Run("Execute_RejectsInvalidTournaments", func(t *testing.T) {
+		invalid := []func(req *CreateTournamentRequest){
+			func(req *CreateTournamentRequest) { req.Name = " " },
+			func(req *CreateTournamentRequest) { req.MachineIDs = nil },
+			func(req *CreateTournamentRequest) { req.EndsAt = req.StartsAt },
+			func(req *CreateTournamentRequest) { req.EndsAt = now.Add(-time.Minute) },
+			func(req *CreateTournamentRequest) { req.EntryFee = -1 },
+			func(req *CreateTournamentRequest) { req.PrizeDistribution = []int{80, 30} },
+			func(req *CreateTournamentRequest) { req.PrizeDistribution = []int{100, 0} },
+			func(req *CreateTournamentRequest) { req.Scoring = "most_spins" },
+		}
+		for _, change := range invalid {
+			req := valid
+			change(&req)
+			_, err := createUC.Execute(ctx, &req)
+			assert.Equal(t, ErrValidate, err, "Esperava-se erro de validação para %+v", req)
+		}
+
+		req := valid
+		req.MachineIDs = []string{"machine9"}
+		_, err := createUC.Execute(ctx, &req)
+		assert.Equal(t, repository.ErrSlotMachineNotFound, err)
+	})
+
+	t.Run("Execute_Create", func(t *testing.T) {
+		req := valid
+		req.MachineIDs = []string{"machine1", "machine1"}
+		tournament, err = createUC.Execute(ctx, &req)
+		assert.NoError(t, err, "Esperava-se nenhum erro ao criar o torneio")
+		assert.Equal(t, []string{"machine1"}, tournament.MachineIDs, "Máquinas repetidas devem ser ignoradas")
+		assert.Equal(t, model.TournamentStatusOpen, tournament.Status)
+		assert.Equal(t, "admin1", tournament.CreatedBy)
+
+		entries, err := auditRepo.ListAuditEntries(ctx, repository.AuditFilter{Action: AuditActionTournamentCreate})
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1, "A criação deve ser auditada")
+	})
+
+	t.Run("Execute_Join", func(t *testing.T) {
+		for _, id := range []string{"player1", "player2", "player3"} {
+			resp, err := joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: tournament.ID, PlayerID: id})
+			assert.NoError(t, err, "Esperava-se nenhum erro na inscrição")
+			assert.Equal(t, 950, resp.PlayerBalance, "A inscrição deve ser debitada do saldo")
+		}
+
+		_, err := joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: tournament.ID, PlayerID: "player1"})
+		assert.Equal(t, repository.ErrTournamentEntryExists, err)
+		_, err = joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: "missing", PlayerID: "player1"})
+		assert.Equal(t, repository.ErrTournamentNotFound, err)
+
+		treasury, err := treasuryRepo.GetTreasury(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 250, treasury.Balance, "As inscrições devem ir para a tesouraria")
+
+		pending, err := outboxRepo.ListPendingEvents(ctx, 100)
+		assert.NoError(t, err)
+		joined := 0
+		for _, event := range pending {
+			if event.Type == model.DomainEventTournamentJoined {
+				joined++
+			}
+		}
+		assert.Equal(t, 3, joined, "Cada inscrição deve gravar um evento no outbox")
+	})
+
+	t.Run("Execute_PlayScoresEligibleSpins", func(t *testing.T) {
+		for _, req := range []*PlayRequest{
+			{PlayerID: "player1", MachineID: "machine1", AmountBet: 10},
+			{PlayerID: "player1", MachineID: "machine1", AmountBet: 10},
+			{PlayerID: "player1", MachineID: "machine1", AmountBet: 10},
+			{PlayerID: "player2", MachineID: "machine1", AmountBet: 20},
+			{PlayerID: "player2", MachineID: "machine2", AmountBet: 50},
+			{PlayerID: "player4", MachineID: "machine1", AmountBet: 10},
+		} {
+			_, err := playUC.Execute(context.Background(), req)
+			assert.NoError(t, err, "Esperava-se nenhum erro na jogada")
+		}
+
+		resp, err := leaderboardUC.Execute(context.Background(), &GetLeaderboardRequest{TournamentID: tournament.ID, PlayerID: "player3"})
+		assert.NoError(t, err, "Esperava-se nenhum erro na classificação")
+		assert.Equal(t, 250, resp.PrizePool, "Prêmio garantido mais três inscrições")
+		assert.Len(t, resp.Leaderboard, 3, "Apenas os inscritos entram na classificação")
+
+		first, second, third := resp.Leaderboard[0], resp.Leaderboard[1], resp.Leaderboard[2]
+		assert.Equal(t, "player1", first.PlayerID)
+		assert.Equal(t, 30, first.Score)
+		assert.Equal(t, 3, first.Spins)
+		assert.Equal(t, 175, first.Prize)
+		assert.Equal(t, "player2", second.PlayerID)
+		assert.Equal(t, 20, second.Score, "Jogadas em máquinas fora do torneio não pontuam")
+		assert.Equal(t, 75, second.Prize)
+		assert.Equal(t, 0, third.Prize, "Quem não jogou não concorre a prêmios")
+		assert.Equal(t, "player3", resp.PlayerEntry.PlayerID)
+		assert.Equal(t, 3, resp.PlayerEntry.Rank)
+
+		resp, err = leaderboardUC.Execute(context.Background(), &GetLeaderboardRequest{TournamentID: tournament.ID, PlayerID: "player3", Limit: 1})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Leaderboard, 1)
+		assert.Equal(t, 3, resp.PlayerEntry.Rank, "A colocação do jogador vem mesmo fora do limite")
+	})
+
+	t.Run("Execute_WaitsForTheEnd", func(t *testing.T) {
+		resp, err := settleUC.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, resp.Settled, "Torneios em andamento não são encerrados")
+	})
+
+	t.Run("Execute_PaysPrizes", func(t *testing.T) {
+		now = now.Add(time.Hour)
+
+		_, err := joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: tournament.ID, PlayerID: "player4"})
+		assert.Equal(t, ErrTournamentClosed, err, "Inscrições fecham no fim do torneio")
+
+		resp, err := settleUC.Execute(context.Background())
+		assert.NoError(t, err, "Esperava-se nenhum erro no encerramento")
+		assert.Equal(t, &SettleTournamentsResponse{Settled: 1, PrizesPaid: 250}, resp)
+
+		player1, _ := playerRepo.GetPlayer(ctx, "player1")
+		player2, _ := playerRepo.GetPlayer(ctx, "player2")
+		assert.Equal(t, 950-30+175, player1.Balance)
+		assert.Equal(t, 950-20-50+75, player2.Balance)
+		assert.Len(t, events.events, 2, "Cada premiado deve receber o novo saldo")
+
+		settled, err := tournamentRepo.GetTournament(ctx, tournament.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, model.TournamentStatusSettled, settled.Status)
+
+		pending, err := outboxRepo.ListPendingEvents(ctx, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, model.DomainEventTournamentSettled, pending[len(pending)-1].Type)
+
+		resp, err = settleUC.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, resp.Settled, "Um torneio encerrado não paga duas vezes")
+	})
+
+	t.Run("Execute_LedgerStaysBalanced", func(t *testing.T) {
+		reconciliationRepo := repository_in_memory.NewInMemoryReconciliationRepository(playerRepo, slotRepo, txRepo, adjustmentRepo, treasuryRepo, spinRepo, jackpotRepo)
+		snapshot, err := reconciliationRepo.LoadLedgerSnapshot(ctx)
+		assert.NoError(t, err)
+
+		entries, totals := snapshot.Reconcile()
+		for _, entry := range entries {
+			assert.True(t, entry.Balanced(), "Conta divergente: %+v", entry)
+		}
+		assert.Equal(t, 0, totals.Difference)
+	})
+
+	t.Run("Execute_RejectsUnfundedPrizes", func(t *testing.T) {
+		req := valid
+		req.StartsAt = now.Add(-time.Minute)
+		req.EndsAt = now.Add(time.Minute)
+		req.EntryFee = 0
+		req.GuaranteedPrize = 1000
+		unfunded, err := createUC.Execute(ctx, &req)
+		assert.NoError(t, err)
+		_, err = joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: unfunded.ID, PlayerID: "player1"})
+		assert.NoError(t, err)
+		_, err = playUC.Execute(context.Background(), &PlayRequest{PlayerID: "player1", MachineID: "machine1", AmountBet: 10})
+		assert.NoError(t, err)
+
+		req.GuaranteedPrize = 0
+		free, err := createUC.Execute(ctx, &req)
+		assert.NoError(t, err)
+		_, err = joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: free.ID, PlayerID: "player2"})
+		assert.NoError(t, err)
+
+		now = now.Add(time.Minute)
+		resp, err := settleUC.Execute(context.Background())
+		assert.NoError(t, err, "Um torneio sem fundos não deve interromper os demais")
+		assert.Equal(t, []string{unfunded.ID}, resp.Unfunded)
+		assert.Equal(t, 1, resp.Settled, "Os demais torneios devem ser encerrados")
+
+		stillOpen, err := tournamentRepo.GetTournament(ctx, unfunded.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, model.TournamentStatusOpen, stillOpen.Status, "O torneio fica aberto até a tesouraria cobrir os prêmios")
+	})
+
+	t.Run("Execute_EntryFeesCountTowardLimits", func(t *testing.T) {
+		req := valid
+		req.StartsAt = now.Add(-time.Minute)
+		req.EndsAt = now.Add(time.Hour)
+		req.GuaranteedPrize = 0
+		limited, err := createUC.Execute(ctx, &req)
+		assert.NoError(t, err)
+
+		err = limitRepo.SaveGamblingLimit(ctx, &model.GamblingLimit{PlayerID: "player4", Type: model.LimitWager, Period: model.PeriodDaily, Amount: 40})
+		assert.NoError(t, err)
+		_, err = joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: limited.ID, PlayerID: "player4"})
+		assert.Equal(t, ErrWagerLimitExceeded, err, "A inscrição deve respeitar o limite de apostas")
+
+		err = limitRepo.SaveGamblingLimit(ctx, &model.GamblingLimit{PlayerID: "player4", Type: model.LimitWager, Period: model.PeriodDaily, Amount: 60})
+		assert.NoError(t, err)
+		_, err = joinUC.Execute(context.Background(), &JoinTournamentRequest{TournamentID: limited.ID, PlayerID: "player4"})
+		assert.NoError(t, err)
+
+		_, err = playUC.Execute(context.Background(), &PlayRequest{PlayerID: "player4", MachineID: "machine1", AmountBet: 20})
+		assert.Equal(t, ErrWagerLimitExceeded, err, "A inscrição já paga deve contar no limite de apostas")
+	})
+}
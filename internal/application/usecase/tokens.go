@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+// generateSecureToken devolve size bytes aleatórios codificados em hexadecimal.
+func generateSecureToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// hashToken é usado para persistir tokens de uso único sem guardar o valor original.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueActionToken substitui os tokens pendentes do jogador para o propósito
+// informado e devolve o novo token em texto claro.
+func issueActionToken(ctx context.Context, repo repository.ActionTokenRepository, playerID string, purpose model.TokenPurpose, duration time.Duration, now time.Time) (string, error) {
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	if err := repo.DeletePlayerActionTokens(ctx, playerID, purpose); err != nil {
+		return "", err
+	}
+
+	err = repo.StoreActionToken(ctx, &model.ActionToken{
+		TokenHash: hashToken(token),
+		PlayerID:  playerID,
+		Purpose:   purpose,
+		ExpiresAt: now.Add(duration),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func issueTokenPair(ctx context.Context, jwtManager ports.JWTManager, refreshRepo repository.RefreshTokenRepository, playerID string) (string, string, error) {
+	accessToken, err := jwtManager.GenerateAccessToken(playerID)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, err := jwtManager.GenerateRefreshToken(playerID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := refreshRepo.StoreRefreshToken(ctx, playerID, refreshToken); err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+// scoreTournaments soma a jogada aos torneios em andamento na máquina em que
+// o jogador está inscrito.
+func scoreTournaments(ctx context.Context, repo repository.TournamentRepository, playerID, machineID string, totalBet, wagered, payout int, now time.Time) error {
+	tournaments, err := repo.ListRunningTournaments(ctx, machineID, now)
+	if err != nil {
+		return err
+	}
+	for _, tournament := range tournaments {
+		points := tournament.Points(totalBet, wagered, payout)
+		err := repo.RecordScore(ctx, tournament.ID, playerID, tournament.Scoring, points, now)
+		if err != nil && err != repository.ErrTournamentEntryNotFound {
+			return err
+		}
+	}
+	return nil
+}
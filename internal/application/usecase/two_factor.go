@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+)
+
+var (
+	ErrInvalidMFAToken       = errors.New("invalid or expired mfa token")
+	ErrInvalidTOTPCode       = errors.New("invalid two-factor code")
+	ErrTOTPAlreadyEnabled    = errors.New("two-factor authentication already enabled")
+	ErrTOTPNotEnabled        = errors.New("two-factor authentication not enabled")
+	ErrTOTPRequiredForAdmins = errors.New("two-factor authentication is mandatory for admins")
+)
+
+const recoveryCodeCount = 10
+
+// resolveTwoFactorPlayer identifica o jogador pelo JWT de acesso (PlayerID) ou,
+// durante o login, pelo token de MFA. O segundo retorno indica se o token de
+// MFA foi usado.
+func resolveTwoFactorPlayer(jwtManager ports.JWTManager, playerID, mfaToken string) (string, bool, error) {
+	if playerID != "" {
+		return playerID, false, nil
+	}
+
+	claims, err := jwtManager.VerifyMFAToken(mfaToken)
+	if err != nil {
+		return "", false, ErrInvalidMFAToken
+	}
+	return claims.UserID, true, nil
+}
+
+// verifyTOTPCode valida o código contra o segredo do jogador e rejeita códigos
+// já utilizados. O contador aceito é gravado com um UPDATE condicional, então
+// duas requisições com o mesmo código não passam ambas.
+func verifyTOTPCode(ctx context.Context, repo repository.PlayerRepository, provider security.TOTPProvider, player *model.Player, code string, now time.Time) (bool, error) {
+	counter, ok := provider.Verify(player.TOTPSecret, strings.TrimSpace(code), now)
+	if !ok || counter <= player.TOTPLastCounter {
+		return false, nil
+	}
+	return repo.ConsumeTOTPCounter(ctx, player.ID, counter)
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw, err := generateSecureToken(6)
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, raw[0:4]+"-"+raw[4:8]+"-"+raw[8:12])
+		hashes = append(hashes, hashToken(raw))
+	}
+
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return hashToken(normalized)
+}
+
+func mfaAttemptKey(playerID string) string {
+	return "mfa:" + playerID
+}
+
+// guardMFAAttempt aplica ao segundo fator a mesma política de atrasos e bloqueio do login.
+func guardMFAAttempt(ctx context.Context, repo repository.LoginAttemptRepository, playerID string, now time.Time) error {
+	attempt, err := loadLoginAttempt(ctx, repo, mfaAttemptKey(playerID))
+	if err != nil {
+		return err
+	}
+	if now.Before(attempt.LockedUntil) {
+		return ErrAccountLocked
+	}
+	if now.Before(attempt.BlockedUntil) {
+		return ErrTooManyLoginAttempts
+	}
+	return nil
+}
+
+func registerMFAFailure(ctx context.Context, repo repository.LoginAttemptRepository, policy LoginThrottlePolicy, playerID string, now time.Time) error {
+	attempt, err := policy.recordFailure(ctx, repo, mfaAttemptKey(playerID), policy.FreeAttempts, policy.AccountLockoutAfter, now)
+	if err != nil {
+		return err
+	}
+
+	if now.Before(attempt.LockedUntil) {
+		return ErrAccountLocked
+	}
+	return ErrInvalidTOTPCode
+}
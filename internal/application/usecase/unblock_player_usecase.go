@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type UnblockPlayerUseCase struct {
+	PlayerRepo repository.PlayerRepository
+	AuditRepo  repository.AuditRepository
+	Transactor ports.Transactor
+	now        func() time.Time
+}
+
+type UnblockPlayerRequest struct {
+	PlayerID string `json:"-"`
+}
+
+func NewUnblockPlayerUseCase(playerRepo repository.PlayerRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *UnblockPlayerUseCase {
+	return &UnblockPlayerUseCase{
+		PlayerRepo: playerRepo,
+		AuditRepo:  auditRepo,
+		Transactor: transactor,
+		now:        time.Now,
+	}
+}
+
+func (uc *UnblockPlayerUseCase) Execute(ctx context.Context, req *UnblockPlayerRequest) error {
+	if err := authorize(ctx, model.ScopePlayersWrite); err != nil {
+		return err
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	before := *player
+	after := *player
+	after.Blocked = false
+	after.BlockedReason = ""
+
+	now := uc.now()
+	return uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.PlayerRepo.SetBlocked(ctx, player.ID, false, ""); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionPlayerUnblock, "player", player.ID, before, after, now)
+	})
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+type UnlinkJackpotMachineUseCase struct {
+	JackpotRepo repository.JackpotRepository
+	AuditRepo   repository.AuditRepository
+	Transactor  ports.Transactor
+	now         func() time.Time
+}
+
+func NewUnlinkJackpotMachineUseCase(jackpotRepo repository.JackpotRepository, auditRepo repository.AuditRepository, transactor ports.Transactor) *UnlinkJackpotMachineUseCase {
+	return &UnlinkJackpotMachineUseCase{
+		JackpotRepo: jackpotRepo,
+		AuditRepo:   auditRepo,
+		Transactor:  transactor,
+		now:         time.Now,
+	}
+}
+
+// Execute desliga a máquina do pool. O valor acumulado continua no pool.
+func (uc *UnlinkJackpotMachineUseCase) Execute(ctx context.Context, req *JackpotMachineRequest) (*model.JackpotPool, error) {
+	if err := authorize(ctx, model.ScopeJackpotsManage); err != nil {
+		return nil, err
+	}
+
+	before, err := uc.JackpotRepo.GetPool(ctx, req.PoolID)
+	if err != nil {
+		return nil, err
+	}
+	var after *model.JackpotPool
+	err = uc.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.JackpotRepo.UnlinkMachine(ctx, req.PoolID, req.MachineID); err != nil {
+			return err
+		}
+		var err error
+		after, err = uc.JackpotRepo.GetPool(ctx, req.PoolID)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.AuditRepo, AuditActionJackpotUnlink, "jackpot", req.PoolID, before.MachineIDs, after.MachineIDs, uc.now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+)
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+)
+
+type VerifyEmailUseCase struct {
+	PlayerRepo      repository.PlayerRepository
+	ActionTokenRepo repository.ActionTokenRepository
+	now             func() time.Time
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+func NewVerifyEmailUseCase(playerRepo repository.PlayerRepository, tokenRepo repository.ActionTokenRepository) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		PlayerRepo:      playerRepo,
+		ActionTokenRepo: tokenRepo,
+		now:             time.Now,
+	}
+}
+
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, req *VerifyEmailRequest) error {
+	if req.Token == "" {
+		return ErrValidate
+	}
+
+	token, err := uc.ActionTokenRepo.GetActionToken(ctx, hashToken(req.Token))
+	if err != nil {
+		if err == repository.ErrActionTokenNotFound {
+			return ErrInvalidVerificationToken
+		}
+		return err
+	}
+
+	if token.Purpose != model.EmailVerificationPurpose || !uc.now().Before(token.ExpiresAt) {
+		return ErrInvalidVerificationToken
+	}
+
+	if err := uc.PlayerRepo.MarkEmailVerified(ctx, token.PlayerID); err != nil {
+		return err
+	}
+
+	return uc.ActionTokenRepo.DeletePlayerActionTokens(ctx, token.PlayerID, model.EmailVerificationPurpose)
+}
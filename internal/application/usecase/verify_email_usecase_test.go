@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyEmailUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	tokenRepo := repository_in_memory.NewInMemoryActionTokenRepository()
+	notifier := &capturingNotifier{}
+
+	sendVerificationUC := NewSendEmailVerificationUseCase(playerRepo, tokenRepo, notifier)
+	verifyEmailUC := NewVerifyEmailUseCase(playerRepo, tokenRepo)
+
+	now := time.Date(2025, 2, 17, 9, 0, 0, 0, time.UTC)
+	sendVerificationUC.now = func() time.Time { return now }
+	verifyEmailUC.now = func() time.Time { return now }
+
+	err := playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Email: "player@email.com"})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+
+	t.Run("Execute_InvalidToken", func(t *testing.T) {
+		err := verifyEmailUC.Execute(ctx, &VerifyEmailRequest{Token: "invalid"})
+
+		assert.Equal(t, ErrInvalidVerificationToken, err, "Expected ErrInvalidVerificationToken error")
+	})
+
+	t.Run("Execute_ResendInvalidatesPreviousToken", func(t *testing.T) {
+		err := sendVerificationUC.Execute(ctx, &SendEmailVerificationRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error when sending the verification email")
+		first := tokenPattern.FindString(notifier.notifications[len(notifier.notifications)-1].Body)
+
+		err = sendVerificationUC.Execute(ctx, &SendEmailVerificationRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error when resending the verification email")
+
+		err = verifyEmailUC.Execute(ctx, &VerifyEmailRequest{Token: first})
+		assert.Equal(t, ErrInvalidVerificationToken, err, "Expected the previous token to be invalidated")
+	})
+
+	t.Run("Execute_Success", func(t *testing.T) {
+		token := tokenPattern.FindString(notifier.notifications[len(notifier.notifications)-1].Body)
+
+		err := verifyEmailUC.Execute(ctx, &VerifyEmailRequest{Token: token})
+		assert.NoError(t, err, "Expected no error when verifying the email")
+
+		player, err := playerRepo.GetPlayer(ctx, "player1")
+		assert.NoError(t, err, "Expected to find the player")
+		assert.True(t, player.EmailVerified, "Expected the email to be verified")
+
+		err = sendVerificationUC.Execute(ctx, &SendEmailVerificationRequest{PlayerID: "player1"})
+		assert.Equal(t, ErrEmailAlreadyVerified, err, "Expected ErrEmailAlreadyVerified error")
+	})
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"slot-machine/internal/domain/security"
+	"time"
+)
+
+type VerifyMFAUseCase struct {
+	PlayerRepo       repository.PlayerRepository
+	RefreshTokenRepo repository.RefreshTokenRepository
+	RecoveryCodeRepo repository.RecoveryCodeRepository
+	LoginAttemptRepo repository.LoginAttemptRepository
+	JWTManager       ports.JWTManager
+	TOTP             security.TOTPProvider
+	Throttle         LoginThrottlePolicy
+	now              func() time.Time
+}
+
+type VerifyMFARequest struct {
+	MFAToken     string `json:"mfa_token"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func NewVerifyMFAUseCase(playerRepo repository.PlayerRepository, refreshRepo repository.RefreshTokenRepository, recoveryRepo repository.RecoveryCodeRepository, attemptRepo repository.LoginAttemptRepository, jwtManager ports.JWTManager, totp security.TOTPProvider) *VerifyMFAUseCase {
+	return &VerifyMFAUseCase{
+		PlayerRepo:       playerRepo,
+		RefreshTokenRepo: refreshRepo,
+		RecoveryCodeRepo: recoveryRepo,
+		LoginAttemptRepo: attemptRepo,
+		JWTManager:       jwtManager,
+		TOTP:             totp,
+		Throttle:         DefaultLoginThrottlePolicy(),
+		now:              time.Now,
+	}
+}
+
+// Execute conclui o login de contas com 2FA usando um código TOTP ou um
+// código de recuperação.
+func (uc *VerifyMFAUseCase) Execute(ctx context.Context, req *VerifyMFARequest) (*LoginResponse, error) {
+	if req.MFAToken == "" || (req.Code == "" && req.RecoveryCode == "") {
+		return nil, ErrValidate
+	}
+
+	claims, err := uc.JWTManager.VerifyMFAToken(req.MFAToken)
+	if err != nil {
+		return nil, ErrInvalidMFAToken
+	}
+
+	now := uc.now()
+	if err := guardMFAAttempt(ctx, uc.LoginAttemptRepo, claims.UserID, now); err != nil {
+		return nil, err
+	}
+
+	player, err := uc.PlayerRepo.GetPlayer(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !player.TOTPEnabled {
+		return nil, ErrTOTPNotEnabled
+	}
+
+	if req.Code != "" {
+		valid, err := verifyTOTPCode(ctx, uc.PlayerRepo, uc.TOTP, player, req.Code, now)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, registerMFAFailure(ctx, uc.LoginAttemptRepo, uc.Throttle, player.ID, now)
+		}
+	} else {
+		valid, err := uc.RecoveryCodeRepo.ConsumeRecoveryCode(ctx, player.ID, hashRecoveryCode(req.RecoveryCode))
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, registerMFAFailure(ctx, uc.LoginAttemptRepo, uc.Throttle, player.ID, now)
+		}
+	}
+
+	if err := uc.LoginAttemptRepo.DeleteLoginAttempt(ctx, mfaAttemptKey(player.ID)); err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := issueTokenPair(ctx, uc.JWTManager, uc.RefreshTokenRepo, player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/infrastructure/jwt"
+	repository_in_memory "slot-machine/internal/infrastructure/repository/in_memory"
+	"slot-machine/internal/infrastructure/security"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestTwoFactorLoginFlow(t *testing.T) {
+	ctx := context.Background()
+
+	playerRepo := repository_in_memory.NewInMemoryPlayerRepository()
+	refreshRepo := repository_in_memory.NewInMemoryRefreshTokenRepository()
+	attemptRepo := repository_in_memory.NewInMemoryLoginAttemptRepository()
+	recoveryRepo := repository_in_memory.NewInMemoryRecoveryCodeRepository()
+	hasher := security.NewBcryptPasswordHasher(bcrypt.MinCost)
+	jwtManager := jwt.NewJWTManager("secret", time.Minute, time.Hour)
+	totp := security.NewTOTPGenerator("Slot Machine")
+
+	loginUC := NewLoginUseCase(playerRepo, refreshRepo, attemptRepo, repository_in_memory.NewInMemorySelfExclusionRepository(), hasher, jwtManager)
+	enrollUC := NewEnrollTOTPUseCase(playerRepo, jwtManager, totp)
+	confirmUC := NewConfirmTOTPUseCase(playerRepo, refreshRepo, recoveryRepo, attemptRepo, jwtManager, totp)
+	verifyUC := NewVerifyMFAUseCase(playerRepo, refreshRepo, recoveryRepo, attemptRepo, jwtManager, totp)
+	disableUC := NewDisableTOTPUseCase(playerRepo, recoveryRepo, hasher, totp)
+
+	now := time.Date(2025, 2, 18, 11, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	loginUC.now = clock
+	confirmUC.now = clock
+	verifyUC.now = clock
+	disableUC.now = clock
+
+	hashed, err := hasher.Hash("password")
+	assert.NoError(t, err, "Erro ao gerar hash da senha")
+
+	err = playerRepo.CreatePlayer(ctx, &model.Player{ID: "player1", Email: "player@email.com", Password: hashed, Role: model.PlayerRole})
+	assert.NoError(t, err, "Erro ao criar jogador para testes")
+	err = playerRepo.CreatePlayer(ctx, &model.Player{ID: "admin1", Email: "admin@email.com", Password: hashed, Role: model.AdminRole})
+	assert.NoError(t, err, "Erro ao criar administrador para testes")
+
+	var recoveryCodes []string
+
+	t.Run("Enroll_AndConfirm", func(t *testing.T) {
+		enrollment, err := enrollUC.Execute(ctx, &EnrollTOTPRequest{PlayerID: "player1"})
+		assert.NoError(t, err, "Expected no error when enrolling")
+		assert.Contains(t, enrollment.ProvisioningURI, "otpauth://totp/", "Expected an otpauth URI")
+
+		_, err = confirmUC.Execute(ctx, &ConfirmTOTPRequest{PlayerID: "player1", Code: "000000"})
+		assert.Equal(t, ErrInvalidTOTPCode, err, "Expected a wrong code to be rejected")
+
+		code, err := totp.Code(enrollment.Secret, now)
+		assert.NoError(t, err, "Erro ao gerar código TOTP")
+
+		resp, err := confirmUC.Execute(ctx, &ConfirmTOTPRequest{PlayerID: "player1", Code: code})
+		assert.NoError(t, err, "Expected no error when confirming")
+		assert.Len(t, resp.RecoveryCodes, recoveryCodeCount, "Expected recovery codes to be returned")
+		assert.Empty(t, resp.AccessToken, "Expected no session tokens outside the login flow")
+		recoveryCodes = resp.RecoveryCodes
+
+		_, err = enrollUC.Execute(ctx, &EnrollTOTPRequest{PlayerID: "player1"})
+		assert.Equal(t, ErrTOTPAlreadyEnabled, err, "Expected re-enrollment to be rejected")
+	})
+
+	t.Run("Login_RequiresSecondFactor", func(t *testing.T) {
+		now = now.Add(time.Minute)
+
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected no error on valid credentials")
+		assert.True(t, resp.MFARequired, "Expected the second factor to be required")
+		assert.Empty(t, resp.AccessToken, "Expected no access token before the second factor")
+
+		player, _ := playerRepo.GetPlayer(ctx, "player1")
+		code, err := totp.Code(player.TOTPSecret, now)
+		assert.NoError(t, err, "Erro ao gerar código TOTP")
+
+		session, err := verifyUC.Execute(ctx, &VerifyMFARequest{MFAToken: resp.MFAToken, Code: code})
+		assert.NoError(t, err, "Expected no error with a valid code")
+		assert.NotEmpty(t, session.AccessToken, "Expected an access token after the second factor")
+
+		_, err = verifyUC.Execute(ctx, &VerifyMFARequest{MFAToken: resp.MFAToken, Code: code})
+		assert.Equal(t, ErrInvalidTOTPCode, err, "Expected a replayed code to be rejected")
+	})
+
+	t.Run("Login_WithRecoveryCode", func(t *testing.T) {
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected no error on valid credentials")
+
+		session, err := verifyUC.Execute(ctx, &VerifyMFARequest{MFAToken: resp.MFAToken, RecoveryCode: recoveryCodes[0]})
+		assert.NoError(t, err, "Expected no error with a valid recovery code")
+		assert.NotEmpty(t, session.AccessToken, "Expected an access token")
+
+		_, err = verifyUC.Execute(ctx, &VerifyMFARequest{MFAToken: resp.MFAToken, RecoveryCode: recoveryCodes[0]})
+		assert.Equal(t, ErrInvalidTOTPCode, err, "Expected recovery codes to be single use")
+	})
+
+	t.Run("Login_InvalidMFAToken", func(t *testing.T) {
+		_, err := verifyUC.Execute(ctx, &VerifyMFARequest{MFAToken: "invalid", Code: "123456"})
+		assert.Equal(t, ErrInvalidMFAToken, err, "Expected ErrInvalidMFAToken error")
+	})
+
+	t.Run("Admin_MandatoryEnrollment", func(t *testing.T) {
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "admin@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected no error on valid credentials")
+		assert.True(t, resp.MFAEnrollmentRequired, "Expected admins without 2FA to be forced to enroll")
+		assert.Empty(t, resp.AccessToken, "Expected no access token before enrollment")
+
+		enrollment, err := enrollUC.Execute(ctx, &EnrollTOTPRequest{MFAToken: resp.MFAToken})
+		assert.NoError(t, err, "Expected enrollment with the MFA token")
+
+		code, err := totp.Code(enrollment.Secret, now)
+		assert.NoError(t, err, "Erro ao gerar código TOTP")
+
+		confirmed, err := confirmUC.Execute(ctx, &ConfirmTOTPRequest{MFAToken: resp.MFAToken, Code: code})
+		assert.NoError(t, err, "Expected no error when confirming")
+		assert.NotEmpty(t, confirmed.AccessToken, "Expected session tokens when enrolling during login")
+
+		err = disableUC.Execute(ctx, &DisableTOTPRequest{PlayerID: "admin1", Password: "password", Code: code})
+		assert.Equal(t, ErrTOTPRequiredForAdmins, err, "Expected admins to be unable to disable 2FA")
+	})
+
+	t.Run("Disable_Success", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		player, _ := playerRepo.GetPlayer(ctx, "player1")
+		code, err := totp.Code(player.TOTPSecret, now)
+		assert.NoError(t, err, "Erro ao gerar código TOTP")
+
+		err = disableUC.Execute(ctx, &DisableTOTPRequest{PlayerID: "player1", Password: "password", Code: code})
+		assert.NoError(t, err, "Expected no error when disabling 2FA")
+
+		resp, err := loginUC.Execute(ctx, &LoginRequest{Email: "player@email.com", Password: "password"})
+		assert.NoError(t, err, "Expected no error on valid credentials")
+		assert.NotEmpty(t, resp.AccessToken, "Expected a regular login after disabling 2FA")
+	})
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookFanout é o publicador do outbox para as assinaturas de webhook:
+// grava uma entrega pendente para cada assinatura que aceita o tipo do
+// evento. O envio fica com o DeliverWebhooksUseCase.
+type WebhookFanout struct {
+	WebhookRepo repository.WebhookRepository
+	now         func() time.Time
+}
+
+func NewWebhookFanout(webhookRepo repository.WebhookRepository) ports.DomainEventPublisher {
+	return &WebhookFanout{
+		WebhookRepo: webhookRepo,
+		now:         time.Now,
+	}
+}
+
+func (f *WebhookFanout) Publish(ctx context.Context, event *model.DomainEvent) error {
+	subscriptions, err := f.WebhookRepo.ListSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	now := f.now()
+	for _, subscription := range subscriptions {
+		if !subscription.Accepts(event.Type) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				return err
+			}
+		}
+		err := f.WebhookRepo.AddDelivery(ctx, &model.WebhookDelivery{
+			ID:             uuid.New().String(),
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         model.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// webhookBackoff dobra a espera a cada tentativa falha, a partir de base e
+// até max.
+func webhookBackoff(attempts int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
@@ -3,6 +3,10 @@ package contextkeys
 type ContextKey string
 
 const (
-	ContextKeyUserID  ContextKey = "userID"
-	ContextKeyIsAdmin ContextKey = "isAdmin"
+	ContextKeyUserID    ContextKey = "userID"
+	ContextKeyIsAdmin   ContextKey = "isAdmin"
+	ContextKeyAPIKeyID  ContextKey = "apiKeyID"
+	ContextKeyScopes    ContextKey = "scopes"
+	ContextKeyRequestID ContextKey = "requestID"
+	ContextKeyClientIP  ContextKey = "clientIP"
 )
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+type TokenPurpose string
+
+const (
+	PasswordResetPurpose     TokenPurpose = "password_reset"
+	EmailVerificationPurpose TokenPurpose = "email_verification"
+)
+
+// ActionToken é um token de uso único enviado ao jogador. Apenas o hash do
+// token é armazenado.
+type ActionToken struct {
+	TokenHash string       `json:"-"`
+	PlayerID  string       `json:"player_id"`
+	Purpose   TokenPurpose `json:"purpose"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
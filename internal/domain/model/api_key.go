@@ -0,0 +1,82 @@
+package model
+
+import "time"
+
+type Scope string
+
+const (
+	ScopeMachinesRead  Scope = "machines:read"
+	ScopeMachinesWrite Scope = "machines:write"
+	ScopeAPIKeysManage Scope = "api_keys:manage"
+	ScopeAuditRead     Scope = "audit:read"
+	ScopePlayersRead   Scope = "players:read"
+	ScopePlayersWrite  Scope = "players:write"
+	// Propor e aprovar ajustes são escopos separados para que uma mesma
+	// chave não feche sozinha o fluxo de quatro olhos.
+	ScopeAdjustmentsPropose Scope = "adjustments:propose"
+	ScopeAdjustmentsApprove Scope = "adjustments:approve"
+	ScopeTreasuryRead       Scope = "treasury:read"
+	ScopeTreasuryManage     Scope = "treasury:manage"
+	ScopeJackpotsManage     Scope = "jackpots:manage"
+	ScopeWebhooksManage     Scope = "webhooks:manage"
+	ScopeTournamentsManage  Scope = "tournaments:manage"
+)
+
+func AllScopes() []Scope {
+	return []Scope{
+		ScopeMachinesRead,
+		ScopeMachinesWrite,
+		ScopeAPIKeysManage,
+		ScopeAuditRead,
+		ScopePlayersRead,
+		ScopePlayersWrite,
+		ScopeAdjustmentsPropose,
+		ScopeAdjustmentsApprove,
+		ScopeTreasuryRead,
+		ScopeTreasuryManage,
+		ScopeJackpotsManage,
+		ScopeWebhooksManage,
+		ScopeTournamentsManage,
+	}
+}
+
+func IsValidScope(scope Scope) bool {
+	for _, s := range AllScopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKey dá acesso administrativo restrito aos escopos concedidos. Apenas o
+// hash do segredo é armazenado.
+type APIKey struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	KeyHash   string  `json:"-"`
+	Scopes    []Scope `json:"scopes"`
+	CreatedBy string  `json:"created_by"`
+	// ParentKeyID aponta a chave usada para criar esta, quando houver.
+	// Revogar a chave de origem revoga também as que ela criou.
+	ParentKeyID *string    `json:"parent_key_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+}
+
+func (k *APIKey) HasScope(scope Scope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
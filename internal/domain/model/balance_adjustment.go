@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+type AdjustmentTarget string
+
+const (
+	AdjustmentTargetPlayer  AdjustmentTarget = "player"
+	AdjustmentTargetMachine AdjustmentTarget = "machine"
+	// AdjustmentTargetTreasury credita ou debita a tesouraria da casa; o
+	// alvo é sempre HouseTreasuryID.
+	AdjustmentTargetTreasury AdjustmentTarget = "treasury"
+)
+
+type AdjustmentStatus string
+
+const (
+	AdjustmentPending  AdjustmentStatus = "pending"
+	AdjustmentApproved AdjustmentStatus = "approved"
+	AdjustmentRejected AdjustmentStatus = "rejected"
+)
+
+type AdjustmentReason string
+
+const (
+	ReasonGoodwill        AdjustmentReason = "goodwill"
+	ReasonErrorCorrection AdjustmentReason = "error_correction"
+	ReasonChargeback      AdjustmentReason = "chargeback"
+	ReasonPromotion       AdjustmentReason = "promotion"
+	ReasonMachineRefill   AdjustmentReason = "machine_refill"
+	ReasonTreasuryFunding AdjustmentReason = "treasury_funding"
+)
+
+func IsValidAdjustmentReason(reason AdjustmentReason) bool {
+	switch reason {
+	case ReasonGoodwill, ReasonErrorCorrection, ReasonChargeback, ReasonPromotion, ReasonMachineRefill,
+		ReasonTreasuryFunding:
+		return true
+	}
+	return false
+}
+
+// BalanceAdjustment é um crédito (Amount positivo) ou débito (negativo) no
+// saldo de um jogador ou máquina. Só é aplicado depois de aprovado por um
+// administrador diferente de quem o propôs.
+type BalanceAdjustment struct {
+	ID         string           `json:"id"`
+	TargetType AdjustmentTarget `json:"target_type"`
+	TargetID   string           `json:"target_id"`
+	Amount     int              `json:"amount"`
+	ReasonCode AdjustmentReason `json:"reason_code"`
+	Note       string           `json:"note,omitempty"`
+	Status     AdjustmentStatus `json:"status"`
+	ProposedBy string           `json:"proposed_by"`
+	ProposedAt time.Time        `json:"proposed_at"`
+	ReviewedBy string           `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time       `json:"reviewed_at,omitempty"`
+}
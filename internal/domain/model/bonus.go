@@ -0,0 +1,88 @@
+package model
+
+import "time"
+
+// ScatterAward concede FreeSpins rodadas grátis quando a jogada traz Count
+// símbolos scatter em qualquer posição da grade. Os prêmios das rodadas grátis são
+// multiplicados por Multiplier; zero equivale a 1.
+type ScatterAward struct {
+	Count      int `json:"count"`
+	FreeSpins  int `json:"free_spins"`
+	Multiplier int `json:"multiplier,omitempty"`
+}
+
+// EffectiveMultiplier trata o multiplicador omitido como 1.
+func (a ScatterAward) EffectiveMultiplier() int {
+	if a.Multiplier < 1 {
+		return 1
+	}
+	return a.Multiplier
+}
+
+// BonusFeature é a configuração de rodadas grátis de uma máquina.
+type BonusFeature struct {
+	ScatterSymbol string         `json:"scatter_symbol"`
+	Awards        []ScatterAward `json:"awards"`
+}
+
+// Award retorna o prêmio de maior Count que a jogada alcança.
+func (b *BonusFeature) Award(grid Grid) (ScatterAward, bool) {
+	if b == nil {
+		return ScatterAward{}, false
+	}
+	scatters := grid.Count(b.ScatterSymbol)
+
+	var best ScatterAward
+	found := false
+	for _, award := range b.Awards {
+		if award.Count <= scatters && (!found || award.Count > best.Count) {
+			best = award
+			found = true
+		}
+	}
+	return best, found
+}
+
+// FreeSpins guarda as rodadas grátis do jogador em uma máquina. Todas as
+// rodadas de uma mesma sequência usam Bet por linha em Lines linhas, a
+// aposta da jogada que as concedeu. Awarded e TotalWon acumulam a sequência atual e são zerados
+// quando uma nova sequência começa.
+type FreeSpins struct {
+	PlayerID   string    `json:"player_id"`
+	MachineID  string    `json:"machine_id"`
+	Remaining  int       `json:"remaining"`
+	Multiplier int       `json:"multiplier"`
+	Bet        int       `json:"bet"`
+	Lines      int       `json:"lines"`
+	Awarded    int       `json:"awarded"`
+	TotalWon   int       `json:"total_won"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Active indica se ainda há rodadas grátis a jogar.
+func (f *FreeSpins) Active() bool {
+	return f != nil && f.Remaining > 0
+}
+
+// Grant soma as rodadas do prêmio. Durante uma sequência ativa, as novas
+// rodadas mantêm a aposta original e o maior multiplicador.
+func (f *FreeSpins) Grant(award ScatterAward, bet, lines int, now time.Time) {
+	if !f.Active() {
+		f.Bet = bet
+		f.Lines = lines
+		f.Multiplier = 1
+		f.Awarded = 0
+		f.TotalWon = 0
+	}
+	f.Remaining += award.FreeSpins
+	f.Awarded += award.FreeSpins
+	f.Multiplier = max(f.Multiplier, award.EffectiveMultiplier())
+	f.UpdatedAt = now
+}
+
+// Consume gasta uma rodada grátis e soma o prêmio dela à sequência.
+func (f *FreeSpins) Consume(payout int, now time.Time) {
+	f.Remaining--
+	f.TotalWon += payout
+	f.UpdatedAt = now
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DomainEventType identifica os fatos de negócio gravados no outbox para
+// integrações externas (CRM, analytics, antifraude).
+type DomainEventType string
+
+const (
+	DomainEventPlayerRegistered   DomainEventType = "player.registered"
+	DomainEventPlayerBlocked      DomainEventType = "player.blocked"
+	DomainEventDepositCompleted   DomainEventType = "deposit.completed"
+	DomainEventSpinSettled        DomainEventType = "spin.settled"
+	DomainEventJackpotWon         DomainEventType = "jackpot.won"
+	DomainEventAdjustmentApproved DomainEventType = "adjustment.approved"
+	DomainEventMachineCreated     DomainEventType = "machine.created"
+	DomainEventTournamentJoined   DomainEventType = "tournament.joined"
+	DomainEventTournamentSettled  DomainEventType = "tournament.settled"
+)
+
+func AllDomainEventTypes() []DomainEventType {
+	return []DomainEventType{
+		DomainEventPlayerRegistered,
+		DomainEventPlayerBlocked,
+		DomainEventDepositCompleted,
+		DomainEventSpinSettled,
+		DomainEventJackpotWon,
+		DomainEventAdjustmentApproved,
+		DomainEventMachineCreated,
+		DomainEventTournamentJoined,
+		DomainEventTournamentSettled,
+	}
+}
+
+func IsValidDomainEventType(eventType DomainEventType) bool {
+	for _, t := range AllDomainEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// DomainEvent é gravado na mesma transação da mudança de estado que o
+// originou e entregue depois pelo relay. PublishedAt fica vazio até a
+// entrega; Attempts e LastError registram as falhas. DeliveredTo guarda os
+// publicadores que já aceitaram o evento, e DeadAt marca o evento que
+// esgotou as tentativas e saiu da fila.
+type DomainEvent struct {
+	ID            string          `json:"id"`
+	Type          DomainEventType `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	Payload       json.RawMessage `json:"payload" swaggertype:"object"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	PublishedAt   *time.Time      `json:"published_at,omitempty"`
+	Attempts      int             `json:"attempts"`
+	LastError     string          `json:"last_error,omitempty"`
+	DeliveredTo   []string        `json:"-"`
+	DeadAt        *time.Time      `json:"dead_at,omitempty"`
+}
+
+func (e *DomainEvent) DeliveredBy(publisher string) bool {
+	for _, name := range e.DeliveredTo {
+		if name == publisher {
+			return true
+		}
+	}
+	return false
+}
+
+// PlayerRegisteredPayload não inclui dados sensíveis além do email.
+type PlayerRegisteredPayload struct {
+	PlayerID string `json:"player_id"`
+	Email    string `json:"email"`
+	Balance  int    `json:"balance"`
+}
+
+type PlayerBlockedPayload struct {
+	PlayerID string `json:"player_id"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+type DepositCompletedPayload struct {
+	PlayerID string `json:"player_id"`
+	Amount   int    `json:"amount"`
+	Balance  int    `json:"balance"`
+}
+
+// SpinSettledPayload resume a jogada liquidada; Bet é zero nas rodadas
+// grátis.
+type SpinSettledPayload struct {
+	SpinID     string   `json:"spin_id"`
+	PlayerID   string   `json:"player_id"`
+	MachineID  string   `json:"machine_id"`
+	Bet        int      `json:"bet"`
+	Payout     int      `json:"payout"`
+	JackpotWin int      `json:"jackpot_win,omitempty"`
+	FreeSpin   bool     `json:"free_spin"`
+	Result     []string `json:"result"`
+	Balance    int      `json:"balance"`
+}
+
+type JackpotWonPayload struct {
+	JackpotWinID string `json:"jackpot_win_id"`
+	PoolID       string `json:"pool_id"`
+	PlayerID     string `json:"player_id"`
+	MachineID    string `json:"machine_id"`
+	Amount       int    `json:"amount"`
+}
+
+type AdjustmentApprovedPayload struct {
+	AdjustmentID string           `json:"adjustment_id"`
+	TargetType   AdjustmentTarget `json:"target_type"`
+	TargetID     string           `json:"target_id"`
+	Amount       int              `json:"amount"`
+	ReasonCode   AdjustmentReason `json:"reason_code"`
+}
+
+type MachineCreatedPayload struct {
+	MachineID    string `json:"machine_id"`
+	Balance      int    `json:"balance"`
+	Reels        int    `json:"reels"`
+	Rows         int    `json:"rows"`
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+}
+
+// TournamentJoinedPayload registra a inscrição e o débito da taxa; EntryFee é
+// zero nos torneios gratuitos.
+type TournamentJoinedPayload struct {
+	TournamentID string `json:"tournament_id"`
+	PlayerID     string `json:"player_id"`
+	EntryFee     int    `json:"entry_fee"`
+	Balance      int    `json:"balance"`
+}
+
+// TournamentSettledPayload traz apenas as colocações premiadas.
+type TournamentSettledPayload struct {
+	TournamentID string             `json:"tournament_id"`
+	PrizePool    int                `json:"prize_pool"`
+	Entrants     int                `json:"entrants"`
+	Winners      []TournamentWinner `json:"winners"`
+}
+
+type TournamentWinner struct {
+	PlayerID string `json:"player_id"`
+	Rank     int    `json:"rank"`
+	Prize    int    `json:"prize"`
+}
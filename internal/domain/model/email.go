@@ -0,0 +1,32 @@
+package model
+
+import (
+	"net/mail"
+	"strings"
+)
+
+// NormalizeEmail devolve a forma canônica usada para armazenar e comparar emails.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// IsValidEmail aceita apenas endereços simples (sem nome de exibição) cujo
+// domínio tenha ao menos um ponto.
+func IsValidEmail(email string) bool {
+	if len(email) > 254 {
+		return false
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return false
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return false
+	}
+
+	domain := email[at+1:]
+	return strings.Contains(domain, ".") && !strings.HasPrefix(domain, ".") && !strings.HasSuffix(domain, ".")
+}
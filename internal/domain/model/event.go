@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// EventType identifica os eventos enviados aos clientes em tempo real.
+type EventType string
+
+const (
+	EventBalanceUpdated EventType = "balance.updated"
+	EventBigWin         EventType = "win.big"
+	EventJackpotUpdated EventType = "jackpot.updated"
+)
+
+// BalanceReason indica o que alterou o saldo do jogador.
+type BalanceReason string
+
+const (
+	BalanceReasonPlay       BalanceReason = "play"
+	BalanceReasonDeposit    BalanceReason = "deposit"
+	BalanceReasonAdjustment BalanceReason = "adjustment"
+	BalanceReasonTournament BalanceReason = "tournament"
+)
+
+// Event é entregue apenas ao jogador PlayerID ou, sem ele, a todos os
+// clientes conectados.
+type Event struct {
+	Type      EventType `json:"type"`
+	PlayerID  string    `json:"-"`
+	Data      any       `json:"data"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BalanceUpdate traz o novo saldo do jogador e a variação que o produziu.
+type BalanceUpdate struct {
+	Balance int           `json:"balance"`
+	Delta   int           `json:"delta"`
+	Reason  BalanceReason `json:"reason"`
+}
+
+// BigWin anuncia uma vitória grande a todos, sem identificar o jogador.
+type BigWin struct {
+	MachineID string `json:"machine_id"`
+	Bet       int    `json:"bet"`
+	Payout    int    `json:"payout"`
+}
+
+// JackpotUpdate traz o valor atual do pool. WonAmount vem preenchido quando
+// a atualização é o pagamento do jackpot.
+type JackpotUpdate struct {
+	PoolID    string `json:"pool_id"`
+	Name      string `json:"name"`
+	Amount    int    `json:"amount"`
+	WonAmount int    `json:"won_amount,omitempty"`
+}
@@ -0,0 +1,63 @@
+package model
+
+import "time"
+
+type LimitType string
+
+const (
+	LimitDeposit LimitType = "deposit"
+	LimitLoss    LimitType = "loss"
+	LimitWager   LimitType = "wager"
+	LimitSession LimitType = "session"
+)
+
+type LimitPeriod string
+
+const (
+	PeriodDaily   LimitPeriod = "daily"
+	PeriodWeekly  LimitPeriod = "weekly"
+	PeriodMonthly LimitPeriod = "monthly"
+)
+
+// Duration devolve a janela móvel usada para somar os movimentos do período.
+func (p LimitPeriod) Duration() time.Duration {
+	switch p {
+	case PeriodDaily:
+		return 24 * time.Hour
+	case PeriodWeekly:
+		return 7 * 24 * time.Hour
+	case PeriodMonthly:
+		return 30 * 24 * time.Hour
+	}
+	return 0
+}
+
+// GamblingLimit é um limite de jogo responsável definido pelo jogador. Para
+// limites de sessão, Amount é a duração máxima em minutos e Period fica vazio.
+// Amount zero significa sem limite.
+type GamblingLimit struct {
+	PlayerID           string      `json:"-"`
+	Type               LimitType   `json:"type"`
+	Period             LimitPeriod `json:"period,omitempty"`
+	Amount             int         `json:"amount"`
+	PendingAmount      *int        `json:"pending_amount,omitempty"`
+	PendingEffectiveAt *time.Time  `json:"pending_effective_at,omitempty"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+}
+
+// ApplyPending efetiva um aumento pendente cujo período de espera terminou.
+// Devolve true quando o limite foi alterado.
+func (l *GamblingLimit) ApplyPending(now time.Time) bool {
+	if l.PendingAmount == nil || l.PendingEffectiveAt == nil || now.Before(*l.PendingEffectiveAt) {
+		return false
+	}
+	l.Amount = *l.PendingAmount
+	l.PendingAmount = nil
+	l.PendingEffectiveAt = nil
+	l.UpdatedAt = now
+	return true
+}
+
+func (l *GamblingLimit) IsActive() bool {
+	return l.Amount > 0
+}
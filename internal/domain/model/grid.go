@@ -0,0 +1,96 @@
+package model
+
+import "sort"
+
+const (
+	DefaultReels = 3
+	DefaultRows  = 1
+)
+
+// Grid é o resultado visível de uma jogada, linha a linha: Grid[linha][rolo].
+type Grid [][]string
+
+// Payline indica, para cada rolo, a linha da grade que forma a linha de
+// pagamento.
+type Payline []int
+
+// LinePay paga a aposta da linha mais Multiplier vezes ela quando os Count
+// primeiros rolos da linha trazem o mesmo símbolo.
+type LinePay struct {
+	Count      int `json:"count"`
+	Multiplier int `json:"multiplier"`
+}
+
+// LineWin é uma linha de pagamento premiada. Line é o índice da linha na
+// configuração da máquina, a partir de 1.
+type LineWin struct {
+	Line   int    `json:"line"`
+	Symbol string `json:"symbol"`
+	Count  int    `json:"count"`
+	Payout int    `json:"payout"`
+}
+
+// Symbols retorna os símbolos da grade ao longo da linha de pagamento.
+func (g Grid) Symbols(line Payline) []string {
+	symbols := make([]string, len(line))
+	for reel, row := range line {
+		symbols[reel] = g[row][reel]
+	}
+	return symbols
+}
+
+// Count conta as ocorrências do símbolo em toda a grade.
+func (g Grid) Count(symbol string) int {
+	count := 0
+	for _, row := range g {
+		for _, s := range row {
+			if s == symbol {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Flatten junta as linhas da grade em uma única lista.
+func (g Grid) Flatten() []string {
+	var symbols []string
+	for _, row := range g {
+		symbols = append(symbols, row...)
+	}
+	return symbols
+}
+
+// DefaultPaylines retorna as linhas horizontais, começando pela do meio, e,
+// em grades com mais de uma linha, um V e um V invertido.
+func DefaultPaylines(reels, rows int) []Payline {
+	var paylines []Payline
+	for i := 0; i < rows; i++ {
+		row := (rows/2 + i) % rows
+		line := make(Payline, reels)
+		for reel := range line {
+			line[reel] = row
+		}
+		paylines = append(paylines, line)
+	}
+	if rows < 2 {
+		return paylines
+	}
+
+	v := make(Payline, reels)
+	inverted := make(Payline, reels)
+	for reel := range v {
+		v[reel] = min(reel, reels-1-reel, rows-1)
+		inverted[reel] = rows - 1 - v[reel]
+	}
+	return append(paylines, v, inverted)
+}
+
+// sortedLinePays ordena a tabela pela quantidade de símbolos.
+func sortedLinePays(pays []LinePay) []LinePay {
+	sorted := append([]LinePay(nil), pays...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Count < sorted[j].Count
+	})
+	return sorted
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// JackpotPool é um prêmio progressivo compartilhado pelas máquinas ligadas a
+// ele. Cada aposta nessas máquinas contribui com ContributionPercent do valor
+// apostado. O prêmio sai quando uma linha jogada começa com
+// TriggerCombination ou, se TriggerOdds estiver definido, com chance de 1 em
+// TriggerOdds por jogada.
+// Depois de pago, o pool volta ao SeedAmount, retirado da tesouraria.
+type JackpotPool struct {
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	SeedAmount          int       `json:"seed_amount"`
+	ContributionPercent float64   `json:"contribution_percent"`
+	TriggerCombination  []string  `json:"trigger_combination,omitempty"`
+	TriggerOdds         int       `json:"trigger_odds,omitempty"`
+	Amount              int       `json:"amount"`
+	TotalSeeded         int       `json:"total_seeded"`
+	TotalContributed    int       `json:"total_contributed"`
+	TotalPaid           int       `json:"total_paid"`
+	MachineIDs          []string  `json:"machine_ids"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// Contribution é a parte da aposta destinada ao pool, arredondada para
+// baixo; o restante fica com a máquina.
+func (p *JackpotPool) Contribution(bet int) int {
+	basisPoints := int(math.Round(p.ContributionPercent * 100))
+	return bet * basisPoints / 10000
+}
+
+// Triggered indica se a jogada ganha o jackpot: a combinação aparece no
+// início de uma das linhas de pagamento jogadas ou o sorteio de 1 em
+// TriggerOdds acerta. roll(n) deve sortear um inteiro em [0, n).
+func (p *JackpotPool) Triggered(grid Grid, paylines []Payline, roll func(n int) int) bool {
+	if len(p.TriggerCombination) > 0 {
+		for _, line := range paylines {
+			if hasPrefix(grid.Symbols(line), p.TriggerCombination) {
+				return true
+			}
+		}
+	}
+	return p.TriggerOdds > 0 && roll(p.TriggerOdds) == 0
+}
+
+func hasPrefix(symbols, prefix []string) bool {
+	if len(prefix) > len(symbols) {
+		return false
+	}
+	for i := range prefix {
+		if symbols[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// JackpotWin registra um jackpot pago. Amount é definido pelo repositório,
+// com o valor do pool no momento do pagamento.
+type JackpotWin struct {
+	ID        string    `json:"id"`
+	PoolID    string    `json:"pool_id"`
+	PlayerID  string    `json:"player_id"`
+	MachineID string    `json:"machine_id"`
+	Amount    int       `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// LoginAttempt acumula as falhas de autenticação de uma chave (conta ou IP).
+type LoginAttempt struct {
+	Key           string    `json:"key"`
+	Failures      int       `json:"failures"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+	BlockedUntil  time.Time `json:"blocked_until"`
+	LockedUntil   time.Time `json:"locked_until"`
+}
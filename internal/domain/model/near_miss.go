@@ -0,0 +1,33 @@
+package model
+
+// NearMissMode é a apresentação de quase acertos da máquina. Ela nunca muda
+// o resultado, que vem apenas das fitas declaradas: NearMissReelWindow só
+// revela os símbolos reais das fitas logo acima e abaixo da grade, sem
+// novos sorteios.
+type NearMissMode string
+
+const (
+	NearMissOff        NearMissMode = "off"
+	NearMissReelWindow NearMissMode = "reel_window"
+)
+
+// Valid aceita o modo vazio como NearMissOff.
+func (m NearMissMode) Valid() bool {
+	switch m {
+	case "", NearMissOff, NearMissReelWindow:
+		return true
+	}
+	return false
+}
+
+// Enabled indica se a máquina pede alguma apresentação de quase acerto.
+func (m NearMissMode) Enabled() bool {
+	return m != "" && m != NearMissOff
+}
+
+// NearMissPeek traz, para cada rolo, o símbolo da fita logo acima e logo
+// abaixo da grade.
+type NearMissPeek struct {
+	Above []string `json:"above"`
+	Below []string `json:"below"`
+}
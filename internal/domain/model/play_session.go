@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// PlaySession agrupa jogadas consecutivas. Uma nova sessão começa quando o
+// jogador fica inativo por mais tempo que o limite de inatividade.
+type PlaySession struct {
+	ID                  string    `json:"id"`
+	PlayerID            string    `json:"player_id"`
+	StartedAt           time.Time `json:"started_at"`
+	LastActivityAt      time.Time `json:"last_activity_at"`
+	Spins               int       `json:"spins"`
+	Wagered             int       `json:"wagered"`
+	Won                 int       `json:"won"`
+	LastRealityCheckAt  time.Time `json:"last_reality_check_at"`
+	RealityCheckPending bool      `json:"reality_check_pending"`
+}
+
+// RealityCheck resume a sessão para o jogador, que precisa confirmá-lo antes
+// da próxima jogada.
+type RealityCheck struct {
+	ElapsedMinutes int `json:"elapsed_minutes"`
+	Spins          int `json:"spins"`
+	Wagered        int `json:"wagered"`
+	Won            int `json:"won"`
+	NetResult      int `json:"net_result"`
+}
+
+func (s *PlaySession) RealityCheck(now time.Time) RealityCheck {
+	return RealityCheck{
+		ElapsedMinutes: int(now.Sub(s.StartedAt).Minutes()),
+		Spins:          s.Spins,
+		Wagered:        s.Wagered,
+		Won:            s.Won,
+		NetResult:      s.Won - s.Wagered,
+	}
+}
+
+// RealityCheckDue indica se já passou o intervalo escolhido pelo jogador
+// desde o último aviso (ou desde o início da sessão).
+func (s *PlaySession) RealityCheckDue(interval time.Duration, now time.Time) bool {
+	if interval <= 0 {
+		return false
+	}
+	last := s.LastRealityCheckAt
+	if last.IsZero() {
+		last = s.StartedAt
+	}
+	return now.Sub(last) >= interval
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type Role string
 
 const (
@@ -8,9 +10,17 @@ const (
 )
 
 type Player struct {
-	ID       string `json:"id"`
-	Balance  int    `json:"balance"`
-	Email    string `json:"email"`
-	Password string `json:"-"`
-	Role     Role   `json:"-"`
+	ID                  string    `json:"id"`
+	Balance             int       `json:"balance"`
+	Email               string    `json:"email"`
+	EmailVerified       bool      `json:"email_verified"`
+	Password            string    `json:"-"`
+	Role                Role      `json:"-"`
+	TOTPEnabled         bool      `json:"totp_enabled"`
+	TOTPSecret          string    `json:"-"`
+	TOTPLastCounter     int64     `json:"-"`
+	RealityCheckMinutes int       `json:"reality_check_minutes"`
+	CreatedAt           time.Time `json:"created_at"`
+	Blocked             bool      `json:"blocked"`
+	BlockedReason       string    `json:"blocked_reason,omitempty"`
 }
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// PlayerSpinStats acumula as jogadas de um jogador em uma máquina durante um
+// dia (UTC). É atualizado a cada jogada, para que as estatísticas do jogador
+// não precisem percorrer o histórico de jogadas.
+type PlayerSpinStats struct {
+	PlayerID     string    `json:"player_id"`
+	MachineID    string    `json:"machine_id"`
+	Day          time.Time `json:"day"`
+	Spins        int       `json:"spins"`
+	TotalWagered int       `json:"total_wagered"`
+	TotalWon     int       `json:"total_won"`
+	BiggestWin   int       `json:"biggest_win"`
+}
+
+// Add soma uma jogada ao acumulado.
+func (s *PlayerSpinStats) Add(spin *Spin) {
+	s.Spins++
+	s.TotalWagered += spin.Bet
+	s.TotalWon += spin.TotalWon()
+	if spin.TotalWon() > s.BiggestWin {
+		s.BiggestWin = spin.TotalWon()
+	}
+}
+
+// PlayerStats resume as jogadas de um jogador em um período.
+type PlayerStats struct {
+	Spins            int    `json:"spins"`
+	TotalWagered     int    `json:"total_wagered"`
+	TotalWon         int    `json:"total_won"`
+	NetResult        int    `json:"net_result"`
+	BiggestWin       int    `json:"biggest_win"`
+	FavouriteMachine string `json:"favourite_machine,omitempty"`
+}
+
+// SummarizePlayerSpinStats soma os acumulados diários a partir do dia de
+// since. A máquina favorita é a com mais jogadas; empates ficam com a de
+// maior valor apostado e, depois, com o menor ID.
+func SummarizePlayerSpinStats(rows []PlayerSpinStats, since time.Time) PlayerStats {
+	var stats PlayerStats
+	first := GranularityDay.Truncate(since)
+	machines := make(map[string]*PlayerSpinStats)
+	for _, row := range rows {
+		if row.Day.Before(first) {
+			continue
+		}
+		stats.Spins += row.Spins
+		stats.TotalWagered += row.TotalWagered
+		stats.TotalWon += row.TotalWon
+		if row.BiggestWin > stats.BiggestWin {
+			stats.BiggestWin = row.BiggestWin
+		}
+
+		machine, exists := machines[row.MachineID]
+		if !exists {
+			machine = &PlayerSpinStats{MachineID: row.MachineID}
+			machines[row.MachineID] = machine
+		}
+		machine.Spins += row.Spins
+		machine.TotalWagered += row.TotalWagered
+	}
+	stats.NetResult = stats.TotalWon - stats.TotalWagered
+
+	ranking := make([]*PlayerSpinStats, 0, len(machines))
+	for _, machine := range machines {
+		ranking = append(ranking, machine)
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Spins != ranking[j].Spins {
+			return ranking[i].Spins > ranking[j].Spins
+		}
+		if ranking[i].TotalWagered != ranking[j].TotalWagered {
+			return ranking[i].TotalWagered > ranking[j].TotalWagered
+		}
+		return ranking[i].MachineID < ranking[j].MachineID
+	})
+	if len(ranking) > 0 {
+		stats.FavouriteMachine = ranking[0].MachineID
+	}
+	return stats
+}
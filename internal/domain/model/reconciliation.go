@@ -0,0 +1,237 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+type ReconciliationAccountType string
+
+const (
+	ReconciliationAccountPlayer   ReconciliationAccountType = "player"
+	ReconciliationAccountMachine  ReconciliationAccountType = "machine"
+	ReconciliationAccountTreasury ReconciliationAccountType = "treasury"
+	ReconciliationAccountJackpot  ReconciliationAccountType = "jackpot"
+)
+
+type AccountBalance struct {
+	ID             string
+	Balance        int
+	InitialBalance int
+}
+
+// TransactionTotal soma os lançamentos de um tipo por jogador e máquina.
+type TransactionTotal struct {
+	PlayerID  string
+	MachineID string
+	Type      TransactionType
+	Amount    int
+}
+
+// TreasuryMovementTotal soma os movimentos da tesouraria de um tipo por
+// máquina ou jackpot. Ajustes diretos na tesouraria não têm nenhum dos dois.
+type TreasuryMovementTotal struct {
+	MachineID     string
+	JackpotPoolID string
+	Type          TreasuryMovementType
+	Amount        int
+}
+
+// JackpotContributionTotal soma o que as jogadas de uma máquina destinaram
+// a um jackpot.
+type JackpotContributionTotal struct {
+	PoolID    string
+	MachineID string
+	Amount    int
+}
+
+// LedgerSnapshot reúne, lidos no mesmo instante, os saldos gravados e os
+// movimentos acumulados que deveriam explicá-los.
+type LedgerSnapshot struct {
+	Players            []AccountBalance
+	Machines           []AccountBalance
+	TreasuryBalance    int
+	Transactions       []TransactionTotal
+	TreasuryMovements  []TreasuryMovementTotal
+	MachineAdjustments map[string]int
+	// JackpotPools traz o valor atual de cada pool em Balance.
+	JackpotPools         []AccountBalance
+	JackpotContributions []JackpotContributionTotal
+	JackpotWins          map[string]int
+}
+
+// ReconciliationEntry compara o saldo gravado de uma conta com o saldo
+// recalculado a partir dos movimentos. Difference é Actual - Expected.
+type ReconciliationEntry struct {
+	AccountType ReconciliationAccountType `json:"account_type"`
+	AccountID   string                    `json:"account_id"`
+	Expected    int                       `json:"expected"`
+	Actual      int                       `json:"actual"`
+	Difference  int                       `json:"difference"`
+}
+
+func (e ReconciliationEntry) Balanced() bool {
+	return e.Difference == 0
+}
+
+// ReconciliationTotals confere o sistema como um todo: sem saques, o dinheiro
+// em jogadores, máquinas, jackpots e tesouraria deve ser igual aos depósitos
+// mais os fundos iniciais das máquinas e os ajustes aprovados. Apostas,
+// prêmios, recargas, recolhimentos, jackpots e torneios só movem valores
+// entre contas.
+type ReconciliationTotals struct {
+	Deposits            int `json:"deposits"`
+	PlayerAdjustments   int `json:"player_adjustments"`
+	MachineAdjustments  int `json:"machine_adjustments"`
+	TreasuryAdjustments int `json:"treasury_adjustments"`
+	MachineOpeningFloat int `json:"machine_opening_float"`
+	Expected            int `json:"expected"`
+	PlayerBalances      int `json:"player_balances"`
+	MachineBalances     int `json:"machine_balances"`
+	JackpotBalances     int `json:"jackpot_balances"`
+	TreasuryBalance     int `json:"treasury_balance"`
+	Actual              int `json:"actual"`
+	Difference          int `json:"difference"`
+}
+
+type ReconciliationReport struct {
+	ID            string                `json:"id"`
+	GeneratedAt   time.Time             `json:"generated_at"`
+	Balanced      bool                  `json:"balanced"`
+	Accounts      int                   `json:"accounts"`
+	Discrepancies int                   `json:"discrepancies"`
+	Totals        ReconciliationTotals  `json:"totals"`
+	Entries       []ReconciliationEntry `json:"entries"`
+}
+
+// Reconcile recalcula o saldo de cada conta a partir dos movimentos. As
+// contas divergentes vêm primeiro; dentro de cada grupo, a ordem é por tipo
+// e ID da conta.
+func (s *LedgerSnapshot) Reconcile() ([]ReconciliationEntry, ReconciliationTotals) {
+	var totals ReconciliationTotals
+	playerLedger := make(map[string]int)
+	machineLedger := make(map[string]int)
+	jackpotLedger := make(map[string]int)
+	treasuryLedger := 0
+
+	for _, total := range s.Transactions {
+		switch total.Type {
+		case TransactionDeposit:
+			playerLedger[total.PlayerID] += total.Amount
+			totals.Deposits += total.Amount
+		case TransactionBet:
+			playerLedger[total.PlayerID] -= total.Amount
+			machineLedger[total.MachineID] += total.Amount
+		case TransactionWin:
+			playerLedger[total.PlayerID] += total.Amount
+			machineLedger[total.MachineID] -= total.Amount
+		case TransactionJackpotWin:
+			playerLedger[total.PlayerID] += total.Amount
+		case TransactionAdjustmentCredit:
+			playerLedger[total.PlayerID] += total.Amount
+			totals.PlayerAdjustments += total.Amount
+		case TransactionAdjustmentDebit:
+			playerLedger[total.PlayerID] -= total.Amount
+			totals.PlayerAdjustments -= total.Amount
+		case TransactionTournamentEntry:
+			playerLedger[total.PlayerID] -= total.Amount
+		case TransactionTournamentPrize:
+			playerLedger[total.PlayerID] += total.Amount
+		}
+	}
+
+	for _, total := range s.TreasuryMovements {
+		movement := TreasuryMovement{Type: total.Type, Amount: total.Amount}
+		treasuryLedger += movement.TreasuryDelta()
+		switch total.Type {
+		case MovementRefill:
+			machineLedger[total.MachineID] += total.Amount
+		case MovementCashout:
+			machineLedger[total.MachineID] -= total.Amount
+		case MovementAdjustment:
+			totals.TreasuryAdjustments += total.Amount
+		case MovementJackpotSeed:
+			jackpotLedger[total.JackpotPoolID] += total.Amount
+		}
+	}
+
+	for _, total := range s.JackpotContributions {
+		machineLedger[total.MachineID] -= total.Amount
+		jackpotLedger[total.PoolID] += total.Amount
+	}
+	for poolID, amount := range s.JackpotWins {
+		jackpotLedger[poolID] -= amount
+	}
+
+	for machineID, amount := range s.MachineAdjustments {
+		machineLedger[machineID] += amount
+		totals.MachineAdjustments += amount
+	}
+
+	entries := make([]ReconciliationEntry, 0, len(s.Players)+len(s.Machines)+len(s.JackpotPools)+1)
+	add := func(accountType ReconciliationAccountType, id string, expected, actual int) {
+		entries = append(entries, ReconciliationEntry{
+			AccountType: accountType,
+			AccountID:   id,
+			Expected:    expected,
+			Actual:      actual,
+			Difference:  actual - expected,
+		})
+	}
+
+	seenPlayers := make(map[string]bool, len(s.Players))
+	for _, player := range s.Players {
+		seenPlayers[player.ID] = true
+		add(ReconciliationAccountPlayer, player.ID, playerLedger[player.ID], player.Balance)
+		totals.PlayerBalances += player.Balance
+	}
+	// Lançamentos de contas que não existem mais também são divergências.
+	for id, expected := range playerLedger {
+		if !seenPlayers[id] && expected != 0 {
+			add(ReconciliationAccountPlayer, id, expected, 0)
+		}
+	}
+
+	seenMachines := make(map[string]bool, len(s.Machines))
+	for _, machine := range s.Machines {
+		seenMachines[machine.ID] = true
+		add(ReconciliationAccountMachine, machine.ID, machine.InitialBalance+machineLedger[machine.ID], machine.Balance)
+		totals.MachineOpeningFloat += machine.InitialBalance
+		totals.MachineBalances += machine.Balance
+	}
+	for id, expected := range machineLedger {
+		if !seenMachines[id] && expected != 0 {
+			add(ReconciliationAccountMachine, id, expected, 0)
+		}
+	}
+
+	seenPools := make(map[string]bool, len(s.JackpotPools))
+	for _, pool := range s.JackpotPools {
+		seenPools[pool.ID] = true
+		add(ReconciliationAccountJackpot, pool.ID, jackpotLedger[pool.ID], pool.Balance)
+		totals.JackpotBalances += pool.Balance
+	}
+	for id, expected := range jackpotLedger {
+		if !seenPools[id] && expected != 0 {
+			add(ReconciliationAccountJackpot, id, expected, 0)
+		}
+	}
+
+	add(ReconciliationAccountTreasury, HouseTreasuryID, treasuryLedger, s.TreasuryBalance)
+	totals.TreasuryBalance = s.TreasuryBalance
+
+	totals.Expected = totals.Deposits + totals.PlayerAdjustments + totals.MachineAdjustments + totals.TreasuryAdjustments + totals.MachineOpeningFloat
+	totals.Actual = totals.PlayerBalances + totals.MachineBalances + totals.JackpotBalances + totals.TreasuryBalance
+	totals.Difference = totals.Actual - totals.Expected
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Balanced() != entries[j].Balanced() {
+			return !entries[i].Balanced()
+		}
+		if entries[i].AccountType != entries[j].AccountType {
+			return entries[i].AccountType < entries[j].AccountType
+		}
+		return entries[i].AccountID < entries[j].AccountID
+	})
+	return entries, totals
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// ReelStrip é a fita de um rolo: a sequência circular de símbolos e o peso
+// de cada parada. Sem Weights, todas as paradas têm peso 1. A parada mostra
+// o símbolo dela na primeira linha da grade e os seguintes nas demais.
+type ReelStrip struct {
+	Symbols []string `json:"symbols"`
+	Weights []int    `json:"weights,omitempty"`
+
+	// cumulative guarda os pesos acumulados para o sorteio por busca
+	// binária. É calculado por NewReelStrip e na leitura do JSON.
+	cumulative []int
+}
+
+func NewReelStrip(symbols []string, weights []int) ReelStrip {
+	return ReelStrip{
+		Symbols:    symbols,
+		Weights:    weights,
+		cumulative: cumulativeWeights(symbols, weights),
+	}
+}
+
+func (s *ReelStrip) UnmarshalJSON(data []byte) error {
+	type plain ReelStrip
+	var strip plain
+	if err := json.Unmarshal(data, &strip); err != nil {
+		return err
+	}
+	*s = NewReelStrip(strip.Symbols, strip.Weights)
+	return nil
+}
+
+// Weight é o peso da parada.
+func (s ReelStrip) Weight(stop int) int {
+	if len(s.Weights) == 0 {
+		return 1
+	}
+	return s.Weights[stop]
+}
+
+// TotalWeight é a soma dos pesos das paradas.
+func (s ReelStrip) TotalWeight() int {
+	cumulative := s.weights()
+	if len(cumulative) == 0 {
+		return 0
+	}
+	return cumulative[len(cumulative)-1]
+}
+
+// Stop sorteia uma parada proporcionalmente ao peso em O(log n). intn(n)
+// deve sortear um inteiro em [0, n).
+func (s ReelStrip) Stop(intn func(n int) int) int {
+	cumulative := s.weights()
+	x := intn(cumulative[len(cumulative)-1])
+	return sort.Search(len(cumulative), func(i int) bool {
+		return cumulative[i] > x
+	})
+}
+
+// Symbol retorna o símbolo exibido na linha row quando o rolo para em stop.
+func (s ReelStrip) Symbol(stop, row int) string {
+	return s.Symbols[(stop+row)%len(s.Symbols)]
+}
+
+// weights evita recalcular os pesos acumulados das fitas criadas por
+// NewReelStrip ou lidas de JSON; as demais os calculam a cada uso.
+func (s ReelStrip) weights() []int {
+	if s.cumulative != nil {
+		return s.cumulative
+	}
+	return cumulativeWeights(s.Symbols, s.Weights)
+}
+
+func cumulativeWeights(symbols []string, weights []int) []int {
+	cumulative := make([]int, len(symbols))
+	total := 0
+	for i := range symbols {
+		if len(weights) == 0 {
+			total++
+		} else {
+			total += weights[i]
+		}
+		cumulative[i] = total
+	}
+	return cumulative
+}
+
+// LevelReelStrips monta a configuração equivalente às antigas permutações
+// por Level: fitas iguais com cada símbolo uma vez e peso de parada alinhada
+// Level × símbolos. Assim cada combinação sorteada independentemente tem
+// peso 1 e cada combinação de símbolos iguais ganha Level de peso extra,
+// como as Level cópias de cada trinca.
+func LevelReelStrips(symbols map[string]string, reels, level int) ([]ReelStrip, int) {
+	keys := make([]string, 0, len(symbols))
+	for k := range symbols {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	strips := make([]ReelStrip, reels)
+	for i := range strips {
+		strips[i] = NewReelStrip(keys, nil)
+	}
+	return strips, level * len(keys)
+}
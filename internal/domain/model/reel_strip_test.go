@@ -0,0 +1,140 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// legacyPermutations reproduz a antiga geração de permutações por Level,
+// usada como referência para as fitas equivalentes.
+func legacyPermutations(symbols map[string]string, reels, level int) [][]string {
+	keys := make([]string, 0, len(symbols))
+	for k := range symbols {
+		keys = append(keys, k)
+	}
+
+	perms := [][]string{{}}
+	for i := 0; i < reels; i++ {
+		next := make([][]string, 0, len(perms)*len(keys))
+		for _, perm := range perms {
+			for _, sym := range keys {
+				next = append(next, append(append(make([]string, 0, reels), perm...), sym))
+			}
+		}
+		perms = next
+	}
+
+	for j := 0; j < level; j++ {
+		for _, sym := range keys {
+			perm := make([]string, reels)
+			for i := range perm {
+				perm[i] = sym
+			}
+			perms = append(perms, perm)
+		}
+	}
+	return perms
+}
+
+func TestLevelReelStrips(t *testing.T) {
+	for _, tc := range []struct{ reels, level int }{{3, 1}, {3, 5}, {5, 3}} {
+		perms := legacyPermutations(DefaultSymbols(), tc.reels, tc.level)
+		wins := 0
+		for _, perm := range perms {
+			if len(unique(perm)) == 1 {
+				wins++
+			}
+		}
+
+		sm := &SlotMachine{Level: tc.level, Symbols: DefaultSymbols(), MultipleGain: 1}
+		sm.ConfigureGrid(tc.reels, 1, nil, nil)
+
+		// Com Multiplier 1, cada linha vencedora devolve 2 vezes a aposta.
+		expected := 2 * float64(wins) / float64(len(perms))
+		assert.InDelta(t, expected, sm.TheoreticalRTP(), 1e-9, "reels=%d level=%d", tc.reels, tc.level)
+	}
+}
+
+func TestReelStrip_Stop(t *testing.T) {
+	strip := NewReelStrip([]string{"A", "B", "C"}, []int{1, 0, 3})
+
+	stops := make([]int, strip.TotalWeight())
+	for x := range stops {
+		stops[x] = strip.Stop(func(int) int { return x })
+	}
+	assert.Equal(t, []int{0, 2, 2, 2}, stops, "Paradas de peso zero nunca devem ser sorteadas")
+	assert.Equal(t, "A", strip.Symbol(2, 1), "A fita deve ser circular")
+}
+
+func TestReelStrip_StopDistribution(t *testing.T) {
+	strip := NewReelStrip([]string{"A", "B", "C", "D"}, []int{5, 3, 1, 1})
+	rng := rand.New(rand.NewSource(7))
+
+	const draws = 20000
+	observed := make([]int, len(strip.Symbols))
+	for i := 0; i < draws; i++ {
+		observed[strip.Stop(rng.Intn)]++
+	}
+
+	// Qui-quadrado com 3 graus de liberdade; 16.27 é o valor crítico para
+	// p = 0.001.
+	chiSquare := 0.0
+	for stop, count := range observed {
+		expected := float64(draws*strip.Weight(stop)) / float64(strip.TotalWeight())
+		diff := float64(count) - expected
+		chiSquare += diff * diff / expected
+	}
+	assert.Less(t, chiSquare, 16.27, "Paradas observadas: %v", observed)
+}
+
+func TestSlotMachine_Peek(t *testing.T) {
+	sm := &SlotMachine{
+		Rows: 2,
+		Strips: []ReelStrip{
+			NewReelStrip([]string{"A", "B", "C", "D"}, nil),
+			NewReelStrip([]string{"E", "F", "G"}, nil),
+		},
+	}
+
+	stops := []int{0, 1}
+	assert.Equal(t, Grid{{"A", "F"}, {"B", "G"}}, sm.Window(stops))
+	assert.Equal(t, NearMissPeek{Above: []string{"D", "E"}, Below: []string{"C", "E"}}, sm.Peek(stops))
+}
+
+func unique(symbols []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(symbols))
+	for _, sym := range symbols {
+		set[sym] = struct{}{}
+	}
+	return set
+}
+
+func BenchmarkSpin_Permutations(b *testing.B) {
+	for _, reels := range []int{3, 5} {
+		b.Run(fmt.Sprintf("%d_reels", reels), func(b *testing.B) {
+			rng := rand.New(rand.NewSource(1))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				perms := legacyPermutations(DefaultSymbols(), reels, 3)
+				_ = append([]string(nil), perms[rng.Intn(len(perms))]...)
+			}
+		})
+	}
+}
+
+func BenchmarkSpin_ReelStrips(b *testing.B) {
+	for _, reels := range []int{3, 5} {
+		b.Run(fmt.Sprintf("%d_reels", reels), func(b *testing.B) {
+			rng := rand.New(rand.NewSource(1))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sm := &SlotMachine{Level: 3, Symbols: DefaultSymbols()}
+				sm.ConfigureGrid(reels, 1, nil, nil)
+				_ = sm.Draw(rng.Intn)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+type ExclusionType string
+
+const (
+	// ExclusionCoolOff é uma pausa curta: o jogador ainda acessa a conta,
+	// mas não pode jogar nem depositar.
+	ExclusionCoolOff ExclusionType = "cool_off"
+	// ExclusionSelfExclusion bloqueia o login durante todo o período.
+	ExclusionSelfExclusion ExclusionType = "self_exclusion"
+)
+
+// SelfExclusion é a exclusão vigente (ou a última) de um jogador. EndsAt nil
+// significa exclusão permanente.
+type SelfExclusion struct {
+	PlayerID  string        `json:"-"`
+	Type      ExclusionType `json:"type"`
+	StartedAt time.Time     `json:"started_at"`
+	EndsAt    *time.Time    `json:"ends_at,omitempty"`
+	LiftedAt  *time.Time    `json:"lifted_at,omitempty"`
+	LiftedBy  string        `json:"lifted_by,omitempty"`
+}
+
+func (e *SelfExclusion) IsPermanent() bool {
+	return e.EndsAt == nil
+}
+
+func (e *SelfExclusion) IsActive(now time.Time) bool {
+	if e.LiftedAt != nil {
+		return false
+	}
+	return e.EndsAt == nil || now.Before(*e.EndsAt)
+}
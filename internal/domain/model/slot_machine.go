@@ -1,14 +1,28 @@
 package model
 
+// SlotMachine tem uma fita por rolo em Strips; a grade mostra, em cada
+// rolo, os símbolos a partir da parada sorteada. AlignedWeight, usado pelas
+// máquinas configuradas por Level, é o peso de todos os rolos pararem na
+// mesma posição. Os prêmios são pagos por linha de pagamento conforme o
+// Paytable; sem ele, apenas a linha completa paga MultipleGain. NearMiss
+// só muda a apresentação, e apenas onde Jurisdiction permitir.
 type SlotMachine struct {
 	ID             string            `json:"id"`
 	Level          int               `json:"level"`
 	Balance        int               `json:"balance"`
 	InitialBalance int               `json:"initial_balance"`
 	Symbols        map[string]string `json:"symbols"`
-	Permutations   [][3]string       `json:"permutations"`
+	Strips         []ReelStrip       `json:"strips"`
+	AlignedWeight  int               `json:"aligned_weight,omitempty"`
 	MultipleGain   int               `json:"multiple_gain"`
 	Description    string            `json:"description"`
+	Reels          int               `json:"reels"`
+	Rows           int               `json:"rows"`
+	Paylines       []Payline         `json:"paylines"`
+	Paytable       []LinePay         `json:"paytable,omitempty"`
+	Bonus          *BonusFeature     `json:"bonus,omitempty"`
+	Jurisdiction   string            `json:"jurisdiction,omitempty"`
+	NearMiss       NearMissMode      `json:"near_miss,omitempty"`
 }
 
 func DefaultSymbols() map[string]string {
@@ -32,31 +46,229 @@ func NewSlotMachine(id string, level, balance int, multipleGain int, description
 	}
 
 	sm.Symbols = DefaultSymbols()
-	sm.GeneratePermutations()
+	sm.ConfigureGrid(DefaultReels, DefaultRows, nil, nil)
 
 	return sm
 }
 
-func (sm *SlotMachine) GeneratePermutations() {
-	perms := [][3]string{}
-	symbolKeys := make([]string, 0, len(sm.Symbols))
-	for k := range sm.Symbols {
-		symbolKeys = append(symbolKeys, k)
+// ConfigureGrid define a grade da máquina com as fitas equivalentes ao
+// Level. Sem linhas de pagamento, usa DefaultPaylines.
+func (sm *SlotMachine) ConfigureGrid(reels, rows int, paylines []Payline, paytable []LinePay) {
+	if len(paylines) == 0 {
+		paylines = DefaultPaylines(reels, rows)
 	}
+	sm.Reels = reels
+	sm.Rows = rows
+	sm.Paylines = paylines
+	sm.Paytable = paytable
+	sm.Strips, sm.AlignedWeight = LevelReelStrips(sm.Symbols, reels, sm.Level)
+}
+
+// ReelCount considera máquinas sem grade configurada como de três rolos.
+func (sm *SlotMachine) ReelCount() int {
+	if sm.Reels > 0 {
+		return sm.Reels
+	}
+	if len(sm.Strips) > 0 {
+		return len(sm.Strips)
+	}
+	return DefaultReels
+}
+
+// RowCount considera máquinas sem grade configurada como de uma linha.
+func (sm *SlotMachine) RowCount() int {
+	if sm.Rows > 0 {
+		return sm.Rows
+	}
+	return DefaultRows
+}
+
+// EffectivePaylines retorna as linhas de pagamento configuradas ou as
+// padrão da grade.
+func (sm *SlotMachine) EffectivePaylines() []Payline {
+	if len(sm.Paylines) > 0 {
+		return sm.Paylines
+	}
+	return DefaultPaylines(sm.ReelCount(), sm.RowCount())
+}
+
+// EffectivePaytable retorna a tabela configurada ou, sem ela, o pagamento de
+// MultipleGain para a linha completa.
+func (sm *SlotMachine) EffectivePaytable() []LinePay {
+	if len(sm.Paytable) > 0 {
+		return sm.Paytable
+	}
+	return []LinePay{{Count: sm.ReelCount(), Multiplier: sm.MultipleGain}}
+}
+
+// independentWeight é o peso total das paradas independentes: o produto dos
+// pesos totais das fitas.
+func (sm *SlotMachine) independentWeight() int {
+	weight := 1
+	for _, strip := range sm.Strips {
+		weight *= strip.TotalWeight()
+	}
+	return weight
+}
+
+// Draw sorteia a grade a partir das paradas de Stops.
+func (sm *SlotMachine) Draw(intn func(n int) int) Grid {
+	return sm.Window(sm.Stops(intn))
+}
+
+// Stops sorteia a parada de cada rolo. Com peso AlignedWeight contra o das
+// paradas independentes, todos os rolos param na mesma posição da fita;
+// caso contrário, cada rolo para de forma independente. intn(n) deve
+// sortear um inteiro em [0, n).
+func (sm *SlotMachine) Stops(intn func(n int) int) []int {
+	stops := make([]int, len(sm.Strips))
+	if sm.AlignedWeight > 0 && intn(sm.AlignedWeight+sm.independentWeight()) < sm.AlignedWeight {
+		stop := sm.Strips[0].Stop(intn)
+		for i := range stops {
+			stops[i] = stop
+		}
+	} else {
+		for i, strip := range sm.Strips {
+			stops[i] = strip.Stop(intn)
+		}
+	}
+	return stops
+}
+
+// Window monta a grade visível com os rolos parados em stops.
+func (sm *SlotMachine) Window(stops []int) Grid {
+	grid := make(Grid, sm.RowCount())
+	for row := range grid {
+		grid[row] = make([]string, len(sm.Strips))
+		for reel, strip := range sm.Strips {
+			grid[row][reel] = strip.Symbol(stops[reel], row)
+		}
+	}
+	return grid
+}
+
+// Peek retorna os símbolos das fitas logo acima e logo abaixo da grade com
+// os rolos parados em stops.
+func (sm *SlotMachine) Peek(stops []int) NearMissPeek {
+	peek := NearMissPeek{
+		Above: make([]string, len(sm.Strips)),
+		Below: make([]string, len(sm.Strips)),
+	}
+	for reel, strip := range sm.Strips {
+		peek.Above[reel] = strip.Symbol(stops[reel], len(strip.Symbols)-1)
+		peek.Below[reel] = strip.Symbol(stops[reel], sm.RowCount())
+	}
+	return peek
+}
+
+// EvaluateLines avalia as primeiras lines linhas de pagamento da grade.
+// Cada linha paga pelo maior prêmio do Paytable alcançado pela sequência de
+// símbolos iguais a partir do primeiro rolo.
+func (sm *SlotMachine) EvaluateLines(grid Grid, lines, lineBet int) []LineWin {
+	pays := sortedLinePays(sm.EffectivePaytable())
+
+	var wins []LineWin
+	for i, line := range sm.EffectivePaylines()[:lines] {
+		symbols := grid.Symbols(line)
+		run := 1
+		for run < len(symbols) && symbols[run] == symbols[0] {
+			run++
+		}
 
-	for _, a := range symbolKeys {
-		for _, b := range symbolKeys {
-			for _, c := range symbolKeys {
-				perms = append(perms, [3]string{a, b, c})
+		best := -1
+		for j, pay := range pays {
+			if pay.Count <= run {
+				best = j
 			}
 		}
+		if best < 0 {
+			continue
+		}
+		wins = append(wins, LineWin{
+			Line:   i + 1,
+			Symbol: symbols[0],
+			Count:  run,
+			Payout: lineBet * (pays[best].Multiplier + 1),
+		})
 	}
+	return wins
+}
 
-	for j := 0; j < sm.Level; j++ {
-		for _, sym := range symbolKeys {
-			perms = append(perms, [3]string{sym, sym, sym})
+// TheoreticalRTP é o retorno esperado declarado pela configuração, na média
+// das linhas de pagamento: para cada linha, a soma dos pagamentos brutos
+// (Multiplier + 1) ponderados pela probabilidade de alcançá-los.
+func (sm *SlotMachine) TheoreticalRTP() float64 {
+	paylines := sm.EffectivePaylines()
+	if len(sm.Strips) == 0 || len(paylines) == 0 {
+		return 0
+	}
+	pays := sortedLinePays(sm.EffectivePaytable())
+
+	symbols := make(map[string]struct{})
+	for _, strip := range sm.Strips {
+		for _, sym := range strip.Symbols {
+			symbols[sym] = struct{}{}
 		}
 	}
+	aligned := 0.0
+	if sm.AlignedWeight > 0 {
+		aligned = float64(sm.AlignedWeight) / float64(sm.AlignedWeight+sm.independentWeight())
+	}
 
-	sm.Permutations = perms
+	total := 0.0
+	for _, line := range paylines {
+		for sym := range symbols {
+			// Cada faixa do Paytable soma a diferença para a faixa anterior,
+			// já que a linha recebe apenas o maior prêmio alcançado.
+			previous := 0
+			for _, pay := range pays {
+				if pay.Count > len(line) {
+					break
+				}
+				probability := (1-aligned)*sm.independentPrefix(line, sym, pay.Count) +
+					aligned*sm.alignedPrefix(line, sym, pay.Count)
+				gross := pay.Multiplier + 1
+				total += probability * float64(gross-previous)
+				previous = gross
+			}
+		}
+	}
+	return total / float64(len(paylines))
+}
+
+// independentPrefix é a probabilidade de os count primeiros rolos da linha
+// trazerem sym quando cada rolo para de forma independente.
+func (sm *SlotMachine) independentPrefix(line Payline, sym string, count int) float64 {
+	probability := 1.0
+	for reel := 0; reel < count; reel++ {
+		strip := sm.Strips[reel]
+		matches := 0
+		for stop := range strip.Symbols {
+			if strip.Symbol(stop, line[reel]) == sym {
+				matches += strip.Weight(stop)
+			}
+		}
+		probability *= float64(matches) / float64(strip.TotalWeight())
+	}
+	return probability
+}
+
+// alignedPrefix é a mesma probabilidade quando todos os rolos param na
+// posição sorteada na primeira fita.
+func (sm *SlotMachine) alignedPrefix(line Payline, sym string, count int) float64 {
+	first := sm.Strips[0]
+	matches := 0
+	for stop := range first.Symbols {
+		match := true
+		for reel := 0; reel < count; reel++ {
+			if sm.Strips[reel].Symbol(stop, line[reel]) != sym {
+				match = false
+				break
+			}
+		}
+		if match {
+			matches += first.Weight(stop)
+		}
+	}
+	return float64(matches) / float64(first.TotalWeight())
 }
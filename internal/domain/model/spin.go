@@ -0,0 +1,81 @@
+package model
+
+import "time"
+
+// Spin registra uma jogada liquidada. Payout é o valor bruto pago pela
+// máquina ao jogador (aposta incluída) e é zero quando não há prêmio. Em
+// máquinas ligadas a um jackpot, JackpotContribution é a parte da aposta que
+// foi para o pool e JackpotWin o jackpot pago na jogada.
+type Spin struct {
+	ID                  string    `json:"id"`
+	PlayerID            string    `json:"player_id"`
+	MachineID           string    `json:"machine_id"`
+	Bet                 int       `json:"bet"`
+	Payout              int       `json:"payout"`
+	JackpotPoolID       string    `json:"jackpot_pool_id,omitempty"`
+	JackpotContribution int       `json:"jackpot_contribution,omitempty"`
+	JackpotWin          int       `json:"jackpot_win,omitempty"`
+	Result              []string  `json:"result"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// IsWin considera apenas o prêmio da máquina.
+func (s *Spin) IsWin() bool {
+	return s.Payout > 0
+}
+
+// TotalWon é tudo que o jogador recebeu na jogada, jackpot incluído.
+func (s *Spin) TotalWon() int {
+	return s.Payout + s.JackpotWin
+}
+
+type StatsGranularity string
+
+const (
+	GranularityHour StatsGranularity = "hour"
+	GranularityDay  StatsGranularity = "day"
+)
+
+// Duration é o tamanho de cada intervalo da série.
+func (g StatsGranularity) Duration() time.Duration {
+	if g == GranularityHour {
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// Truncate devolve o início do intervalo (em UTC) que contém t.
+func (g StatsGranularity) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	if g == GranularityHour {
+		return t.Truncate(time.Hour)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// SpinAggregate soma as jogadas de um período. Start é zero no total geral.
+type SpinAggregate struct {
+	Start         time.Time `json:"start,omitempty"`
+	Spins         int       `json:"spins"`
+	Wins          int       `json:"wins"`
+	TotalWagered  int       `json:"total_wagered"`
+	TotalPaid     int       `json:"total_paid"`
+	UniquePlayers int       `json:"unique_players"`
+	LargestWin    int       `json:"largest_win"`
+}
+
+// RTP é a fração das apostas devolvida em prêmios.
+func (a SpinAggregate) RTP() float64 {
+	if a.TotalWagered == 0 {
+		return 0
+	}
+	return float64(a.TotalPaid) / float64(a.TotalWagered)
+}
+
+// HitFrequency é a fração das jogadas com prêmio.
+func (a SpinAggregate) HitFrequency() float64 {
+	if a.Spins == 0 {
+		return 0
+	}
+	return float64(a.Wins) / float64(a.Spins)
+}
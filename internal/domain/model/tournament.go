@@ -0,0 +1,141 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// TournamentScoring define como cada jogada pontua no torneio.
+type TournamentScoring string
+
+const (
+	// TournamentScoringTotalWagered soma o valor apostado, sem as rodadas
+	// grátis.
+	TournamentScoringTotalWagered TournamentScoring = "total_wagered"
+	// TournamentScoringBiggestMultiplier guarda o maior prêmio de linha em
+	// relação à aposta total, em centésimos (um prêmio de 2,5x vale 250).
+	TournamentScoringBiggestMultiplier TournamentScoring = "biggest_multiplier"
+)
+
+func IsValidTournamentScoring(scoring TournamentScoring) bool {
+	return scoring == TournamentScoringTotalWagered || scoring == TournamentScoringBiggestMultiplier
+}
+
+type TournamentStatus string
+
+const (
+	TournamentStatusOpen TournamentStatus = "open"
+	// TournamentStatusSettled marca os torneios encerrados e com os prêmios
+	// já pagos.
+	TournamentStatusSettled TournamentStatus = "settled"
+)
+
+// Tournament pontua as jogadas dos inscritos nas máquinas MachineIDs entre
+// StartsAt e EndsAt. O prêmio total é GuaranteedPrize, pago pela tesouraria,
+// mais as inscrições; PrizeDistribution é o percentual de cada colocação, a
+// partir da primeira. O que sobra do arredondamento fica com a tesouraria.
+type Tournament struct {
+	ID                string            `json:"id"`
+	Name              string            `json:"name"`
+	MachineIDs        []string          `json:"machine_ids"`
+	StartsAt          time.Time         `json:"starts_at"`
+	EndsAt            time.Time         `json:"ends_at"`
+	EntryFee          int               `json:"entry_fee"`
+	GuaranteedPrize   int               `json:"guaranteed_prize"`
+	PrizeDistribution []int             `json:"prize_distribution"`
+	Scoring           TournamentScoring `json:"scoring"`
+	Status            TournamentStatus  `json:"status"`
+	Entrants          int               `json:"entrants"`
+	CreatedBy         string            `json:"created_by"`
+	CreatedAt         time.Time         `json:"created_at"`
+	SettledAt         *time.Time        `json:"settled_at,omitempty"`
+}
+
+// Running indica se as jogadas em now pontuam.
+func (t *Tournament) Running(now time.Time) bool {
+	return t.Status == TournamentStatusOpen && !now.Before(t.StartsAt) && now.Before(t.EndsAt)
+}
+
+// AcceptsEntries permite inscrições até o fim do torneio.
+func (t *Tournament) AcceptsEntries(now time.Time) bool {
+	return t.Status == TournamentStatusOpen && now.Before(t.EndsAt)
+}
+
+func (t *Tournament) Eligible(machineID string) bool {
+	for _, id := range t.MachineIDs {
+		if id == machineID {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Tournament) PrizePool() int {
+	return t.GuaranteedPrize + t.EntryFee*t.Entrants
+}
+
+// Points é o que uma jogada com aposta total totalBet, dos quais wagered
+// foram cobrados, e prêmio payout soma ao torneio. No maior multiplicador,
+// o repositório mantém o maior valor em vez de somar.
+func (t *Tournament) Points(totalBet, wagered, payout int) int {
+	switch t.Scoring {
+	case TournamentScoringTotalWagered:
+		return wagered
+	case TournamentScoringBiggestMultiplier:
+		if totalBet == 0 {
+			return 0
+		}
+		return payout * 100 / totalBet
+	}
+	return 0
+}
+
+// TournamentEntry é a inscrição de um jogador e sua pontuação. ScoredAt é a
+// última vez em que a pontuação subiu e desempata a classificação: chega na
+// frente quem alcançou a pontuação primeiro. Rank e Prize são preenchidos na
+// classificação; Prize é o valor pago após o encerramento ou, antes dele, o
+// que a colocação pagaria.
+type TournamentEntry struct {
+	TournamentID string     `json:"tournament_id"`
+	PlayerID     string     `json:"player_id"`
+	Score        int        `json:"score"`
+	Spins        int        `json:"spins"`
+	JoinedAt     time.Time  `json:"joined_at"`
+	ScoredAt     *time.Time `json:"scored_at,omitempty"`
+	Rank         int        `json:"rank"`
+	Prize        int        `json:"prize"`
+}
+
+// Rank ordena as inscrições pela pontuação e distribui o prêmio total entre
+// as primeiras colocações. Só concorrem a prêmios os inscritos que jogaram
+// ao menos uma vez no torneio.
+func (t *Tournament) Rank(entries []*TournamentEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if (a.ScoredAt == nil) != (b.ScoredAt == nil) {
+			return a.ScoredAt != nil
+		}
+		if a.ScoredAt != nil && !a.ScoredAt.Equal(*b.ScoredAt) {
+			return a.ScoredAt.Before(*b.ScoredAt)
+		}
+		if (a.Spins > 0) != (b.Spins > 0) {
+			return a.Spins > 0
+		}
+		if !a.JoinedAt.Equal(b.JoinedAt) {
+			return a.JoinedAt.Before(b.JoinedAt)
+		}
+		return a.PlayerID < b.PlayerID
+	})
+
+	pool := t.PrizePool()
+	for i, entry := range entries {
+		entry.Rank = i + 1
+		entry.Prize = 0
+		if i < len(t.PrizeDistribution) && entry.Spins > 0 {
+			entry.Prize = pool * t.PrizeDistribution[i] / 100
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTournament_Points(t *testing.T) {
+	wagered := &Tournament{Scoring: TournamentScoringTotalWagered}
+	assert.Equal(t, 20, wagered.Points(20, 20, 60))
+	assert.Equal(t, 0, wagered.Points(20, 0, 60), "Rodadas grátis não somam valor apostado")
+
+	multiplier := &Tournament{Scoring: TournamentScoringBiggestMultiplier}
+	assert.Equal(t, 250, multiplier.Points(20, 20, 50))
+	assert.Equal(t, 0, multiplier.Points(20, 20, 0))
+	assert.Equal(t, 300, multiplier.Points(20, 0, 60), "Rodadas grátis pontuam pelo prêmio")
+}
+
+func TestTournament_Rank(t *testing.T) {
+	start := time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) *time.Time {
+		moment := start.Add(time.Duration(minutes) * time.Minute)
+		return &moment
+	}
+
+	tournament := &Tournament{GuaranteedPrize: 1, EntryFee: 25, Entrants: 4, PrizeDistribution: []int{50, 30, 20}}
+	entries := []*TournamentEntry{
+		{PlayerID: "late", Score: 100, Spins: 3, ScoredAt: at(10), JoinedAt: start},
+		{PlayerID: "idle", JoinedAt: start},
+		{PlayerID: "early", Score: 100, Spins: 5, ScoredAt: at(5), JoinedAt: start.Add(time.Minute)},
+		{PlayerID: "unlucky", Spins: 2, JoinedAt: start.Add(time.Minute)},
+	}
+	tournament.Rank(entries)
+
+	ranking := make([]string, len(entries))
+	for i, entry := range entries {
+		ranking[i] = entry.PlayerID
+		assert.Equal(t, i+1, entry.Rank)
+	}
+	assert.Equal(t, []string{"early", "late", "unlucky", "idle"}, ranking, "Empates ficam com quem pontuou primeiro")
+
+	// Prêmio total de 101: os centavos do arredondamento ficam com a casa.
+	assert.Equal(t, 50, entries[0].Prize)
+	assert.Equal(t, 30, entries[1].Prize)
+	assert.Equal(t, 20, entries[2].Prize)
+	assert.Equal(t, 0, entries[3].Prize)
+
+	tournament.PrizeDistribution = []int{40, 30, 20, 10}
+	tournament.Rank(entries)
+	assert.Equal(t, 0, entries[3].Prize, "Quem não jogou não recebe prêmio")
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type TransactionType string
+
+const (
+	TransactionDeposit TransactionType = "deposit"
+	TransactionBet     TransactionType = "bet"
+	TransactionWin     TransactionType = "win"
+	// Prêmio pago por um jackpot progressivo, e não pela máquina.
+	TransactionJackpotWin TransactionType = "jackpot_win"
+	// Ajustes manuais aprovados pela administração.
+	TransactionAdjustmentCredit TransactionType = "adjustment_credit"
+	TransactionAdjustmentDebit  TransactionType = "adjustment_debit"
+	// Inscrição paga em um torneio e prêmio recebido no encerramento.
+	TransactionTournamentEntry TransactionType = "tournament_entry"
+	TransactionTournamentPrize TransactionType = "tournament_prize"
+)
+
+// Transaction é um movimento na carteira do jogador. Apostas debitam e
+// prêmios creditam o valor bruto (aposta incluída).
+type Transaction struct {
+	ID        string          `json:"id"`
+	PlayerID  string          `json:"player_id"`
+	Type      TransactionType `json:"type"`
+	Amount    int             `json:"amount"`
+	MachineID string          `json:"machine_id,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// HouseTreasuryID identifica a conta da casa, de onde saem as recargas das
+// máquinas e para onde vão os recolhimentos.
+const HouseTreasuryID = "house"
+
+type Treasury struct {
+	ID        string    `json:"id"`
+	Balance   int       `json:"balance"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TreasuryMovementType string
+
+const (
+	// MovementRefill leva fundos da tesouraria para a máquina.
+	MovementRefill TreasuryMovementType = "refill"
+	// MovementCashout recolhe fundos da máquina para a tesouraria.
+	MovementCashout TreasuryMovementType = "cashout"
+	// MovementAdjustment é um ajuste aprovado diretamente na tesouraria.
+	MovementAdjustment TreasuryMovementType = "adjustment"
+	// MovementJackpotSeed leva fundos da tesouraria para o valor inicial de
+	// um jackpot.
+	MovementJackpotSeed TreasuryMovementType = "jackpot_seed"
+	// MovementTournamentEntry recebe na tesouraria a inscrição de um torneio.
+	MovementTournamentEntry TreasuryMovementType = "tournament_entry"
+	// MovementTournamentPrize leva fundos da tesouraria para o prêmio de um
+	// torneio.
+	MovementTournamentPrize TreasuryMovementType = "tournament_prize"
+)
+
+// TreasuryMovement registra cada alteração no saldo da tesouraria. Amount é
+// sempre positivo para recargas e recolhimentos; em ajustes, o sinal indica
+// crédito ou débito. Os saldos são os resultantes após o movimento.
+type TreasuryMovement struct {
+	ID              string               `json:"id"`
+	Type            TreasuryMovementType `json:"type"`
+	MachineID       string               `json:"machine_id,omitempty"`
+	JackpotPoolID   string               `json:"jackpot_pool_id,omitempty"`
+	Amount          int                  `json:"amount"`
+	Reason          string               `json:"reason"`
+	Actor           string               `json:"actor"`
+	TreasuryBalance int                  `json:"treasury_balance"`
+	MachineBalance  int                  `json:"machine_balance,omitempty"`
+	CreatedAt       time.Time            `json:"created_at"`
+}
+
+// TreasuryDelta é a variação que o movimento causa na tesouraria.
+func (m *TreasuryMovement) TreasuryDelta() int {
+	switch m.Type {
+	case MovementRefill, MovementJackpotSeed, MovementTournamentPrize:
+		return -m.Amount
+	case MovementCashout:
+		return m.Amount
+	}
+	return m.Amount
+}
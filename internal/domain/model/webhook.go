@@ -0,0 +1,55 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookSubscription recebe os eventos de domínio dos tipos informados. O
+// segredo assina as entregas e por isso é guardado como recebido; ele só é
+// devolvido na criação.
+type WebhookSubscription struct {
+	ID         string            `json:"id"`
+	URL        string            `json:"url"`
+	EventTypes []DomainEventType `json:"event_types"`
+	Secret     string            `json:"-"`
+	CreatedBy  string            `json:"created_by"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+func (s *WebhookSubscription) Accepts(eventType DomainEventType) bool {
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryDead marca as entregas que esgotaram as tentativas ou
+	// perderam a assinatura; só voltam à fila por um reenvio manual.
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+// WebhookDelivery é a entrega de um evento a uma assinatura. Payload é o
+// evento serializado, enviado sem alterações a cada tentativa.
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	SubscriptionID string                `json:"subscription_id"`
+	EventID        string                `json:"event_id"`
+	EventType      DomainEventType       `json:"event_type"`
+	Payload        json.RawMessage       `json:"payload" swaggertype:"object"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	LastAttemptAt  *time.Time            `json:"last_attempt_at,omitempty"`
+	LastStatusCode int                   `json:"last_status_code,omitempty"`
+	LastError      string                `json:"last_error,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+}
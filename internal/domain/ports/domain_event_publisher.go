@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+)
+
+// DomainEventPublisher entrega um evento do outbox a um sistema externo. Um
+// erro faz o relay tentar o mesmo evento de novo, então o destino deve
+// descartar duplicatas pelo ID do evento.
+type DomainEventPublisher interface {
+	Publish(ctx context.Context, event *model.DomainEvent) error
+}
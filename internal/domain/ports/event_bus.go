@@ -0,0 +1,15 @@
+package ports
+
+import "slot-machine/internal/domain/model"
+
+// EventPublisher entrega eventos aos clientes conectados. Publish não deve
+// bloquear quem publica: um assinante lento pode perder eventos.
+type EventPublisher interface {
+	Publish(event model.Event)
+}
+
+// EventSubscriber entrega ao jogador os eventos dele e os gerais. A função
+// retornada encerra a assinatura e fecha o canal.
+type EventSubscriber interface {
+	Subscribe(playerID string) (<-chan model.Event, func())
+}
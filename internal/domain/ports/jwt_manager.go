@@ -7,6 +7,7 @@ type TokenType string
 const (
     TokenTypeAccess  TokenType = "access"
     TokenTypeRefresh TokenType = "refresh"
+    TokenTypeMFA     TokenType = "mfa"
 )
 
 type JWTClaims struct {
@@ -20,4 +21,6 @@ type JWTManager interface {
     GenerateRefreshToken(userID string) (string, error)
     VerifyAccessToken(token string) (*JWTClaims, error)
     VerifyRefreshToken(token string) (*JWTClaims, error)
+    GenerateMFAToken(userID string) (string, error)
+    VerifyMFAToken(token string) (*JWTClaims, error)
 }
\ No newline at end of file
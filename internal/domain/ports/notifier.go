@@ -0,0 +1,13 @@
+package ports
+
+import "context"
+
+type Notification struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+)
+
+type ReconciliationReportWriter interface {
+	// WriteReconciliationReport grava o relatório e retorna onde ele ficou.
+	WriteReconciliationReport(ctx context.Context, report *model.ReconciliationReport) ([]string, error)
+}
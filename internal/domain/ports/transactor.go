@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// Transactor executa fn de forma atômica: as escritas feitas pelos
+// repositórios com o contexto recebido por fn são confirmadas juntas ou
+// descartadas se fn retornar erro.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
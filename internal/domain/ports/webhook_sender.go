@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookRequest é uma tentativa de entrega. O remetente assina Body com
+// Secret e Timestamp para que o destino confira a origem e recuse
+// reenvios antigos.
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventID    string
+	EventType  string
+	Timestamp  time.Time
+	Body       []byte
+}
+
+// WebhookSender devolve o status HTTP da resposta. O erro indica falha de
+// rede; um status fora de 2xx não é erro.
+type WebhookSender interface {
+	Send(ctx context.Context, req WebhookRequest) (int, error)
+}
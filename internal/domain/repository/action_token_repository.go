@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+var (
+	ErrActionTokenNotFound = errors.New("action token not found")
+)
+
+type ActionTokenRepository interface {
+	StoreActionToken(ctx context.Context, token *model.ActionToken) error
+	GetActionToken(ctx context.Context, tokenHash string) (*model.ActionToken, error)
+	// ConsumeActionToken remove e devolve o token se ele ainda for válido
+	// para o propósito informado. Duas chamadas concorrentes com o mesmo token
+	// nunca têm sucesso juntas.
+	ConsumeActionToken(ctx context.Context, tokenHash string, purpose model.TokenPurpose, now time.Time) (*model.ActionToken, error)
+	DeletePlayerActionTokens(ctx context.Context, playerID string, purpose model.TokenPurpose) error
+}
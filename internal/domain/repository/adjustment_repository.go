@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+)
+
+var (
+	ErrAdjustmentNotFound   = errors.New("adjustment not found")
+	ErrAdjustmentNotPending = errors.New("adjustment is not pending")
+)
+
+type AdjustmentRepository interface {
+	CreateAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error
+	GetAdjustment(ctx context.Context, id string) (*model.BalanceAdjustment, error)
+	// ListAdjustments retorna os ajustes mais recentes primeiro. Um status
+	// vazio não filtra.
+	ListAdjustments(ctx context.Context, status model.AdjustmentStatus) ([]*model.BalanceAdjustment, error)
+	// ApplyAdjustment marca o ajuste pendente como aprovado e altera o saldo
+	// do alvo de forma atômica. Retorna ErrAdjustmentNotPending se o ajuste
+	// já foi revisado.
+	ApplyAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error
+	// RejectAdjustment marca o ajuste pendente como rejeitado.
+	RejectAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error
+}
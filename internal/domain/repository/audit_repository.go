@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+// AuditFilter restringe a listagem do log de auditoria. Campos vazios não
+// são filtrados.
+type AuditFilter struct {
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+type AuditRepository interface {
+	AppendAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+	ListAuditEntries(ctx context.Context, filter AuditFilter) ([]*model.AuditEntry, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+)
+
+var (
+	ErrFreeSpinsNotFound = errors.New("free spins not found")
+)
+
+type FreeSpinRepository interface {
+	GetFreeSpins(ctx context.Context, playerID, machineID string) (*model.FreeSpins, error)
+	SaveFreeSpins(ctx context.Context, freeSpins *model.FreeSpins) error
+}
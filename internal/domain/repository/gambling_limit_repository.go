@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+)
+
+var (
+	ErrGamblingLimitNotFound = errors.New("gambling limit not found")
+)
+
+type GamblingLimitRepository interface {
+	GetGamblingLimit(ctx context.Context, playerID string, limitType model.LimitType, period model.LimitPeriod) (*model.GamblingLimit, error)
+	ListGamblingLimits(ctx context.Context, playerID string) ([]*model.GamblingLimit, error)
+	SaveGamblingLimit(ctx context.Context, limit *model.GamblingLimit) error
+}
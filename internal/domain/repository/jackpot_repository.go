@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+)
+
+var (
+	ErrJackpotPoolNotFound  = errors.New("jackpot pool not found")
+	ErrMachineNotLinked     = errors.New("machine is not linked to this jackpot pool")
+	ErrMachineAlreadyLinked = errors.New("machine is already linked to a jackpot pool")
+)
+
+type JackpotRepository interface {
+	// CreatePool grava o pool e retira o valor inicial da tesouraria na
+	// mesma operação.
+	CreatePool(ctx context.Context, pool *model.JackpotPool, seed *model.TreasuryMovement) error
+	GetPool(ctx context.Context, id string) (*model.JackpotPool, error)
+	ListPools(ctx context.Context) ([]*model.JackpotPool, error)
+	// GetMachinePool retorna o pool ligado à máquina ou
+	// ErrJackpotPoolNotFound se ela não participa de nenhum.
+	GetMachinePool(ctx context.Context, machineID string) (*model.JackpotPool, error)
+	LinkMachine(ctx context.Context, poolID, machineID string) error
+	UnlinkMachine(ctx context.Context, poolID, machineID string) error
+	// Contribute soma amount ao pool de forma atômica. Se win não for nil, o
+	// pool é pago na mesma operação: win.Amount recebe o valor acumulado e
+	// o pool volta ao valor inicial, retirado da tesouraria até o saldo
+	// disponível. Retorna o pool atualizado.
+	Contribute(ctx context.Context, poolID string, amount int, win *model.JackpotWin) (*model.JackpotPool, error)
+	// ListJackpotWins retorna os jackpots pagos mais recentes primeiro.
+	ListJackpotWins(ctx context.Context, limit int) ([]*model.JackpotWin, error)
+}
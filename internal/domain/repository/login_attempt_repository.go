@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+var (
+	ErrLoginAttemptNotFound = errors.New("login attempt not found")
+)
+
+type LoginAttemptRepository interface {
+	GetLoginAttempt(ctx context.Context, key string) (*model.LoginAttempt, error)
+	// RecordLoginFailure soma uma falha à chave em uma única operação
+	// atômica, recomeçando a contagem quando a falha anterior é mais antiga
+	// que window, e devolve a tentativa já atualizada.
+	RecordLoginFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*model.LoginAttempt, error)
+	// BlockLoginAttempt estende os bloqueios da chave sem nunca encurtá-los.
+	// Quando lockedUntil passa do travamento atual, a contagem de falhas
+	// recomeça.
+	BlockLoginAttempt(ctx context.Context, key string, blockedUntil, lockedUntil time.Time) error
+	DeleteLoginAttempt(ctx context.Context, key string) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+type OutboxRepository interface {
+	// AddEvent grava o evento; deve ser chamado com o contexto da transação
+	// que altera o estado.
+	AddEvent(ctx context.Context, event *model.DomainEvent) error
+	// ListPendingEvents retorna os eventos ainda não publicados nem mortos,
+	// do mais antigo ao mais recente.
+	ListPendingEvents(ctx context.Context, limit int) ([]*model.DomainEvent, error)
+	// MarkEventDelivered registra que o publicador aceitou o evento.
+	MarkEventDelivered(ctx context.Context, id, publisher string) error
+	MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error
+	// MarkEventFailed incrementa as tentativas e guarda o último erro. Com
+	// deadAt, o evento esgotou as tentativas e sai da fila.
+	MarkEventFailed(ctx context.Context, id string, lastError string, deadAt *time.Time) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+)
+
+var (
+	ErrPlaySessionNotFound = errors.New("play session not found")
+)
+
+type PlaySessionRepository interface {
+	GetLatestPlaySession(ctx context.Context, playerID string) (*model.PlaySession, error)
+	SavePlaySession(ctx context.Context, session *model.PlaySession) error
+}
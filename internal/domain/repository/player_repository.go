@@ -6,8 +6,26 @@ import (
 	"slot-machine/internal/domain/model"
 )
 
+type PlayerSortField string
+
+const (
+	PlayerSortCreatedAt PlayerSortField = "created_at"
+	PlayerSortBalance   PlayerSortField = "balance"
+)
+
+// PlayerFilter pagina e ordena a busca de jogadores. Email filtra por
+// trecho do email, sem diferenciar maiúsculas.
+type PlayerFilter struct {
+	Email      string
+	SortBy     PlayerSortField
+	Descending bool
+	Limit      int
+	Offset     int
+}
+
 var (
-	ErrPlayerNotFound = errors.New("player not found")
+	ErrPlayerNotFound  = errors.New("player not found")
+	ErrNegativeBalance = errors.New("balance would become negative")
 )
 
 type PlayerRepository interface {
@@ -15,5 +33,29 @@ type PlayerRepository interface {
 	GetPlayer(ctx context.Context, id string) (*model.Player, error)
 	GetPlayerByEmail(ctx context.Context, email string) (*model.Player, error)
 	UpdatePlayer(ctx context.Context, player *model.Player) error
+	// AdjustBalance soma delta ao saldo em uma única operação atômica e
+	// devolve o saldo resultante. Retorna ErrNegativeBalance, sem alterar
+	// nada, quando o saldo ficaria negativo.
+	AdjustBalance(ctx context.Context, id string, delta int) (int, error)
+	// LockPlayer bloqueia o jogador até o fim da transação em andamento, para
+	// que verificações de limite concorrentes do mesmo jogador sejam feitas
+	// uma de cada vez.
+	LockPlayer(ctx context.Context, id string) error
+	// ConsumeTOTPCounter grava o passo de tempo do último código TOTP aceito
+	// somente se ele for maior que o já gravado, e informa se gravou. Um
+	// código repetido, mesmo em requisições simultâneas, não é aceito duas
+	// vezes.
+	ConsumeTOTPCounter(ctx context.Context, id string, counter int64) (bool, error)
+	// SetTOTPSecret troca o segredo TOTP, desativando o 2FA e zerando o
+	// contador. Um segredo vazio remove o 2FA da conta.
+	SetTOTPSecret(ctx context.Context, id, secret string) error
+	EnableTOTP(ctx context.Context, id string) error
+	UpdatePassword(ctx context.Context, id, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, id string) error
+	SetRealityCheckMinutes(ctx context.Context, id string, minutes int) error
+	SetBlocked(ctx context.Context, id string, blocked bool, reason string) error
 	ListPlayers(ctx context.Context) ([]*model.Player, error)
+	// SearchPlayers retorna a página pedida e o total de jogadores que
+	// atendem ao filtro.
+	SearchPlayers(ctx context.Context, filter PlayerFilter) ([]*model.Player, int, error)
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+)
+
+type ReconciliationRepository interface {
+	// LoadLedgerSnapshot lê saldos e movimentos como estavam em um mesmo
+	// instante, para que algo gravado durante a leitura não apareça em uma
+	// consulta e falte em outra.
+	LoadLedgerSnapshot(ctx context.Context) (*model.LedgerSnapshot, error)
+}
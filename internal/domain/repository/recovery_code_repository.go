@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+type RecoveryCodeRepository interface {
+	// ReplaceRecoveryCodes remove os códigos existentes do jogador e grava os novos hashes.
+	ReplaceRecoveryCodes(ctx context.Context, playerID string, codeHashes []string) error
+	// ConsumeRecoveryCode marca o código como usado e informa se ele era válido.
+	ConsumeRecoveryCode(ctx context.Context, playerID, codeHash string) (bool, error)
+	DeleteRecoveryCodes(ctx context.Context, playerID string) error
+}
@@ -6,4 +6,5 @@ type RefreshTokenRepository interface {
     StoreRefreshToken(ctx context.Context, userID, token string) error
     ValidateRefreshToken(ctx context.Context, userID, token string) (bool, error)
     DeleteRefreshToken(ctx context.Context, userID, token string) error
+    RevokeAllRefreshTokens(ctx context.Context, userID string) error
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+)
+
+var (
+	ErrSelfExclusionNotFound = errors.New("self exclusion not found")
+)
+
+type SelfExclusionRepository interface {
+	GetSelfExclusion(ctx context.Context, playerID string) (*model.SelfExclusion, error)
+	SaveSelfExclusion(ctx context.Context, exclusion *model.SelfExclusion) error
+}
@@ -14,5 +14,10 @@ var (
 type SlotMachineRepository interface {
 	GetSlotMachine(ctx context.Context, id string) (*model.SlotMachine, error)
 	UpdateSlotMachine(ctx context.Context, machine *model.SlotMachine) error
+	// AdjustSlotMachineBalance soma delta ao saldo da máquina em uma única
+	// operação atômica e devolve o saldo resultante, sem sobrescrever
+	// recargas ou retiradas feitas ao mesmo tempo.
+	AdjustSlotMachineBalance(ctx context.Context, id string, delta int) (int, error)
 	CreateSlotMachine(ctx context.Context, machine *model.SlotMachine) error
+	ListSlotMachines(ctx context.Context) ([]*model.SlotMachine, error)
 }
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+type SpinRepository interface {
+	// RecordSpin grava a jogada e atualiza o acumulado diário do jogador
+	// na mesma operação.
+	RecordSpin(ctx context.Context, spin *model.Spin) error
+	// AggregateMachineSpins soma as jogadas da máquina em [from, to), no
+	// total e por intervalo da granularidade. Intervalos sem jogadas não são
+	// retornados.
+	AggregateMachineSpins(ctx context.Context, machineID string, from, to time.Time, granularity model.StatsGranularity) (model.SpinAggregate, []model.SpinAggregate, error)
+	// ListPlayerSpinStats retorna os acumulados diários do jogador a partir
+	// do dia de from (UTC), do mais antigo para o mais recente. from zero
+	// retorna todo o histórico.
+	ListPlayerSpinStats(ctx context.Context, playerID string, from time.Time) ([]model.PlayerSpinStats, error)
+	// SumJackpotContributions soma, por jackpot e máquina, o que as jogadas
+	// destinaram aos pools.
+	SumJackpotContributions(ctx context.Context) ([]model.JackpotContributionTotal, error)
+}
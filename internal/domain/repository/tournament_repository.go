@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+var (
+	ErrTournamentNotFound      = errors.New("tournament not found")
+	ErrTournamentEntryNotFound = errors.New("tournament entry not found")
+	ErrTournamentEntryExists   = errors.New("player already joined the tournament")
+	ErrTournamentSettled       = errors.New("tournament already settled")
+)
+
+type TournamentRepository interface {
+	CreateTournament(ctx context.Context, tournament *model.Tournament) error
+	GetTournament(ctx context.Context, id string) (*model.Tournament, error)
+	// ListTournaments retorna os torneios pelo início, dos mais recentes para
+	// os mais antigos.
+	ListTournaments(ctx context.Context, status model.TournamentStatus) ([]*model.Tournament, error)
+	// ListRunningTournaments retorna os torneios abertos em now que incluem a
+	// máquina.
+	ListRunningTournaments(ctx context.Context, machineID string, now time.Time) ([]*model.Tournament, error)
+	// ListDueTournaments retorna os torneios abertos que terminaram até now.
+	ListDueTournaments(ctx context.Context, now time.Time) ([]*model.Tournament, error)
+	// AddEntry inscreve o jogador e incrementa Entrants do torneio.
+	AddEntry(ctx context.Context, entry *model.TournamentEntry) error
+	GetEntry(ctx context.Context, tournamentID, playerID string) (*model.TournamentEntry, error)
+	// ListEntries retorna as inscrições sem ordem definida; a classificação é
+	// feita por model.Tournament.Rank.
+	ListEntries(ctx context.Context, tournamentID string) ([]*model.TournamentEntry, error)
+	// RecordScore soma uma jogada à inscrição do jogador: points é somado à
+	// pontuação ou, no maior multiplicador, a substitui quando for maior.
+	// Retorna ErrTournamentEntryNotFound se o jogador não estiver inscrito.
+	RecordScore(ctx context.Context, tournamentID, playerID string, scoring model.TournamentScoring, points int, at time.Time) error
+	// SettleTournament marca o torneio como encerrado. Retorna
+	// ErrTournamentSettled se outro encerramento chegou antes.
+	SettleTournament(ctx context.Context, id string, settledAt time.Time) error
+}
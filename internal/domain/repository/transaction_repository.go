@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+type TransactionRepository interface {
+	RecordTransaction(ctx context.Context, transaction *model.Transaction) error
+	// SumTransactions soma os movimentos do tipo informado a partir de since.
+	SumTransactions(ctx context.Context, playerID string, txType model.TransactionType, since time.Time) (int, error)
+	// ListTransactions retorna os movimentos mais recentes do jogador primeiro.
+	ListTransactions(ctx context.Context, playerID string, limit int) ([]*model.Transaction, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+)
+
+var (
+	ErrInsufficientTreasuryBalance = errors.New("insufficient treasury balance")
+	ErrInsufficientMachineBalance  = errors.New("insufficient machine balance")
+)
+
+type TreasuryRepository interface {
+	GetTreasury(ctx context.Context) (*model.Treasury, error)
+	// ApplyMovement altera os saldos da tesouraria e, em recargas e
+	// recolhimentos, da máquina na mesma transação, e grava o movimento com
+	// os saldos resultantes. minMachineBalance é o saldo mínimo que a máquina
+	// deve manter após um recolhimento.
+	ApplyMovement(ctx context.Context, movement *model.TreasuryMovement, minMachineBalance int) error
+	// ListTreasuryMovements retorna os movimentos mais recentes primeiro.
+	ListTreasuryMovements(ctx context.Context, limit int) ([]*model.TreasuryMovement, error)
+}
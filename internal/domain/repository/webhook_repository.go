@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slot-machine/internal/domain/model"
+	"time"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+)
+
+// WebhookDeliveryFilter filtra o log de entregas. Campos vazios não filtram.
+type WebhookDeliveryFilter struct {
+	SubscriptionID string
+	Status         model.WebhookDeliveryStatus
+	Limit          int
+}
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	// ListSubscriptions retorna as assinaturas da mais antiga para a mais
+	// recente.
+	ListSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	// AddDelivery ignora uma segunda entrega do mesmo evento para a mesma
+	// assinatura, já que o relay do outbox pode repetir eventos.
+	AddDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error)
+	// ListDueDeliveries retorna as entregas pendentes com a próxima tentativa
+	// até now, das mais antigas para as mais recentes.
+	ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error)
+	// ListDeliveries retorna as entregas mais recentes primeiro.
+	ListDeliveries(ctx context.Context, filter WebhookDeliveryFilter) ([]*model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+}
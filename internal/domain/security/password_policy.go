@@ -0,0 +1,13 @@
+package security
+
+import "errors"
+
+var (
+	ErrPasswordTooShort = errors.New("password is too short")
+	ErrPasswordTooLong  = errors.New("password is too long")
+	ErrPasswordBreached = errors.New("password appears in a list of breached passwords")
+)
+
+type PasswordPolicy interface {
+	Validate(password string) error
+}
@@ -0,0 +1,11 @@
+package security
+
+import "time"
+
+type TOTPProvider interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(secret, accountName string) string
+	// Verify valida o código e devolve o contador (passo de tempo) aceito, usado
+	// para impedir a reutilização do mesmo código.
+	Verify(secret, code string, at time.Time) (int64, bool)
+}
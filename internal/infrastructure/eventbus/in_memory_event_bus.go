@@ -0,0 +1,66 @@
+package eventbus
+
+import (
+	"slot-machine/internal/domain/model"
+	"sync"
+)
+
+// subscriberBuffer é quantos eventos um assinante pode acumular antes de
+// começar a perder os novos.
+const subscriberBuffer = 32
+
+// InMemoryEventBus distribui eventos entre os assinantes deste processo.
+// Com várias instâncias do servidor, cada cliente só recebe os eventos
+// publicados na instância em que está conectado.
+type InMemoryEventBus struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	playerID string
+	events   chan model.Event
+}
+
+func NewInMemoryEventBus() *InMemoryEventBus {
+	return &InMemoryEventBus{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (b *InMemoryEventBus) Publish(event model.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subscribers {
+		if event.PlayerID != "" && event.PlayerID != s.playerID {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+		}
+	}
+}
+
+func (b *InMemoryEventBus) Subscribe(playerID string) (<-chan model.Event, func()) {
+	s := &subscriber{
+		playerID: playerID,
+		events:   make(chan model.Event, subscriberBuffer),
+	}
+
+	b.mu.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, s)
+			close(s.events)
+			b.mu.Unlock()
+		})
+	}
+	return s.events, cancel
+}
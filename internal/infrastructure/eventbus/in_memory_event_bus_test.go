@@ -0,0 +1,46 @@
+package eventbus
+
+import (
+	"slot-machine/internal/domain/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInMemoryEventBus(t *testing.T) {
+	bus := NewInMemoryEventBus()
+
+	player1, cancel1 := bus.Subscribe("player1")
+	defer cancel1()
+	player2, cancel2 := bus.Subscribe("player2")
+
+	t.Run("Publish_PlayerEvent", func(t *testing.T) {
+		bus.Publish(model.Event{Type: model.EventBalanceUpdated, PlayerID: "player1"})
+
+		assert.Equal(t, model.EventBalanceUpdated, (<-player1).Type)
+		assert.Empty(t, player2, "Eventos do jogador não devem chegar aos demais")
+	})
+
+	t.Run("Publish_Broadcast", func(t *testing.T) {
+		bus.Publish(model.Event{Type: model.EventBigWin})
+
+		assert.Equal(t, model.EventBigWin, (<-player1).Type)
+		assert.Equal(t, model.EventBigWin, (<-player2).Type)
+	})
+
+	t.Run("Publish_SlowSubscriberDropsEvents", func(t *testing.T) {
+		for i := 0; i < subscriberBuffer+10; i++ {
+			bus.Publish(model.Event{Type: model.EventJackpotUpdated, PlayerID: "player1"})
+		}
+		assert.Len(t, player1, subscriberBuffer, "Publish não deve bloquear com o buffer cheio")
+	})
+
+	t.Run("Cancel_ClosesChannel", func(t *testing.T) {
+		cancel2()
+		cancel2()
+
+		_, open := <-player2
+		assert.False(t, open)
+		bus.Publish(model.Event{Type: model.EventBigWin})
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/dgrijalva/jwt-go"
 )
 
+// mfaTokenDuration limita o tempo entre a senha correta e o segundo fator.
+const mfaTokenDuration = 5 * time.Minute
 
 type JWTManager struct {
 	secretKey     string
@@ -73,6 +75,31 @@ func (m *JWTManager) VerifyRefreshToken(tokenString string) (*ports.JWTClaims, e
     }
     return claims, nil
 }
+func (m *JWTManager) GenerateMFAToken(userID string) (string, error) {
+	claims := &ports.JWTClaims{
+		UserID:    userID,
+		TokenType: ports.TokenTypeMFA,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(mfaTokenDuration).Unix(),
+			IssuedAt:  time.Now().Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(m.secretKey))
+}
+
+func (m *JWTManager) VerifyMFAToken(tokenString string) (*ports.JWTClaims, error) {
+	claims, err := m.verifyToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != ports.TokenTypeMFA {
+		return nil, errors.New("invalid token type")
+	}
+	return claims, nil
+}
+
 func (m *JWTManager) verifyToken(tokenString string) (*ports.JWTClaims, error) {
     claims := &ports.JWTClaims{}
 
@@ -0,0 +1,42 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"slot-machine/internal/domain/ports"
+	"sync"
+	"time"
+)
+
+// FileNotifier grava cada notificação como uma linha JSON em um arquivo local,
+// útil para desenvolvimento enquanto não há um provedor de email.
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+type fileNotification struct {
+	ports.Notification
+	SentAt time.Time `json:"sent_at"`
+}
+
+func NewFileNotifier(path string) ports.Notifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Notify(ctx context.Context, notification ports.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	file, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(fileNotification{
+		Notification: notification,
+		SentAt:       time.Now().UTC(),
+	})
+}
@@ -0,0 +1,24 @@
+package notifier
+
+import (
+	"context"
+	"slot-machine/internal/domain/ports"
+
+	"github.com/sirupsen/logrus"
+)
+
+type LogNotifier struct {
+	logger *logrus.Logger
+}
+
+func NewLogNotifier(logger *logrus.Logger) ports.Notifier {
+	return &LogNotifier{logger: logger}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification ports.Notification) error {
+	n.logger.WithFields(logrus.Fields{
+		"to":      notification.To,
+		"subject": notification.Subject,
+	}).Info(notification.Body)
+	return nil
+}
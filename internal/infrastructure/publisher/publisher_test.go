@@ -0,0 +1,78 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slot-machine/internal/domain/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testEvent() *model.DomainEvent {
+	return &model.DomainEvent{
+		ID:            "event1",
+		Type:          model.DomainEventDepositCompleted,
+		AggregateType: "player",
+		AggregateID:   "player1",
+		Payload:       json.RawMessage(`{"player_id":"player1","amount":100,"balance":100}`),
+		OccurredAt:    time.Date(2025, 3, 6, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestStreamPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := NewStreamPublisher(&buf)
+
+	err := publisher.Publish(context.Background(), testEvent())
+	assert.NoError(t, err)
+
+	var published model.DomainEvent
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &published), "Cada evento deve ser uma linha JSON")
+	assert.Equal(t, "event1", published.ID)
+	assert.JSONEq(t, `{"player_id":"player1","amount":100,"balance":100}`, string(published.Payload))
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	t.Run("Publish_Success", func(t *testing.T) {
+		var received model.DomainEvent
+		var eventType string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			eventType = r.Header.Get("X-Event-Type")
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		err := NewWebhookPublisher(server.URL, server.Client()).Publish(context.Background(), testEvent())
+		assert.NoError(t, err, "Esperava-se nenhum erro com resposta 2xx")
+		assert.Equal(t, "event1", received.ID)
+		assert.Equal(t, "deposit.completed", eventType)
+	})
+
+	t.Run("Publish_ErrorStatus", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := NewWebhookPublisher(server.URL, server.Client()).Publish(context.Background(), testEvent())
+		assert.Error(t, err, "Uma resposta fora de 2xx deve ser tratada como falha")
+	})
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"id":"event1"}`)
+	sentAt := time.Date(2025, 3, 7, 9, 0, 0, 0, time.UTC)
+	signature := SignWebhook("secret", sentAt, body)
+	timestamp := "1741338000"
+
+	assert.True(t, VerifyWebhookSignature("secret", timestamp, signature, body, sentAt.Add(time.Minute), 5*time.Minute))
+	assert.False(t, VerifyWebhookSignature("other", timestamp, signature, body, sentAt, 5*time.Minute), "Segredo diferente")
+	assert.False(t, VerifyWebhookSignature("secret", timestamp, signature, []byte(`{"id":"event2"}`), sentAt, 5*time.Minute), "Corpo alterado")
+	assert.False(t, VerifyWebhookSignature("secret", timestamp, signature, body, sentAt.Add(10*time.Minute), 5*time.Minute), "Timestamp antigo deve ser recusado")
+}
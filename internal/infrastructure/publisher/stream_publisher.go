@@ -0,0 +1,52 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"sync"
+)
+
+// StreamPublisher escreve cada evento como uma linha JSON, por exemplo na
+// saída padrão para um coletor de logs.
+type StreamPublisher struct {
+	w  io.Writer
+	mu sync.Mutex
+}
+
+func NewStreamPublisher(w io.Writer) ports.DomainEventPublisher {
+	return &StreamPublisher{w: w}
+}
+
+func (p *StreamPublisher) Publish(ctx context.Context, event *model.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return json.NewEncoder(p.w).Encode(event)
+}
+
+// FilePublisher acrescenta cada evento como uma linha JSON a um arquivo
+// local.
+type FilePublisher struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFilePublisher(path string) ports.DomainEventPublisher {
+	return &FilePublisher{path: path}
+}
+
+func (p *FilePublisher) Publish(ctx context.Context, event *model.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	file, err := os.OpenFile(p.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(event)
+}
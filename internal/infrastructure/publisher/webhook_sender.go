@@ -0,0 +1,76 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"slot-machine/internal/domain/ports"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderWebhookID        = "X-Webhook-ID"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+// HTTPWebhookSender entrega as tentativas das assinaturas de webhook. Cada
+// requisição leva o timestamp em segundos e a assinatura
+// v1=HMAC-SHA256(segredo, "<timestamp>.<corpo>") em hexadecimal.
+type HTTPWebhookSender struct {
+	client *http.Client
+}
+
+func NewHTTPWebhookSender(client *http.Client) ports.WebhookSender {
+	return &HTTPWebhookSender{client: client}
+}
+
+func (s *HTTPWebhookSender) Send(ctx context.Context, req ports.WebhookRequest) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(HeaderWebhookID, req.DeliveryID)
+	httpReq.Header.Set("X-Event-ID", req.EventID)
+	httpReq.Header.Set("X-Event-Type", req.EventType)
+	httpReq.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(req.Timestamp.Unix(), 10))
+	httpReq.Header.Set(HeaderWebhookSignature, SignWebhook(req.Secret, req.Timestamp, req.Body))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Lê um trecho da resposta para permitir o reuso da conexão.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	return resp.StatusCode, nil
+}
+
+func SignWebhook(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature confere a assinatura como um destino deve fazer,
+// recusando timestamps mais distantes de now que tolerance.
+func VerifyWebhookSignature(secret, timestamp, signature string, body []byte, now time.Time, tolerance time.Duration) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	sentAt := time.Unix(seconds, 0)
+	if sentAt.Before(now.Add(-tolerance)) || sentAt.After(now.Add(tolerance)) {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(SignWebhook(secret, sentAt, body)))
+}
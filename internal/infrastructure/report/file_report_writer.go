@@ -0,0 +1,145 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/ports"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidSignature = errors.New("invalid report signature")
+
+// SignatureSuffix é a extensão do arquivo com a assinatura de cada relatório.
+const SignatureSuffix = ".sig"
+
+// FileReportWriter grava o relatório de conciliação em JSON e CSV no
+// diretório configurado. Cada arquivo ganha ao lado um .sig com o
+// HMAC-SHA256 do conteúdo, que pode ser conferido com VerifyFile.
+type FileReportWriter struct {
+	dir        string
+	signingKey []byte
+}
+
+func NewFileReportWriter(dir string, signingKey []byte) ports.ReconciliationReportWriter {
+	return &FileReportWriter{dir: dir, signingKey: signingKey}
+}
+
+func (w *FileReportWriter) WriteReconciliationReport(ctx context.Context, report *model.ReconciliationReport) ([]string, error) {
+	if err := os.MkdirAll(w.dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	jsonData, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	csvData, err := reconciliationCSV(report)
+	if err != nil {
+		return nil, err
+	}
+
+	base := filepath.Join(w.dir, "reconciliation-"+report.GeneratedAt.UTC().Format("20060102T150405Z"))
+	files := []struct {
+		path string
+		data []byte
+	}{
+		{base + ".json", append(jsonData, '\n')},
+		{base + ".csv", csvData},
+	}
+
+	var paths []string
+	for _, file := range files {
+		if err := writeFileAtomic(file.path, file.data); err != nil {
+			return nil, err
+		}
+		signature := hex.EncodeToString(sign(w.signingKey, file.data)) + "\n"
+		if err := writeFileAtomic(file.path+SignatureSuffix, []byte(signature)); err != nil {
+			return nil, err
+		}
+		paths = append(paths, file.path)
+	}
+	return paths, nil
+}
+
+// VerifyFile confere o arquivo com a assinatura gravada ao lado dele.
+func VerifyFile(path string, signingKey []byte) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	encoded, err := os.ReadFile(path + SignatureSuffix)
+	if err != nil {
+		return err
+	}
+	signature, err := hex.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal(signature, sign(signingKey, data)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func sign(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// reconciliationCSV traz uma linha por conta; os totais do sistema ficam
+// apenas no JSON.
+func reconciliationCSV(report *model.ReconciliationReport) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write([]string{"account_type", "account_id", "expected", "actual", "difference", "balanced"}); err != nil {
+		return nil, err
+	}
+	for _, entry := range report.Entries {
+		err := writer.Write([]string{
+			string(entry.AccountType),
+			entry.AccountID,
+			strconv.Itoa(entry.Expected),
+			strconv.Itoa(entry.Actual),
+			strconv.Itoa(entry.Difference),
+			strconv.FormatBool(entry.Balanced()),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// writeFileAtomic grava em um arquivo temporário e renomeia, para que quem
+// observa o diretório nunca leia um relatório pela metade.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-"+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,59 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+type InMemoryActionTokenRepository struct {
+	tokens map[string]model.ActionToken
+	mu     sync.RWMutex
+}
+
+func NewInMemoryActionTokenRepository() repository.ActionTokenRepository {
+	return &InMemoryActionTokenRepository{
+		tokens: make(map[string]model.ActionToken),
+	}
+}
+
+func (r *InMemoryActionTokenRepository) StoreActionToken(ctx context.Context, token *model.ActionToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[token.TokenHash] = *token
+	return nil
+}
+
+func (r *InMemoryActionTokenRepository) GetActionToken(ctx context.Context, tokenHash string) (*model.ActionToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	token, exists := r.tokens[tokenHash]
+	if !exists {
+		return nil, repository.ErrActionTokenNotFound
+	}
+	return &token, nil
+}
+
+func (r *InMemoryActionTokenRepository) ConsumeActionToken(ctx context.Context, tokenHash string, purpose model.TokenPurpose, now time.Time) (*model.ActionToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token, exists := r.tokens[tokenHash]
+	if !exists || token.Purpose != purpose || !now.Before(token.ExpiresAt) {
+		return nil, repository.ErrActionTokenNotFound
+	}
+	delete(r.tokens, tokenHash)
+	return &token, nil
+}
+
+func (r *InMemoryActionTokenRepository) DeletePlayerActionTokens(ctx context.Context, playerID string, purpose model.TokenPurpose) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for hash, token := range r.tokens {
+		if token.PlayerID == playerID && token.Purpose == purpose {
+			delete(r.tokens, hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// InMemoryAdjustmentRepository aplica os ajustes diretamente nos
+// repositórios de jogadores e máquinas, serializando as aprovações.
+type InMemoryAdjustmentRepository struct {
+	adjustments     map[string]model.BalanceAdjustment
+	playerRepo      repository.PlayerRepository
+	slotMachineRepo repository.SlotMachineRepository
+	transactionRepo repository.TransactionRepository
+	treasuryRepo    repository.TreasuryRepository
+	mu              sync.RWMutex
+}
+
+func NewInMemoryAdjustmentRepository(playerRepo repository.PlayerRepository, slotRepo repository.SlotMachineRepository, txRepo repository.TransactionRepository, treasuryRepo repository.TreasuryRepository) repository.AdjustmentRepository {
+	return &InMemoryAdjustmentRepository{
+		adjustments:     make(map[string]model.BalanceAdjustment),
+		playerRepo:      playerRepo,
+		slotMachineRepo: slotRepo,
+		transactionRepo: txRepo,
+		treasuryRepo:    treasuryRepo,
+	}
+}
+
+func (r *InMemoryAdjustmentRepository) CreateAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.adjustments[adjustment.ID] = *adjustment
+	return nil
+}
+
+func (r *InMemoryAdjustmentRepository) GetAdjustment(ctx context.Context, id string) (*model.BalanceAdjustment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	adjustment, exists := r.adjustments[id]
+	if !exists {
+		return nil, repository.ErrAdjustmentNotFound
+	}
+	return &adjustment, nil
+}
+
+func (r *InMemoryAdjustmentRepository) ListAdjustments(ctx context.Context, status model.AdjustmentStatus) ([]*model.BalanceAdjustment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var adjustments []*model.BalanceAdjustment
+	for _, adjustment := range r.adjustments {
+		if status != "" && adjustment.Status != status {
+			continue
+		}
+		a := adjustment
+		adjustments = append(adjustments, &a)
+	}
+	sort.Slice(adjustments, func(i, j int) bool {
+		return adjustments[i].ProposedAt.After(adjustments[j].ProposedAt)
+	})
+	return adjustments, nil
+}
+
+func (r *InMemoryAdjustmentRepository) ApplyAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkPending(adjustment.ID); err != nil {
+		return err
+	}
+
+	switch adjustment.TargetType {
+	case model.AdjustmentTargetPlayer:
+		if _, err := r.playerRepo.AdjustBalance(ctx, adjustment.TargetID, adjustment.Amount); err != nil {
+			return err
+		}
+
+		txType, amount := model.TransactionAdjustmentCredit, adjustment.Amount
+		if amount < 0 {
+			txType, amount = model.TransactionAdjustmentDebit, -amount
+		}
+		err := r.transactionRepo.RecordTransaction(ctx, &model.Transaction{
+			ID:        uuid.New().String(),
+			PlayerID:  adjustment.TargetID,
+			Type:      txType,
+			Amount:    amount,
+			CreatedAt: *adjustment.ReviewedAt,
+		})
+		if err != nil {
+			return err
+		}
+	case model.AdjustmentTargetMachine:
+		machine, err := r.slotMachineRepo.GetSlotMachine(ctx, adjustment.TargetID)
+		if err != nil {
+			return err
+		}
+		if machine.Balance+adjustment.Amount < 0 {
+			return repository.ErrNegativeBalance
+		}
+		if _, err := r.slotMachineRepo.AdjustSlotMachineBalance(ctx, machine.ID, adjustment.Amount); err != nil {
+			return err
+		}
+	case model.AdjustmentTargetTreasury:
+		if err := r.treasuryRepo.ApplyMovement(ctx, treasuryAdjustment(adjustment), 0); err != nil {
+			return err
+		}
+	}
+
+	adjustment.Status = model.AdjustmentApproved
+	r.adjustments[adjustment.ID] = *adjustment
+	return nil
+}
+
+func (r *InMemoryAdjustmentRepository) RejectAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkPending(adjustment.ID); err != nil {
+		return err
+	}
+	adjustment.Status = model.AdjustmentRejected
+	r.adjustments[adjustment.ID] = *adjustment
+	return nil
+}
+
+func (r *InMemoryAdjustmentRepository) checkPending(id string) error {
+	stored, exists := r.adjustments[id]
+	if !exists {
+		return repository.ErrAdjustmentNotFound
+	}
+	if stored.Status != model.AdjustmentPending {
+		return repository.ErrAdjustmentNotPending
+	}
+	return nil
+}
+
+func treasuryAdjustment(adjustment *model.BalanceAdjustment) *model.TreasuryMovement {
+	return &model.TreasuryMovement{
+		ID:        uuid.New().String(),
+		Type:      model.MovementAdjustment,
+		Amount:    adjustment.Amount,
+		Reason:    string(adjustment.ReasonCode),
+		Actor:     adjustment.ReviewedBy,
+		CreatedAt: *adjustment.ReviewedAt,
+	}
+}
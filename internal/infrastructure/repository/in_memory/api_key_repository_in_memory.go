@@ -0,0 +1,67 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sort"
+	"sync"
+	"time"
+)
+
+type InMemoryAPIKeyRepository struct {
+	keys map[string]*model.APIKey
+	mu   sync.RWMutex
+}
+
+func NewInMemoryAPIKeyRepository() repository.APIKeyRepository {
+	return &InMemoryAPIKeyRepository{
+		keys: make(map[string]*model.APIKey),
+	}
+}
+
+func (r *InMemoryAPIKeyRepository) CreateAPIKey(ctx context.Context, key *model.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *key
+	r.keys[key.ID] = &stored
+	return nil
+}
+
+func (r *InMemoryAPIKeyRepository) GetAPIKey(ctx context.Context, id string) (*model.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key, exists := r.keys[id]
+	if !exists {
+		return nil, repository.ErrAPIKeyNotFound
+	}
+	found := *key
+	return &found, nil
+}
+
+func (r *InMemoryAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	keys := make([]*model.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		found := *key
+		keys = append(keys, &found)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys, nil
+}
+
+func (r *InMemoryAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, exists := r.keys[id]
+	if !exists {
+		return repository.ErrAPIKeyNotFound
+	}
+	if key.RevokedAt == nil {
+		key.RevokedAt = &revokedAt
+	}
+	return nil
+}
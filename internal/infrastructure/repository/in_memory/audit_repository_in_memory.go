@@ -0,0 +1,70 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+)
+
+type InMemoryAuditRepository struct {
+	entries []model.AuditEntry
+	mu      sync.RWMutex
+}
+
+func NewInMemoryAuditRepository() repository.AuditRepository {
+	return &InMemoryAuditRepository{}
+}
+
+func (r *InMemoryAuditRepository) AppendAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, *entry)
+	return nil
+}
+
+// ListAuditEntries devolve as entradas mais recentes primeiro.
+func (r *InMemoryAuditRepository) ListAuditEntries(ctx context.Context, filter repository.AuditFilter) ([]*model.AuditEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []*model.AuditEntry
+	skipped := 0
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		entry := r.entries[i]
+		if !matchesAuditFilter(&entry, filter) {
+			continue
+		}
+		if skipped < filter.Offset {
+			skipped++
+			continue
+		}
+		if filter.Limit > 0 && len(entries) >= filter.Limit {
+			break
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
+
+func matchesAuditFilter(entry *model.AuditEntry, filter repository.AuditFilter) bool {
+	if filter.Actor != "" && entry.Actor != filter.Actor {
+		return false
+	}
+	if filter.Action != "" && entry.Action != filter.Action {
+		return false
+	}
+	if filter.TargetType != "" && entry.TargetType != filter.TargetType {
+		return false
+	}
+	if filter.TargetID != "" && entry.TargetID != filter.TargetID {
+		return false
+	}
+	if !filter.From.IsZero() && entry.CreatedAt.Before(filter.From) {
+		return false
+	}
+	if !filter.To.IsZero() && !entry.CreatedAt.Before(filter.To) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+)
+
+type freeSpinKey struct {
+	playerID  string
+	machineID string
+}
+
+type InMemoryFreeSpinRepository struct {
+	freeSpins map[freeSpinKey]model.FreeSpins
+	mu        sync.RWMutex
+}
+
+func NewInMemoryFreeSpinRepository() repository.FreeSpinRepository {
+	return &InMemoryFreeSpinRepository{
+		freeSpins: make(map[freeSpinKey]model.FreeSpins),
+	}
+}
+
+func (r *InMemoryFreeSpinRepository) GetFreeSpins(ctx context.Context, playerID, machineID string) (*model.FreeSpins, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	freeSpins, exists := r.freeSpins[freeSpinKey{playerID: playerID, machineID: machineID}]
+	if !exists {
+		return nil, repository.ErrFreeSpinsNotFound
+	}
+	return &freeSpins, nil
+}
+
+func (r *InMemoryFreeSpinRepository) SaveFreeSpins(ctx context.Context, freeSpins *model.FreeSpins) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.freeSpins[freeSpinKey{playerID: freeSpins.PlayerID, machineID: freeSpins.MachineID}] = *freeSpins
+	return nil
+}
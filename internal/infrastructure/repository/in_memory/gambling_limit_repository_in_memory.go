@@ -0,0 +1,60 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sort"
+	"sync"
+)
+
+type InMemoryGamblingLimitRepository struct {
+	limits map[string]model.GamblingLimit
+	mu     sync.RWMutex
+}
+
+func NewInMemoryGamblingLimitRepository() repository.GamblingLimitRepository {
+	return &InMemoryGamblingLimitRepository{
+		limits: make(map[string]model.GamblingLimit),
+	}
+}
+
+func gamblingLimitKey(playerID string, limitType model.LimitType, period model.LimitPeriod) string {
+	return playerID + ":" + string(limitType) + ":" + string(period)
+}
+
+func (r *InMemoryGamblingLimitRepository) GetGamblingLimit(ctx context.Context, playerID string, limitType model.LimitType, period model.LimitPeriod) (*model.GamblingLimit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	limit, exists := r.limits[gamblingLimitKey(playerID, limitType, period)]
+	if !exists {
+		return nil, repository.ErrGamblingLimitNotFound
+	}
+	return &limit, nil
+}
+
+func (r *InMemoryGamblingLimitRepository) ListGamblingLimits(ctx context.Context, playerID string) ([]*model.GamblingLimit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var limits []*model.GamblingLimit
+	for _, limit := range r.limits {
+		if limit.PlayerID == playerID {
+			found := limit
+			limits = append(limits, &found)
+		}
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		if limits[i].Type != limits[j].Type {
+			return limits[i].Type < limits[j].Type
+		}
+		return limits[i].Period < limits[j].Period
+	})
+	return limits, nil
+}
+
+func (r *InMemoryGamblingLimitRepository) SaveGamblingLimit(ctx context.Context, limit *model.GamblingLimit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limits[gamblingLimitKey(limit.PlayerID, limit.Type, limit.Period)] = *limit
+	return nil
+}
@@ -75,6 +75,56 @@ func TestInMemoryPlayerRepository(t *testing.T) {
 		assert.Equal(t, repository.ErrPlayerNotFound, err, "Expected ErrPlayerNotFound error")
 	})
 
+	t.Run("GetPlayerByEmail_CaseInsensitive", func(t *testing.T) {
+		player := &model.Player{
+			ID:    "email_player",
+			Email: "player@email.com",
+		}
+
+		err := repo.CreatePlayer(ctx, player)
+		assert.NoError(t, err, "Expected no error on creating player")
+
+		retrievedPlayer, err := repo.GetPlayerByEmail(ctx, "Player@Email.COM")
+		assert.NoError(t, err, "Expected no error on retrieving player by email")
+		assert.Equal(t, player, retrievedPlayer, "Expected email lookup to ignore case")
+	})
+
+	t.Run("AdjustBalance_Concurrent", func(t *testing.T) {
+		err := repo.CreatePlayer(ctx, &model.Player{ID: "balance_player", Balance: 100})
+		assert.NoError(t, err, "Expected no error on creating player")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.AdjustBalance(ctx, "balance_player", 10)
+				assert.NoError(t, err, "Expected no error on concurrent credit")
+			}()
+		}
+		wg.Wait()
+
+		balance, err := repo.AdjustBalance(ctx, "balance_player", 0)
+		assert.NoError(t, err, "Expected no error on reading the balance")
+		assert.Equal(t, 600, balance, "Expected every concurrent credit to be kept")
+
+		_, err = repo.AdjustBalance(ctx, "balance_player", -601)
+		assert.Equal(t, repository.ErrNegativeBalance, err, "Expected ErrNegativeBalance when the balance would go negative")
+	})
+
+	t.Run("ConsumeTOTPCounter_RejectsReplay", func(t *testing.T) {
+		err := repo.CreatePlayer(ctx, &model.Player{ID: "totp_player", TOTPLastCounter: 10})
+		assert.NoError(t, err, "Expected no error on creating player")
+
+		consumed, err := repo.ConsumeTOTPCounter(ctx, "totp_player", 11)
+		assert.NoError(t, err, "Expected no error on consuming a newer counter")
+		assert.True(t, consumed, "Expected a newer counter to be consumed")
+
+		consumed, err = repo.ConsumeTOTPCounter(ctx, "totp_player", 11)
+		assert.NoError(t, err, "Expected no error on consuming a repeated counter")
+		assert.False(t, consumed, "Expected a repeated counter to be rejected")
+	})
+
 	t.Run("ConcurrentAccess", func(t *testing.T) {
 		var wg sync.WaitGroup
 		numGoroutines := 100
@@ -46,7 +46,7 @@ func TestInMemorySlotMachineRepository(t *testing.T) {
 			},
 			MultipleGain: 5,
 		}
-		machine.GeneratePermutations()
+		machine.ConfigureGrid(model.DefaultReels, model.DefaultRows, nil, nil)
 
 		err := repo.UpdateSlotMachine(ctx, machine)
 		assert.NoError(t, err, "Expected no error on updating existing slot machine")
@@ -63,7 +63,7 @@ func TestInMemorySlotMachineRepository(t *testing.T) {
 			Balance:        5000,
 			InitialBalance: 5000,
 			Symbols:        map[string]string{},
-			Permutations:   [][3]string{},
+			Strips:         []model.ReelStrip{},
 			MultipleGain:   2,
 		}
 
@@ -71,4 +71,18 @@ func TestInMemorySlotMachineRepository(t *testing.T) {
 		assert.Error(t, err, "Expected error on updating non-existent slot machine")
 		assert.Equal(t, repository.ErrSlotMachineNotFound, err, "Expected ErrSlotMachineNotFound error")
 	})
+	t.Run("AdjustSlotMachineBalance_Success", func(t *testing.T) {
+		balance, err := repo.AdjustSlotMachineBalance(ctx, "machine1", -500)
+		assert.NoError(t, err, "Expected no error on adjusting the balance")
+		assert.Equal(t, 14500, balance, "Expected the delta to be applied to the stored balance")
+
+		machine, err := repo.GetSlotMachine(ctx, "machine1")
+		assert.NoError(t, err, "Expected no error on retrieving existing slot machine")
+		assert.Equal(t, 14500, machine.Balance, "Expected the stored machine to reflect the delta")
+	})
+
+	t.Run("AdjustSlotMachineBalance_NotFound", func(t *testing.T) {
+		_, err := repo.AdjustSlotMachineBalance(ctx, "nonexistent_machine", 100)
+		assert.Equal(t, repository.ErrSlotMachineNotFound, err, "Expected ErrSlotMachineNotFound error")
+	})
 }
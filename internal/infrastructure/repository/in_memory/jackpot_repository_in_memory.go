@@ -0,0 +1,172 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// InMemoryJackpotRepository serializa contribuições e pagamentos com um
+// único lock e usa o repositório da tesouraria para os valores iniciais.
+type InMemoryJackpotRepository struct {
+	pools        map[string]model.JackpotPool
+	machinePools map[string]string
+	wins         []model.JackpotWin
+	treasuryRepo repository.TreasuryRepository
+	mu           sync.RWMutex
+}
+
+func NewInMemoryJackpotRepository(treasuryRepo repository.TreasuryRepository) repository.JackpotRepository {
+	return &InMemoryJackpotRepository{
+		pools:        make(map[string]model.JackpotPool),
+		machinePools: make(map[string]string),
+		treasuryRepo: treasuryRepo,
+	}
+}
+
+func (r *InMemoryJackpotRepository) CreatePool(ctx context.Context, pool *model.JackpotPool, seed *model.TreasuryMovement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if seed.Amount > 0 {
+		if err := r.treasuryRepo.ApplyMovement(ctx, seed, 0); err != nil {
+			return err
+		}
+	}
+	r.pools[pool.ID] = *pool
+	return nil
+}
+
+func (r *InMemoryJackpotRepository) GetPool(ctx context.Context, id string) (*model.JackpotPool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pool, exists := r.pools[id]
+	if !exists {
+		return nil, repository.ErrJackpotPoolNotFound
+	}
+	return r.withMachines(pool), nil
+}
+
+func (r *InMemoryJackpotRepository) ListPools(ctx context.Context) ([]*model.JackpotPool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pools := make([]*model.JackpotPool, 0, len(r.pools))
+	for _, pool := range r.pools {
+		pools = append(pools, r.withMachines(pool))
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].CreatedAt.Before(pools[j].CreatedAt)
+	})
+	return pools, nil
+}
+
+func (r *InMemoryJackpotRepository) GetMachinePool(ctx context.Context, machineID string) (*model.JackpotPool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	poolID, linked := r.machinePools[machineID]
+	if !linked {
+		return nil, repository.ErrJackpotPoolNotFound
+	}
+	return r.withMachines(r.pools[poolID]), nil
+}
+
+func (r *InMemoryJackpotRepository) LinkMachine(ctx context.Context, poolID, machineID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.pools[poolID]; !exists {
+		return repository.ErrJackpotPoolNotFound
+	}
+	if current, linked := r.machinePools[machineID]; linked && current != poolID {
+		return repository.ErrMachineAlreadyLinked
+	}
+	r.machinePools[machineID] = poolID
+	return nil
+}
+
+func (r *InMemoryJackpotRepository) UnlinkMachine(ctx context.Context, poolID, machineID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.pools[poolID]; !exists {
+		return repository.ErrJackpotPoolNotFound
+	}
+	if r.machinePools[machineID] != poolID {
+		return repository.ErrMachineNotLinked
+	}
+	delete(r.machinePools, machineID)
+	return nil
+}
+
+func (r *InMemoryJackpotRepository) Contribute(ctx context.Context, poolID string, amount int, win *model.JackpotWin) (*model.JackpotPool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pool, exists := r.pools[poolID]
+	if !exists {
+		return nil, repository.ErrJackpotPoolNotFound
+	}
+	pool.Amount += amount
+	pool.TotalContributed += amount
+
+	if win != nil {
+		win.Amount = pool.Amount
+		pool.TotalPaid += pool.Amount
+		pool.Amount = 0
+
+		treasury, err := r.treasuryRepo.GetTreasury(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if seed := min(pool.SeedAmount, treasury.Balance); seed > 0 {
+			err := r.treasuryRepo.ApplyMovement(ctx, jackpotReseed(&pool, seed, win), 0)
+			if err != nil {
+				return nil, err
+			}
+			pool.Amount = seed
+			pool.TotalSeeded += seed
+		}
+		r.wins = append(r.wins, *win)
+	}
+
+	r.pools[poolID] = pool
+	return r.withMachines(pool), nil
+}
+
+func (r *InMemoryJackpotRepository) ListJackpotWins(ctx context.Context, limit int) ([]*model.JackpotWin, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var wins []*model.JackpotWin
+	for i := len(r.wins) - 1; i >= 0; i-- {
+		if limit > 0 && len(wins) == limit {
+			break
+		}
+		win := r.wins[i]
+		wins = append(wins, &win)
+	}
+	return wins, nil
+}
+
+func (r *InMemoryJackpotRepository) withMachines(pool model.JackpotPool) *model.JackpotPool {
+	pool.MachineIDs = []string{}
+	for machineID, poolID := range r.machinePools {
+		if poolID == pool.ID {
+			pool.MachineIDs = append(pool.MachineIDs, machineID)
+		}
+	}
+	sort.Strings(pool.MachineIDs)
+	pool.TriggerCombination = append([]string(nil), pool.TriggerCombination...)
+	return &pool
+}
+
+func jackpotReseed(pool *model.JackpotPool, amount int, win *model.JackpotWin) *model.TreasuryMovement {
+	return &model.TreasuryMovement{
+		ID:            uuid.New().String(),
+		Type:          model.MovementJackpotSeed,
+		JackpotPoolID: pool.ID,
+		Amount:        amount,
+		Reason:        "jackpot reseed",
+		Actor:         "system",
+		CreatedAt:     win.CreatedAt,
+	}
+}
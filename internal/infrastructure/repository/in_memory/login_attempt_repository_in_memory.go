@@ -0,0 +1,71 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+type InMemoryLoginAttemptRepository struct {
+	attempts map[string]model.LoginAttempt
+	mu       sync.RWMutex
+}
+
+func NewInMemoryLoginAttemptRepository() repository.LoginAttemptRepository {
+	return &InMemoryLoginAttemptRepository{
+		attempts: make(map[string]model.LoginAttempt),
+	}
+}
+
+func (r *InMemoryLoginAttemptRepository) GetLoginAttempt(ctx context.Context, key string) (*model.LoginAttempt, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	attempt, exists := r.attempts[key]
+	if !exists {
+		return nil, repository.ErrLoginAttemptNotFound
+	}
+	return &attempt, nil
+}
+
+func (r *InMemoryLoginAttemptRepository) RecordLoginFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*model.LoginAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	attempt, exists := r.attempts[key]
+	if !exists {
+		attempt = model.LoginAttempt{Key: key}
+	}
+	if attempt.LastFailureAt.Before(now.Add(-window)) {
+		attempt.Failures = 0
+	}
+	attempt.Failures++
+	attempt.LastFailureAt = now
+	r.attempts[key] = attempt
+	return &attempt, nil
+}
+
+func (r *InMemoryLoginAttemptRepository) BlockLoginAttempt(ctx context.Context, key string, blockedUntil, lockedUntil time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	attempt, exists := r.attempts[key]
+	if !exists {
+		return nil
+	}
+	if lockedUntil.After(attempt.LockedUntil) {
+		attempt.Failures = 0
+		attempt.LockedUntil = lockedUntil
+	}
+	if blockedUntil.After(attempt.BlockedUntil) {
+		attempt.BlockedUntil = blockedUntil
+	}
+	r.attempts[key] = attempt
+	return nil
+}
+
+func (r *InMemoryLoginAttemptRepository) DeleteLoginAttempt(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.attempts, key)
+	return nil
+}
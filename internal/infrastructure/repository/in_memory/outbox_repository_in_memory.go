@@ -0,0 +1,82 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+type InMemoryOutboxRepository struct {
+	events []*model.DomainEvent
+	mu     sync.RWMutex
+}
+
+func NewInMemoryOutboxRepository() repository.OutboxRepository {
+	return &InMemoryOutboxRepository{}
+}
+
+func (r *InMemoryOutboxRepository) AddEvent(ctx context.Context, event *model.DomainEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *event
+	r.events = append(r.events, &stored)
+	return nil
+}
+
+func (r *InMemoryOutboxRepository) ListPendingEvents(ctx context.Context, limit int) ([]*model.DomainEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []*model.DomainEvent
+	for _, event := range r.events {
+		if event.PublishedAt != nil || event.DeadAt != nil {
+			continue
+		}
+		if len(events) >= limit {
+			break
+		}
+		copied := *event
+		copied.DeliveredTo = append([]string(nil), event.DeliveredTo...)
+		events = append(events, &copied)
+	}
+	return events, nil
+}
+
+func (r *InMemoryOutboxRepository) MarkEventDelivered(ctx context.Context, id, publisher string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, event := range r.events {
+		if event.ID == id && !event.DeliveredBy(publisher) {
+			event.DeliveredTo = append(event.DeliveredTo, publisher)
+		}
+	}
+	return nil
+}
+
+func (r *InMemoryOutboxRepository) MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, event := range r.events {
+		if event.ID == id {
+			event.PublishedAt = &publishedAt
+			event.Attempts++
+			event.LastError = ""
+		}
+	}
+	return nil
+}
+
+func (r *InMemoryOutboxRepository) MarkEventFailed(ctx context.Context, id string, lastError string, deadAt *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, event := range r.events {
+		if event.ID == id {
+			event.Attempts++
+			event.LastError = lastError
+			event.DeadAt = deadAt
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+)
+
+type InMemoryPlaySessionRepository struct {
+	sessions map[string]model.PlaySession
+	mu       sync.RWMutex
+}
+
+func NewInMemoryPlaySessionRepository() repository.PlaySessionRepository {
+	return &InMemoryPlaySessionRepository{
+		sessions: make(map[string]model.PlaySession),
+	}
+}
+
+func (r *InMemoryPlaySessionRepository) GetLatestPlaySession(ctx context.Context, playerID string) (*model.PlaySession, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var latest *model.PlaySession
+	for _, session := range r.sessions {
+		if session.PlayerID != playerID {
+			continue
+		}
+		if latest == nil || session.StartedAt.After(latest.StartedAt) {
+			found := session
+			latest = &found
+		}
+	}
+	if latest == nil {
+		return nil, repository.ErrPlaySessionNotFound
+	}
+	return latest, nil
+}
+
+func (r *InMemoryPlaySessionRepository) SavePlaySession(ctx context.Context, session *model.PlaySession) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[session.ID] = *session
+	return nil
+}
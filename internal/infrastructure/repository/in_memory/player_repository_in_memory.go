@@ -4,6 +4,8 @@ import (
 	"context"
 	"slot-machine/internal/domain/model"
 	"slot-machine/internal/domain/repository"
+	"sort"
+	"strings"
 	"sync"
 )
 
@@ -57,14 +59,140 @@ func (r *InMemoryPlayerRepository) UpdatePlayer(ctx context.Context, player *mod
 	return nil
 }
 
+func (r *InMemoryPlayerRepository) AdjustBalance(ctx context.Context, id string, delta int) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	player, exists := r.players[id]
+	if !exists {
+		return 0, repository.ErrPlayerNotFound
+	}
+	if player.Balance+delta < 0 {
+		return 0, repository.ErrNegativeBalance
+	}
+	player.Balance += delta
+	return player.Balance, nil
+}
+
+// LockPlayer só confirma que o jogador existe: sem transações, não há o que
+// bloquear.
+func (r *InMemoryPlayerRepository) LockPlayer(ctx context.Context, id string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, exists := r.players[id]; !exists {
+		return repository.ErrPlayerNotFound
+	}
+	return nil
+}
+
+func (r *InMemoryPlayerRepository) ConsumeTOTPCounter(ctx context.Context, id string, counter int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	player, exists := r.players[id]
+	if !exists {
+		return false, repository.ErrPlayerNotFound
+	}
+	if counter <= player.TOTPLastCounter {
+		return false, nil
+	}
+	player.TOTPLastCounter = counter
+	return true, nil
+}
+
+func (r *InMemoryPlayerRepository) SetTOTPSecret(ctx context.Context, id, secret string) error {
+	return r.updatePlayer(id, func(player *model.Player) {
+		player.TOTPSecret = secret
+		player.TOTPEnabled = false
+		player.TOTPLastCounter = 0
+	})
+}
+
+func (r *InMemoryPlayerRepository) EnableTOTP(ctx context.Context, id string) error {
+	return r.updatePlayer(id, func(player *model.Player) {
+		player.TOTPEnabled = true
+	})
+}
+
+func (r *InMemoryPlayerRepository) UpdatePassword(ctx context.Context, id, passwordHash string) error {
+	return r.updatePlayer(id, func(player *model.Player) {
+		player.Password = passwordHash
+	})
+}
+
+func (r *InMemoryPlayerRepository) MarkEmailVerified(ctx context.Context, id string) error {
+	return r.updatePlayer(id, func(player *model.Player) {
+		player.EmailVerified = true
+	})
+}
+
+func (r *InMemoryPlayerRepository) SetRealityCheckMinutes(ctx context.Context, id string, minutes int) error {
+	return r.updatePlayer(id, func(player *model.Player) {
+		player.RealityCheckMinutes = minutes
+	})
+}
+
+func (r *InMemoryPlayerRepository) SetBlocked(ctx context.Context, id string, blocked bool, reason string) error {
+	return r.updatePlayer(id, func(player *model.Player) {
+		player.Blocked = blocked
+		player.BlockedReason = reason
+	})
+}
+
+// updatePlayer aplica update ao jogador guardado sob o lock do repositório.
+func (r *InMemoryPlayerRepository) updatePlayer(id string, update func(player *model.Player)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	player, exists := r.players[id]
+	if !exists {
+		return repository.ErrPlayerNotFound
+	}
+	update(player)
+	return nil
+}
+
 func (r *InMemoryPlayerRepository) GetPlayerByEmail(ctx context.Context, email string) (*model.Player, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	for _, player := range r.players {
-		if player.Email == email {
+		if strings.EqualFold(player.Email, email) {
 			return player, nil
 		}
 	}
 
 	return nil, repository.ErrPlayerNotFound
 }
+
+func (r *InMemoryPlayerRepository) SearchPlayers(ctx context.Context, filter repository.PlayerFilter) ([]*model.Player, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	email := strings.ToLower(filter.Email)
+	var players []*model.Player
+	for _, player := range r.players {
+		if strings.Contains(strings.ToLower(player.Email), email) {
+			players = append(players, player)
+		}
+	}
+
+	sort.Slice(players, func(i, j int) bool {
+		a, b := players[i], players[j]
+		if filter.Descending {
+			a, b = b, a
+		}
+		if filter.SortBy == repository.PlayerSortBalance && a.Balance != b.Balance {
+			return a.Balance < b.Balance
+		}
+		if filter.SortBy != repository.PlayerSortBalance && !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return players[i].ID < players[j].ID
+	})
+
+	total := len(players)
+	if filter.Offset >= total {
+		return []*model.Player{}, total, nil
+	}
+	players = players[filter.Offset:]
+	if filter.Limit > 0 && len(players) > filter.Limit {
+		players = players[:filter.Limit]
+	}
+	return players, total, nil
+}
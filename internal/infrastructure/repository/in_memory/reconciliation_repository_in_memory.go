@@ -0,0 +1,132 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+)
+
+// InMemoryReconciliationRepository monta o snapshot a partir dos demais
+// repositórios em memória. Não há isolamento entre as leituras; serve para
+// testes e desenvolvimento.
+type InMemoryReconciliationRepository struct {
+	playerRepo      repository.PlayerRepository
+	slotMachineRepo repository.SlotMachineRepository
+	transactionRepo repository.TransactionRepository
+	adjustmentRepo  repository.AdjustmentRepository
+	treasuryRepo    repository.TreasuryRepository
+	spinRepo        repository.SpinRepository
+	jackpotRepo     repository.JackpotRepository
+}
+
+func NewInMemoryReconciliationRepository(playerRepo repository.PlayerRepository, slotRepo repository.SlotMachineRepository, txRepo repository.TransactionRepository, adjustmentRepo repository.AdjustmentRepository, treasuryRepo repository.TreasuryRepository, spinRepo repository.SpinRepository, jackpotRepo repository.JackpotRepository) repository.ReconciliationRepository {
+	return &InMemoryReconciliationRepository{
+		playerRepo:      playerRepo,
+		slotMachineRepo: slotRepo,
+		transactionRepo: txRepo,
+		adjustmentRepo:  adjustmentRepo,
+		treasuryRepo:    treasuryRepo,
+		spinRepo:        spinRepo,
+		jackpotRepo:     jackpotRepo,
+	}
+}
+
+func (r *InMemoryReconciliationRepository) LoadLedgerSnapshot(ctx context.Context) (*model.LedgerSnapshot, error) {
+	snapshot := &model.LedgerSnapshot{
+		MachineAdjustments: make(map[string]int),
+		JackpotWins:        make(map[string]int),
+	}
+
+	players, err := r.playerRepo.ListPlayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	type transactionKey struct {
+		playerID  string
+		machineID string
+		txType    model.TransactionType
+	}
+	transactionTotals := make(map[transactionKey]int)
+	for _, player := range players {
+		snapshot.Players = append(snapshot.Players, model.AccountBalance{ID: player.ID, Balance: player.Balance})
+
+		transactions, err := r.transactionRepo.ListTransactions(ctx, player.ID, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range transactions {
+			transactionTotals[transactionKey{tx.PlayerID, tx.MachineID, tx.Type}] += tx.Amount
+		}
+	}
+	for key, amount := range transactionTotals {
+		snapshot.Transactions = append(snapshot.Transactions, model.TransactionTotal{
+			PlayerID:  key.playerID,
+			MachineID: key.machineID,
+			Type:      key.txType,
+			Amount:    amount,
+		})
+	}
+
+	machines, err := r.slotMachineRepo.ListSlotMachines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, machine := range machines {
+		snapshot.Machines = append(snapshot.Machines, model.AccountBalance{
+			ID:             machine.ID,
+			Balance:        machine.Balance,
+			InitialBalance: machine.InitialBalance,
+		})
+	}
+
+	adjustments, err := r.adjustmentRepo.ListAdjustments(ctx, model.AdjustmentApproved)
+	if err != nil {
+		return nil, err
+	}
+	for _, adjustment := range adjustments {
+		if adjustment.TargetType == model.AdjustmentTargetMachine {
+			snapshot.MachineAdjustments[adjustment.TargetID] += adjustment.Amount
+		}
+	}
+
+	treasury, err := r.treasuryRepo.GetTreasury(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.TreasuryBalance = treasury.Balance
+
+	movements, err := r.treasuryRepo.ListTreasuryMovements(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	movementTotals := make(map[model.TreasuryMovementTotal]int)
+	for _, movement := range movements {
+		key := model.TreasuryMovementTotal{MachineID: movement.MachineID, JackpotPoolID: movement.JackpotPoolID, Type: movement.Type}
+		movementTotals[key] += movement.Amount
+	}
+	for key, amount := range movementTotals {
+		key.Amount = amount
+		snapshot.TreasuryMovements = append(snapshot.TreasuryMovements, key)
+	}
+
+	pools, err := r.jackpotRepo.ListPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		snapshot.JackpotPools = append(snapshot.JackpotPools, model.AccountBalance{ID: pool.ID, Balance: pool.Amount})
+	}
+	snapshot.JackpotContributions, err = r.spinRepo.SumJackpotContributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wins, err := r.jackpotRepo.ListJackpotWins(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, win := range wins {
+		snapshot.JackpotWins[win.PoolID] += win.Amount
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,50 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/repository"
+	"sync"
+)
+
+type InMemoryRecoveryCodeRepository struct {
+	codes map[string]map[string]bool
+	mu    sync.Mutex
+}
+
+func NewInMemoryRecoveryCodeRepository() repository.RecoveryCodeRepository {
+	return &InMemoryRecoveryCodeRepository{
+		codes: make(map[string]map[string]bool),
+	}
+}
+
+func (r *InMemoryRecoveryCodeRepository) ReplaceRecoveryCodes(ctx context.Context, playerID string, codeHashes []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	codes := make(map[string]bool, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes[hash] = false
+	}
+	r.codes[playerID] = codes
+	return nil
+}
+
+func (r *InMemoryRecoveryCodeRepository) ConsumeRecoveryCode(ctx context.Context, playerID, codeHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	used, exists := r.codes[playerID][codeHash]
+	if !exists || used {
+		return false, nil
+	}
+	r.codes[playerID][codeHash] = true
+	return true, nil
+}
+
+func (r *InMemoryRecoveryCodeRepository) DeleteRecoveryCodes(ctx context.Context, playerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.codes, playerID)
+	return nil
+}
@@ -55,3 +55,10 @@ func (r *InMemoryRefreshTokenRepository) ValidateRefreshToken(ctx context.Contex
     return false, nil
 }
 
+func (r *InMemoryRefreshTokenRepository) RevokeAllRefreshTokens(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.tokens, userID)
+	return nil
+}
@@ -0,0 +1,36 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+)
+
+type InMemorySelfExclusionRepository struct {
+	exclusions map[string]model.SelfExclusion
+	mu         sync.RWMutex
+}
+
+func NewInMemorySelfExclusionRepository() repository.SelfExclusionRepository {
+	return &InMemorySelfExclusionRepository{
+		exclusions: make(map[string]model.SelfExclusion),
+	}
+}
+
+func (r *InMemorySelfExclusionRepository) GetSelfExclusion(ctx context.Context, playerID string) (*model.SelfExclusion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	exclusion, exists := r.exclusions[playerID]
+	if !exists {
+		return nil, repository.ErrSelfExclusionNotFound
+	}
+	return &exclusion, nil
+}
+
+func (r *InMemorySelfExclusionRepository) SaveSelfExclusion(ctx context.Context, exclusion *model.SelfExclusion) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exclusions[exclusion.PlayerID] = *exclusion
+	return nil
+}
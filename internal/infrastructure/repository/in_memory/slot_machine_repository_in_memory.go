@@ -39,6 +39,17 @@ func (r *InMemorySlotMachineRepository) UpdateSlotMachine(ctx context.Context, m
 	return nil
 }
 
+func (r *InMemorySlotMachineRepository) AdjustSlotMachineBalance(ctx context.Context, id string, delta int) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	machine, exists := r.machines[id]
+	if !exists {
+		return 0, repository.ErrSlotMachineNotFound
+	}
+	machine.Balance += delta
+	return machine.Balance, nil
+}
+
 func (r *InMemorySlotMachineRepository) CreateSlotMachine(ctx context.Context, machine *model.SlotMachine) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -48,3 +59,13 @@ func (r *InMemorySlotMachineRepository) CreateSlotMachine(ctx context.Context, m
 	r.machines[machine.ID] = machine
 	return nil
 }
+
+func (r *InMemorySlotMachineRepository) ListSlotMachines(ctx context.Context) ([]*model.SlotMachine, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	machines := make([]*model.SlotMachine, 0, len(r.machines))
+	for _, machine := range r.machines {
+		machines = append(machines, machine)
+	}
+	return machines, nil
+}
@@ -0,0 +1,134 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sort"
+	"sync"
+	"time"
+)
+
+type playerSpinStatsKey struct {
+	playerID  string
+	machineID string
+	day       time.Time
+}
+
+type InMemorySpinRepository struct {
+	spins       []model.Spin
+	playerStats map[playerSpinStatsKey]*model.PlayerSpinStats
+	mu          sync.RWMutex
+}
+
+func NewInMemorySpinRepository() repository.SpinRepository {
+	return &InMemorySpinRepository{
+		playerStats: make(map[playerSpinStatsKey]*model.PlayerSpinStats),
+	}
+}
+
+func (r *InMemorySpinRepository) RecordSpin(ctx context.Context, spin *model.Spin) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spins = append(r.spins, *spin)
+
+	key := playerSpinStatsKey{
+		playerID:  spin.PlayerID,
+		machineID: spin.MachineID,
+		day:       model.GranularityDay.Truncate(spin.CreatedAt),
+	}
+	stats, exists := r.playerStats[key]
+	if !exists {
+		stats = &model.PlayerSpinStats{PlayerID: key.playerID, MachineID: key.machineID, Day: key.day}
+		r.playerStats[key] = stats
+	}
+	stats.Add(spin)
+	return nil
+}
+
+func (r *InMemorySpinRepository) ListPlayerSpinStats(ctx context.Context, playerID string, from time.Time) ([]model.PlayerSpinStats, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	first := model.GranularityDay.Truncate(from)
+	var rows []model.PlayerSpinStats
+	for key, stats := range r.playerStats {
+		if key.playerID == playerID && !key.day.Before(first) {
+			rows = append(rows, *stats)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].Day.Equal(rows[j].Day) {
+			return rows[i].Day.Before(rows[j].Day)
+		}
+		return rows[i].MachineID < rows[j].MachineID
+	})
+	return rows, nil
+}
+
+func (r *InMemorySpinRepository) AggregateMachineSpins(ctx context.Context, machineID string, from, to time.Time, granularity model.StatsGranularity) (model.SpinAggregate, []model.SpinAggregate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var total model.SpinAggregate
+	totalPlayers := make(map[string]struct{})
+	buckets := make(map[time.Time]*model.SpinAggregate)
+	bucketPlayers := make(map[time.Time]map[string]struct{})
+
+	for _, spin := range r.spins {
+		if spin.MachineID != machineID || spin.CreatedAt.Before(from) || !spin.CreatedAt.Before(to) {
+			continue
+		}
+		start := granularity.Truncate(spin.CreatedAt)
+		bucket, exists := buckets[start]
+		if !exists {
+			bucket = &model.SpinAggregate{Start: start}
+			buckets[start] = bucket
+			bucketPlayers[start] = make(map[string]struct{})
+		}
+		addSpin(&total, spin)
+		addSpin(bucket, spin)
+		totalPlayers[spin.PlayerID] = struct{}{}
+		bucketPlayers[start][spin.PlayerID] = struct{}{}
+	}
+
+	total.UniquePlayers = len(totalPlayers)
+	series := make([]model.SpinAggregate, 0, len(buckets))
+	for start, bucket := range buckets {
+		bucket.UniquePlayers = len(bucketPlayers[start])
+		series = append(series, *bucket)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Start.Before(series[j].Start)
+	})
+	return total, series, nil
+}
+
+func (r *InMemorySpinRepository) SumJackpotContributions(ctx context.Context) ([]model.JackpotContributionTotal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	sums := make(map[model.JackpotContributionTotal]int)
+	for _, spin := range r.spins {
+		if spin.JackpotContribution > 0 {
+			sums[model.JackpotContributionTotal{PoolID: spin.JackpotPoolID, MachineID: spin.MachineID}] += spin.JackpotContribution
+		}
+	}
+	totals := make([]model.JackpotContributionTotal, 0, len(sums))
+	for key, amount := range sums {
+		key.Amount = amount
+		totals = append(totals, key)
+	}
+	return totals, nil
+}
+
+func addSpin(aggregate *model.SpinAggregate, spin model.Spin) {
+	aggregate.Spins++
+	aggregate.TotalWagered += spin.Bet
+	aggregate.TotalPaid += spin.Payout
+	if spin.IsWin() {
+		aggregate.Wins++
+	}
+	if spin.Payout > aggregate.LargestWin {
+		aggregate.LargestWin = spin.Payout
+	}
+}
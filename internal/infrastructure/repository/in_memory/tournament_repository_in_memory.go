@@ -0,0 +1,162 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sort"
+	"sync"
+	"time"
+)
+
+type InMemoryTournamentRepository struct {
+	tournaments map[string]*model.Tournament
+	entries     map[string]map[string]*model.TournamentEntry
+	mu          sync.RWMutex
+}
+
+func NewInMemoryTournamentRepository() repository.TournamentRepository {
+	return &InMemoryTournamentRepository{
+		tournaments: make(map[string]*model.Tournament),
+		entries:     make(map[string]map[string]*model.TournamentEntry),
+	}
+}
+
+func copyTournament(tournament *model.Tournament) *model.Tournament {
+	found := *tournament
+	found.MachineIDs = append([]string(nil), tournament.MachineIDs...)
+	found.PrizeDistribution = append([]int(nil), tournament.PrizeDistribution...)
+	return &found
+}
+
+func (r *InMemoryTournamentRepository) CreateTournament(ctx context.Context, tournament *model.Tournament) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tournaments[tournament.ID] = copyTournament(tournament)
+	r.entries[tournament.ID] = make(map[string]*model.TournamentEntry)
+	return nil
+}
+
+func (r *InMemoryTournamentRepository) GetTournament(ctx context.Context, id string) (*model.Tournament, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tournament, exists := r.tournaments[id]
+	if !exists {
+		return nil, repository.ErrTournamentNotFound
+	}
+	return copyTournament(tournament), nil
+}
+
+func (r *InMemoryTournamentRepository) ListTournaments(ctx context.Context, status model.TournamentStatus) ([]*model.Tournament, error) {
+	return r.filter(func(t *model.Tournament) bool {
+		return status == "" || t.Status == status
+	}), nil
+}
+
+func (r *InMemoryTournamentRepository) ListRunningTournaments(ctx context.Context, machineID string, now time.Time) ([]*model.Tournament, error) {
+	return r.filter(func(t *model.Tournament) bool {
+		return t.Running(now) && t.Eligible(machineID)
+	}), nil
+}
+
+func (r *InMemoryTournamentRepository) ListDueTournaments(ctx context.Context, now time.Time) ([]*model.Tournament, error) {
+	tournaments := r.filter(func(t *model.Tournament) bool {
+		return t.Status == model.TournamentStatusOpen && !t.EndsAt.After(now)
+	})
+	sort.SliceStable(tournaments, func(i, j int) bool {
+		return tournaments[i].EndsAt.Before(tournaments[j].EndsAt)
+	})
+	return tournaments, nil
+}
+
+// filter retorna cópias dos torneios aceitos, dos que começam mais tarde
+// para os que começam mais cedo.
+func (r *InMemoryTournamentRepository) filter(accept func(*model.Tournament) bool) []*model.Tournament {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var tournaments []*model.Tournament
+	for _, tournament := range r.tournaments {
+		if accept(tournament) {
+			tournaments = append(tournaments, copyTournament(tournament))
+		}
+	}
+	sort.Slice(tournaments, func(i, j int) bool {
+		return tournaments[i].StartsAt.After(tournaments[j].StartsAt)
+	})
+	return tournaments
+}
+
+func (r *InMemoryTournamentRepository) AddEntry(ctx context.Context, entry *model.TournamentEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tournament, exists := r.tournaments[entry.TournamentID]
+	if !exists {
+		return repository.ErrTournamentNotFound
+	}
+	if _, joined := r.entries[entry.TournamentID][entry.PlayerID]; joined {
+		return repository.ErrTournamentEntryExists
+	}
+	stored := *entry
+	r.entries[entry.TournamentID][entry.PlayerID] = &stored
+	tournament.Entrants++
+	return nil
+}
+
+func (r *InMemoryTournamentRepository) GetEntry(ctx context.Context, tournamentID, playerID string) (*model.TournamentEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entry, exists := r.entries[tournamentID][playerID]
+	if !exists {
+		return nil, repository.ErrTournamentEntryNotFound
+	}
+	found := *entry
+	return &found, nil
+}
+
+func (r *InMemoryTournamentRepository) ListEntries(ctx context.Context, tournamentID string) ([]*model.TournamentEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, exists := r.tournaments[tournamentID]; !exists {
+		return nil, repository.ErrTournamentNotFound
+	}
+	entries := make([]*model.TournamentEntry, 0, len(r.entries[tournamentID]))
+	for _, entry := range r.entries[tournamentID] {
+		found := *entry
+		entries = append(entries, &found)
+	}
+	return entries, nil
+}
+
+func (r *InMemoryTournamentRepository) RecordScore(ctx context.Context, tournamentID, playerID string, scoring model.TournamentScoring, points int, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, exists := r.entries[tournamentID][playerID]
+	if !exists {
+		return repository.ErrTournamentEntryNotFound
+	}
+	entry.Spins++
+	score := entry.Score + points
+	if scoring == model.TournamentScoringBiggestMultiplier {
+		score = max(entry.Score, points)
+	}
+	if score > entry.Score {
+		entry.Score = score
+		entry.ScoredAt = &at
+	}
+	return nil
+}
+
+func (r *InMemoryTournamentRepository) SettleTournament(ctx context.Context, id string, settledAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tournament, exists := r.tournaments[id]
+	if !exists {
+		return repository.ErrTournamentNotFound
+	}
+	if tournament.Status == model.TournamentStatusSettled {
+		return repository.ErrTournamentSettled
+	}
+	tournament.Status = model.TournamentStatusSettled
+	tournament.SettledAt = &settledAt
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+type InMemoryTransactionRepository struct {
+	transactions []model.Transaction
+	mu           sync.RWMutex
+}
+
+func NewInMemoryTransactionRepository() repository.TransactionRepository {
+	return &InMemoryTransactionRepository{}
+}
+
+func (r *InMemoryTransactionRepository) RecordTransaction(ctx context.Context, transaction *model.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transactions = append(r.transactions, *transaction)
+	return nil
+}
+
+func (r *InMemoryTransactionRepository) SumTransactions(ctx context.Context, playerID string, txType model.TransactionType, since time.Time) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	total := 0
+	for _, tx := range r.transactions {
+		if tx.PlayerID == playerID && tx.Type == txType && !tx.CreatedAt.Before(since) {
+			total += tx.Amount
+		}
+	}
+	return total, nil
+}
+
+func (r *InMemoryTransactionRepository) ListTransactions(ctx context.Context, playerID string, limit int) ([]*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var transactions []*model.Transaction
+	for i := len(r.transactions) - 1; i >= 0; i-- {
+		if limit > 0 && len(transactions) == limit {
+			break
+		}
+		if r.transactions[i].PlayerID == playerID {
+			tx := r.transactions[i]
+			transactions = append(transactions, &tx)
+		}
+	}
+	return transactions, nil
+}
@@ -0,0 +1,18 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/ports"
+)
+
+// InMemoryTransactor apenas executa fn: os repositórios em memória não
+// desfazem escritas, então uma falha no meio de fn não é revertida.
+type InMemoryTransactor struct{}
+
+func NewInMemoryTransactor() ports.Transactor {
+	return InMemoryTransactor{}
+}
+
+func (InMemoryTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,74 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sync"
+)
+
+type InMemoryTreasuryRepository struct {
+	treasury        model.Treasury
+	movements       []model.TreasuryMovement
+	slotMachineRepo repository.SlotMachineRepository
+	mu              sync.RWMutex
+}
+
+func NewInMemoryTreasuryRepository(slotRepo repository.SlotMachineRepository) repository.TreasuryRepository {
+	return &InMemoryTreasuryRepository{
+		treasury:        model.Treasury{ID: model.HouseTreasuryID},
+		slotMachineRepo: slotRepo,
+	}
+}
+
+func (r *InMemoryTreasuryRepository) GetTreasury(ctx context.Context) (*model.Treasury, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	treasury := r.treasury
+	return &treasury, nil
+}
+
+func (r *InMemoryTreasuryRepository) ApplyMovement(ctx context.Context, movement *model.TreasuryMovement, minMachineBalance int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	treasuryBalance := r.treasury.Balance + movement.TreasuryDelta()
+	if treasuryBalance < 0 {
+		return repository.ErrInsufficientTreasuryBalance
+	}
+
+	if movement.Type == model.MovementRefill || movement.Type == model.MovementCashout {
+		machine, err := r.slotMachineRepo.GetSlotMachine(ctx, movement.MachineID)
+		if err != nil {
+			return err
+		}
+		if movement.Type == model.MovementCashout && machine.Balance-movement.TreasuryDelta() < minMachineBalance {
+			return repository.ErrInsufficientMachineBalance
+		}
+		balance, err := r.slotMachineRepo.AdjustSlotMachineBalance(ctx, movement.MachineID, -movement.TreasuryDelta())
+		if err != nil {
+			return err
+		}
+		movement.MachineBalance = balance
+	}
+
+	r.treasury.Balance = treasuryBalance
+	r.treasury.UpdatedAt = movement.CreatedAt
+	movement.TreasuryBalance = treasuryBalance
+	r.movements = append(r.movements, *movement)
+	return nil
+}
+
+func (r *InMemoryTreasuryRepository) ListTreasuryMovements(ctx context.Context, limit int) ([]*model.TreasuryMovement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var movements []*model.TreasuryMovement
+	for i := len(r.movements) - 1; i >= 0; i-- {
+		if limit > 0 && len(movements) == limit {
+			break
+		}
+		movement := r.movements[i]
+		movements = append(movements, &movement)
+	}
+	return movements, nil
+}
@@ -0,0 +1,147 @@
+package repository_in_memory
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"sort"
+	"sync"
+	"time"
+)
+
+type InMemoryWebhookRepository struct {
+	subscriptions map[string]*model.WebhookSubscription
+	deliveries    []*model.WebhookDelivery
+	mu            sync.RWMutex
+}
+
+func NewInMemoryWebhookRepository() repository.WebhookRepository {
+	return &InMemoryWebhookRepository{
+		subscriptions: make(map[string]*model.WebhookSubscription),
+	}
+}
+
+func (r *InMemoryWebhookRepository) CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *subscription
+	stored.EventTypes = append([]model.DomainEventType(nil), subscription.EventTypes...)
+	r.subscriptions[subscription.ID] = &stored
+	return nil
+}
+
+func (r *InMemoryWebhookRepository) GetSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	subscription, exists := r.subscriptions[id]
+	if !exists {
+		return nil, repository.ErrWebhookSubscriptionNotFound
+	}
+	found := *subscription
+	return &found, nil
+}
+
+func (r *InMemoryWebhookRepository) ListSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	subscriptions := make([]*model.WebhookSubscription, 0, len(r.subscriptions))
+	for _, subscription := range r.subscriptions {
+		found := *subscription
+		subscriptions = append(subscriptions, &found)
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreatedAt.Before(subscriptions[j].CreatedAt)
+	})
+	return subscriptions, nil
+}
+
+func (r *InMemoryWebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.subscriptions[id]; !exists {
+		return repository.ErrWebhookSubscriptionNotFound
+	}
+	delete(r.subscriptions, id)
+	return nil
+}
+
+func (r *InMemoryWebhookRepository) AddDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.deliveries {
+		if existing.SubscriptionID == delivery.SubscriptionID && existing.EventID == delivery.EventID {
+			return nil
+		}
+	}
+	stored := *delivery
+	r.deliveries = append(r.deliveries, &stored)
+	return nil
+}
+
+func (r *InMemoryWebhookRepository) GetDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, delivery := range r.deliveries {
+		if delivery.ID == id {
+			found := *delivery
+			return &found, nil
+		}
+	}
+	return nil, repository.ErrWebhookDeliveryNotFound
+}
+
+func (r *InMemoryWebhookRepository) ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deliveries []*model.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status != model.WebhookDeliveryPending || delivery.NextAttemptAt.After(now) {
+			continue
+		}
+		found := *delivery
+		deliveries = append(deliveries, &found)
+	}
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].NextAttemptAt.Before(deliveries[j].NextAttemptAt)
+	})
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (r *InMemoryWebhookRepository) ListDeliveries(ctx context.Context, filter repository.WebhookDeliveryFilter) ([]*model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deliveries []*model.WebhookDelivery
+	for i := len(r.deliveries) - 1; i >= 0; i-- {
+		delivery := r.deliveries[i]
+		if filter.SubscriptionID != "" && delivery.SubscriptionID != filter.SubscriptionID {
+			continue
+		}
+		if filter.Status != "" && delivery.Status != filter.Status {
+			continue
+		}
+		if filter.Limit > 0 && len(deliveries) >= filter.Limit {
+			break
+		}
+		found := *delivery
+		deliveries = append(deliveries, &found)
+	}
+	return deliveries, nil
+}
+
+func (r *InMemoryWebhookRepository) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.deliveries {
+		if existing.ID == delivery.ID {
+			stored := *delivery
+			r.deliveries[i] = &stored
+			return nil
+		}
+	}
+	return repository.ErrWebhookDeliveryNotFound
+}
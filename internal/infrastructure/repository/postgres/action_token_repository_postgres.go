@@ -0,0 +1,66 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresActionTokenRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresActionTokenRepository(pool *pgxpool.Pool) repository.ActionTokenRepository {
+	return &PostgresActionTokenRepository{pool: pool}
+}
+
+func (r *PostgresActionTokenRepository) StoreActionToken(ctx context.Context, token *model.ActionToken) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO action_tokens (token_hash, player_id, purpose, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		token.TokenHash, token.PlayerID, token.Purpose, token.ExpiresAt, token.CreatedAt)
+	return err
+}
+
+func (r *PostgresActionTokenRepository) GetActionToken(ctx context.Context, tokenHash string) (*model.ActionToken, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT token_hash, player_id, purpose, expires_at, created_at
+		FROM action_tokens
+		WHERE token_hash = $1`, tokenHash)
+	token := &model.ActionToken{}
+	err := row.Scan(&token.TokenHash, &token.PlayerID, &token.Purpose, &token.ExpiresAt, &token.CreatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrActionTokenNotFound
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r *PostgresActionTokenRepository) ConsumeActionToken(ctx context.Context, tokenHash string, purpose model.TokenPurpose, now time.Time) (*model.ActionToken, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		DELETE FROM action_tokens
+		WHERE token_hash = $1 AND purpose = $2 AND expires_at > $3
+		RETURNING token_hash, player_id, purpose, expires_at, created_at`, tokenHash, purpose, now)
+	token := &model.ActionToken{}
+	err := row.Scan(&token.TokenHash, &token.PlayerID, &token.Purpose, &token.ExpiresAt, &token.CreatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrActionTokenNotFound
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r *PostgresActionTokenRepository) DeletePlayerActionTokens(ctx context.Context, playerID string, purpose model.TokenPurpose) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM action_tokens
+		WHERE player_id = $1 AND purpose = $2`, playerID, purpose)
+	return err
+}
@@ -0,0 +1,153 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const adjustmentColumns = `id, target_type, target_id, amount, reason_code, note, status, proposed_by, proposed_at, reviewed_by, reviewed_at`
+
+type PostgresAdjustmentRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresAdjustmentRepository(pool *pgxpool.Pool) repository.AdjustmentRepository {
+	return &PostgresAdjustmentRepository{pool: pool}
+}
+
+func scanAdjustment(row pgx.Row) (*model.BalanceAdjustment, error) {
+	adjustment := &model.BalanceAdjustment{}
+	err := row.Scan(&adjustment.ID, &adjustment.TargetType, &adjustment.TargetID, &adjustment.Amount, &adjustment.ReasonCode,
+		&adjustment.Note, &adjustment.Status, &adjustment.ProposedBy, &adjustment.ProposedAt, &adjustment.ReviewedBy, &adjustment.ReviewedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrAdjustmentNotFound
+		}
+		return nil, err
+	}
+	return adjustment, nil
+}
+
+func (r *PostgresAdjustmentRepository) CreateAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO balance_adjustments (`+adjustmentColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		adjustment.ID, adjustment.TargetType, adjustment.TargetID, adjustment.Amount, adjustment.ReasonCode,
+		adjustment.Note, adjustment.Status, adjustment.ProposedBy, adjustment.ProposedAt, adjustment.ReviewedBy, adjustment.ReviewedAt)
+	return err
+}
+
+func (r *PostgresAdjustmentRepository) GetAdjustment(ctx context.Context, id string) (*model.BalanceAdjustment, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+adjustmentColumns+`
+		FROM balance_adjustments
+		WHERE id = $1`, id)
+	return scanAdjustment(row)
+}
+
+func (r *PostgresAdjustmentRepository) ListAdjustments(ctx context.Context, status model.AdjustmentStatus) ([]*model.BalanceAdjustment, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+adjustmentColumns+`
+		FROM balance_adjustments
+		WHERE $1 = '' OR status = $1
+		ORDER BY proposed_at DESC, id`, string(status))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var adjustments []*model.BalanceAdjustment
+	for rows.Next() {
+		adjustment, err := scanAdjustment(rows)
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+	return adjustments, rows.Err()
+}
+
+func (r *PostgresAdjustmentRepository) ApplyAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error {
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		if err := reviewAdjustment(ctx, tx, adjustment, model.AdjustmentApproved); err != nil {
+			return err
+		}
+
+		if adjustment.TargetType == model.AdjustmentTargetTreasury {
+			return applyTreasuryMovement(ctx, tx, &model.TreasuryMovement{
+				ID:        uuid.New().String(),
+				Type:      model.MovementAdjustment,
+				Amount:    adjustment.Amount,
+				Reason:    string(adjustment.ReasonCode),
+				Actor:     adjustment.ReviewedBy,
+				CreatedAt: *adjustment.ReviewedAt,
+			}, 0)
+		}
+
+		// A condição no UPDATE impede saldo negativo mesmo com jogadas
+		// concorrentes alterando o saldo.
+		var query string
+		switch adjustment.TargetType {
+		case model.AdjustmentTargetPlayer:
+			query = `UPDATE players SET balance = balance + $1 WHERE id = $2 AND balance + $1 >= 0`
+		case model.AdjustmentTargetMachine:
+			query = `UPDATE slot_machines SET balance = balance + $1 WHERE id = $2 AND balance + $1 >= 0`
+		}
+		result, err := tx.Exec(ctx, query, adjustment.Amount, adjustment.TargetID)
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected() == 0 {
+			return repository.ErrNegativeBalance
+		}
+
+		if adjustment.TargetType != model.AdjustmentTargetPlayer {
+			return nil
+		}
+		txType, amount := model.TransactionAdjustmentCredit, adjustment.Amount
+		if amount < 0 {
+			txType, amount = model.TransactionAdjustmentDebit, -amount
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO transactions (id, player_id, type, amount, machine_id, created_at)
+			VALUES ($1, $2, $3, $4, '', $5)`,
+			uuid.New().String(), adjustment.TargetID, txType, amount, adjustment.ReviewedAt)
+		return err
+	})
+}
+
+func (r *PostgresAdjustmentRepository) RejectAdjustment(ctx context.Context, adjustment *model.BalanceAdjustment) error {
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		return reviewAdjustment(ctx, tx, adjustment, model.AdjustmentRejected)
+	})
+}
+
+// reviewAdjustment muda o status apenas se o ajuste ainda estiver pendente,
+// garantindo que duas aprovações simultâneas não apliquem o ajuste duas vezes.
+func reviewAdjustment(ctx context.Context, tx pgx.Tx, adjustment *model.BalanceAdjustment, status model.AdjustmentStatus) error {
+	result, err := tx.Exec(ctx, `
+		UPDATE balance_adjustments
+		SET status = $1, reviewed_by = $2, reviewed_at = $3
+		WHERE id = $4 AND status = $5`,
+		status, adjustment.ReviewedBy, adjustment.ReviewedAt, adjustment.ID, model.AdjustmentPending)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM balance_adjustments WHERE id = $1)`, adjustment.ID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return repository.ErrAdjustmentNotFound
+		}
+		return repository.ErrAdjustmentNotPending
+	}
+	adjustment.Status = status
+	return nil
+}
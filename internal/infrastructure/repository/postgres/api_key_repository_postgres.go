@@ -0,0 +1,93 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const apiKeyColumns = `id, name, key_hash, scopes, created_by, parent_key_id, created_at, expires_at, revoked_at`
+
+type PostgresAPIKeyRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresAPIKeyRepository(pool *pgxpool.Pool) repository.APIKeyRepository {
+	return &PostgresAPIKeyRepository{pool: pool}
+}
+
+func scanAPIKey(row pgx.Row) (*model.APIKey, error) {
+	key := &model.APIKey{}
+	var scopes []string
+	err := row.Scan(&key.ID, &key.Name, &key.KeyHash, &scopes, &key.CreatedBy, &key.ParentKeyID, &key.CreatedAt, &key.ExpiresAt, &key.RevokedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, model.Scope(scope))
+	}
+	return key, nil
+}
+
+func (r *PostgresAPIKeyRepository) CreateAPIKey(ctx context.Context, key *model.APIKey) error {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO api_keys (`+apiKeyColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		key.ID, key.Name, key.KeyHash, scopes, key.CreatedBy, key.ParentKeyID, key.CreatedAt, key.ExpiresAt, key.RevokedAt)
+	return err
+}
+
+func (r *PostgresAPIKeyRepository) GetAPIKey(ctx context.Context, id string) (*model.APIKey, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		WHERE id = $1`, id)
+	return scanAPIKey(row)
+}
+
+func (r *PostgresAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*model.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (r *PostgresAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, $1)
+		WHERE id = $2`, revokedAt, id)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return repository.ErrAPIKeyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository_postgres
+
+import (
+	"context"
+	"fmt"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const auditColumns = `id, actor, api_key_id, action, target_type, target_id, before, after, request_id, ip, created_at`
+
+type PostgresAuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresAuditRepository(pool *pgxpool.Pool) repository.AuditRepository {
+	return &PostgresAuditRepository{pool: pool}
+}
+
+func (r *PostgresAuditRepository) AppendAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO audit_log (`+auditColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		entry.ID, entry.Actor, entry.APIKeyID, entry.Action, entry.TargetType, entry.TargetID,
+		nullableJSON(entry.Before), nullableJSON(entry.After), entry.RequestID, entry.IP, entry.CreatedAt)
+	return err
+}
+
+func (r *PostgresAuditRepository) ListAuditEntries(ctx context.Context, filter repository.AuditFilter) ([]*model.AuditEntry, error) {
+	var conditions []string
+	var args []any
+	addCondition := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		addCondition("action = $%d", filter.Action)
+	}
+	if filter.TargetType != "" {
+		addCondition("target_type = $%d", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		addCondition("target_id = $%d", filter.TargetID)
+	}
+	if !filter.From.IsZero() {
+		addCondition("created_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		addCondition("created_at < $%d", filter.To)
+	}
+
+	query := `SELECT ` + auditColumns + ` FROM audit_log`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY created_at DESC, id`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(` OFFSET $%d`, len(args))
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*model.AuditEntry
+	for rows.Next() {
+		entry := &model.AuditEntry{}
+		var before, after []byte
+		err := rows.Scan(&entry.ID, &entry.Actor, &entry.APIKeyID, &entry.Action, &entry.TargetType, &entry.TargetID,
+			&before, &after, &entry.RequestID, &entry.IP, &entry.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		entry.Before = before
+		entry.After = after
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func nullableJSON(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,50 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresFreeSpinRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresFreeSpinRepository(pool *pgxpool.Pool) repository.FreeSpinRepository {
+	return &PostgresFreeSpinRepository{pool: pool}
+}
+
+func (r *PostgresFreeSpinRepository) GetFreeSpins(ctx context.Context, playerID, machineID string) (*model.FreeSpins, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT player_id, machine_id, remaining, multiplier, bet, lines, awarded, total_won, updated_at
+		FROM player_free_spins
+		WHERE player_id = $1 AND machine_id = $2`, playerID, machineID)
+	freeSpins := &model.FreeSpins{}
+	err := row.Scan(&freeSpins.PlayerID, &freeSpins.MachineID, &freeSpins.Remaining, &freeSpins.Multiplier, &freeSpins.Bet, &freeSpins.Lines, &freeSpins.Awarded, &freeSpins.TotalWon, &freeSpins.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrFreeSpinsNotFound
+		}
+		return nil, err
+	}
+	return freeSpins, nil
+}
+
+func (r *PostgresFreeSpinRepository) SaveFreeSpins(ctx context.Context, freeSpins *model.FreeSpins) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO player_free_spins (player_id, machine_id, remaining, multiplier, bet, lines, awarded, total_won, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (player_id, machine_id) DO UPDATE
+		SET remaining = EXCLUDED.remaining,
+			multiplier = EXCLUDED.multiplier,
+			bet = EXCLUDED.bet,
+			lines = EXCLUDED.lines,
+			awarded = EXCLUDED.awarded,
+			total_won = EXCLUDED.total_won,
+			updated_at = EXCLUDED.updated_at`,
+		freeSpins.PlayerID, freeSpins.MachineID, freeSpins.Remaining, freeSpins.Multiplier, freeSpins.Bet, freeSpins.Lines, freeSpins.Awarded, freeSpins.TotalWon, freeSpins.UpdatedAt)
+	return err
+}
@@ -0,0 +1,77 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const gamblingLimitColumns = `player_id, type, period, amount, pending_amount, pending_effective_at, updated_at`
+
+type PostgresGamblingLimitRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresGamblingLimitRepository(pool *pgxpool.Pool) repository.GamblingLimitRepository {
+	return &PostgresGamblingLimitRepository{pool: pool}
+}
+
+func scanGamblingLimit(row pgx.Row) (*model.GamblingLimit, error) {
+	limit := &model.GamblingLimit{}
+	err := row.Scan(&limit.PlayerID, &limit.Type, &limit.Period, &limit.Amount,
+		&limit.PendingAmount, &limit.PendingEffectiveAt, &limit.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrGamblingLimitNotFound
+		}
+		return nil, err
+	}
+	return limit, nil
+}
+
+func (r *PostgresGamblingLimitRepository) GetGamblingLimit(ctx context.Context, playerID string, limitType model.LimitType, period model.LimitPeriod) (*model.GamblingLimit, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+gamblingLimitColumns+`
+		FROM gambling_limits
+		WHERE player_id = $1 AND type = $2 AND period = $3`, playerID, limitType, period)
+	return scanGamblingLimit(row)
+}
+
+func (r *PostgresGamblingLimitRepository) ListGamblingLimits(ctx context.Context, playerID string) ([]*model.GamblingLimit, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+gamblingLimitColumns+`
+		FROM gambling_limits
+		WHERE player_id = $1
+		ORDER BY type, period`, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var limits []*model.GamblingLimit
+	for rows.Next() {
+		limit, err := scanGamblingLimit(rows)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, limit)
+	}
+	return limits, rows.Err()
+}
+
+func (r *PostgresGamblingLimitRepository) SaveGamblingLimit(ctx context.Context, limit *model.GamblingLimit) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO gambling_limits (`+gamblingLimitColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (player_id, type, period) DO UPDATE
+		SET amount = EXCLUDED.amount,
+			pending_amount = EXCLUDED.pending_amount,
+			pending_effective_at = EXCLUDED.pending_effective_at,
+			updated_at = EXCLUDED.updated_at`,
+		limit.PlayerID, limit.Type, limit.Period, limit.Amount,
+		limit.PendingAmount, limit.PendingEffectiveAt, limit.UpdatedAt)
+	return err
+}
@@ -0,0 +1,259 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const jackpotPoolColumns = `id, name, seed_amount, contribution_percent, trigger_combination, trigger_odds, amount, total_seeded, total_contributed, total_paid, created_at`
+
+type PostgresJackpotRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresJackpotRepository(pool *pgxpool.Pool) repository.JackpotRepository {
+	return &PostgresJackpotRepository{pool: pool}
+}
+
+func scanJackpotPool(row pgx.Row) (*model.JackpotPool, error) {
+	pool := &model.JackpotPool{}
+	err := row.Scan(&pool.ID, &pool.Name, &pool.SeedAmount, &pool.ContributionPercent, &pool.TriggerCombination, &pool.TriggerOdds,
+		&pool.Amount, &pool.TotalSeeded, &pool.TotalContributed, &pool.TotalPaid, &pool.CreatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrJackpotPoolNotFound
+		}
+		return nil, err
+	}
+	if len(pool.TriggerCombination) == 0 {
+		pool.TriggerCombination = nil
+	}
+	return pool, nil
+}
+
+func (r *PostgresJackpotRepository) CreatePool(ctx context.Context, pool *model.JackpotPool, seed *model.TreasuryMovement) error {
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		if seed.Amount > 0 {
+			if err := applyTreasuryMovement(ctx, tx, seed, 0); err != nil {
+				return err
+			}
+		}
+		trigger := pool.TriggerCombination
+		if trigger == nil {
+			trigger = []string{}
+		}
+		_, err := tx.Exec(ctx, `
+			INSERT INTO jackpot_pools (`+jackpotPoolColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			pool.ID, pool.Name, pool.SeedAmount, pool.ContributionPercent, trigger, pool.TriggerOdds,
+			pool.Amount, pool.TotalSeeded, pool.TotalContributed, pool.TotalPaid, pool.CreatedAt)
+		return err
+	})
+}
+
+func (r *PostgresJackpotRepository) GetPool(ctx context.Context, id string) (*model.JackpotPool, error) {
+	pool, err := scanJackpotPool(conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+jackpotPoolColumns+`
+		FROM jackpot_pools
+		WHERE id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+	return pool, loadPoolMachines(ctx, conn(ctx, r.pool), pool)
+}
+
+func (r *PostgresJackpotRepository) ListPools(ctx context.Context) ([]*model.JackpotPool, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+jackpotPoolColumns+`
+		FROM jackpot_pools
+		ORDER BY created_at, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pools []*model.JackpotPool
+	for rows.Next() {
+		pool, err := scanJackpotPool(rows)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, pool := range pools {
+		if err := loadPoolMachines(ctx, conn(ctx, r.pool), pool); err != nil {
+			return nil, err
+		}
+	}
+	return pools, nil
+}
+
+func (r *PostgresJackpotRepository) GetMachinePool(ctx context.Context, machineID string) (*model.JackpotPool, error) {
+	pool, err := scanJackpotPool(conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+jackpotPoolColumns+`
+		FROM jackpot_pools
+		WHERE id = (SELECT pool_id FROM jackpot_pool_machines WHERE machine_id = $1)`, machineID))
+	if err != nil {
+		return nil, err
+	}
+	return pool, loadPoolMachines(ctx, conn(ctx, r.pool), pool)
+}
+
+func (r *PostgresJackpotRepository) LinkMachine(ctx context.Context, poolID, machineID string) error {
+	result, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO jackpot_pool_machines (machine_id, pool_id)
+		VALUES ($1, $2)
+		ON CONFLICT (machine_id) DO UPDATE SET pool_id = EXCLUDED.pool_id
+		WHERE jackpot_pool_machines.pool_id = EXCLUDED.pool_id`,
+		machineID, poolID)
+	if err != nil {
+		return err
+	}
+	// O DO UPDATE só vale para o mesmo pool; ligada a outro, nada muda.
+	if result.RowsAffected() == 0 {
+		return repository.ErrMachineAlreadyLinked
+	}
+	return nil
+}
+
+func (r *PostgresJackpotRepository) UnlinkMachine(ctx context.Context, poolID, machineID string) error {
+	result, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM jackpot_pool_machines
+		WHERE machine_id = $1 AND pool_id = $2`,
+		machineID, poolID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		if _, err := r.GetPool(ctx, poolID); err != nil {
+			return err
+		}
+		return repository.ErrMachineNotLinked
+	}
+	return nil
+}
+
+// Contribute trava a linha do pool no UPDATE, então contribuições e
+// pagamentos simultâneos no mesmo pool são aplicados um de cada vez.
+func (r *PostgresJackpotRepository) Contribute(ctx context.Context, poolID string, amount int, win *model.JackpotWin) (*model.JackpotPool, error) {
+	var pool *model.JackpotPool
+	err := pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		var err error
+		pool, err = scanJackpotPool(tx.QueryRow(ctx, `
+			UPDATE jackpot_pools
+			SET amount = amount + $1, total_contributed = total_contributed + $1
+			WHERE id = $2
+			RETURNING `+jackpotPoolColumns,
+			amount, poolID))
+		if err != nil {
+			return err
+		}
+		if win == nil {
+			return loadPoolMachines(ctx, tx, pool)
+		}
+
+		win.Amount = pool.Amount
+		var treasuryBalance int
+		err = tx.QueryRow(ctx, `SELECT balance FROM treasury WHERE id = $1 FOR UPDATE`, model.HouseTreasuryID).Scan(&treasuryBalance)
+		if err != nil {
+			return err
+		}
+		seed := min(pool.SeedAmount, treasuryBalance)
+		if seed > 0 {
+			err := applyTreasuryMovement(ctx, tx, &model.TreasuryMovement{
+				ID:            uuid.New().String(),
+				Type:          model.MovementJackpotSeed,
+				JackpotPoolID: pool.ID,
+				Amount:        seed,
+				Reason:        "jackpot reseed",
+				Actor:         "system",
+				CreatedAt:     win.CreatedAt,
+			}, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		pool, err = scanJackpotPool(tx.QueryRow(ctx, `
+			UPDATE jackpot_pools
+			SET amount = $1, total_seeded = total_seeded + $1, total_paid = total_paid + $2
+			WHERE id = $3
+			RETURNING `+jackpotPoolColumns,
+			seed, win.Amount, poolID))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO jackpot_wins (id, pool_id, player_id, machine_id, amount, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			win.ID, win.PoolID, win.PlayerID, win.MachineID, win.Amount, win.CreatedAt)
+		if err != nil {
+			return err
+		}
+		return loadPoolMachines(ctx, tx, pool)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (r *PostgresJackpotRepository) ListJackpotWins(ctx context.Context, limit int) ([]*model.JackpotWin, error) {
+	query := `
+		SELECT id, pool_id, player_id, machine_id, amount, created_at
+		FROM jackpot_wins
+		ORDER BY created_at DESC, id`
+	var args []any
+	if limit > 0 {
+		query += ` LIMIT $1`
+		args = append(args, limit)
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var wins []*model.JackpotWin
+	for rows.Next() {
+		win := &model.JackpotWin{}
+		if err := rows.Scan(&win.ID, &win.PoolID, &win.PlayerID, &win.MachineID, &win.Amount, &win.CreatedAt); err != nil {
+			return nil, err
+		}
+		wins = append(wins, win)
+	}
+	return wins, rows.Err()
+}
+
+func loadPoolMachines(ctx context.Context, q querier, pool *model.JackpotPool) error {
+	rows, err := q.Query(ctx, `
+		SELECT machine_id
+		FROM jackpot_pool_machines
+		WHERE pool_id = $1
+		ORDER BY machine_id`, pool.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	pool.MachineIDs = []string{}
+	for rows.Next() {
+		var machineID string
+		if err := rows.Scan(&machineID); err != nil {
+			return err
+		}
+		pool.MachineIDs = append(pool.MachineIDs, machineID)
+	}
+	return rows.Err()
+}
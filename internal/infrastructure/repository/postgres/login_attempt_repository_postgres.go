@@ -0,0 +1,73 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresLoginAttemptRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresLoginAttemptRepository(pool *pgxpool.Pool) repository.LoginAttemptRepository {
+	return &PostgresLoginAttemptRepository{pool: pool}
+}
+
+func (r *PostgresLoginAttemptRepository) GetLoginAttempt(ctx context.Context, key string) (*model.LoginAttempt, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT key, failures, last_failure_at, blocked_until, locked_until
+		FROM login_attempts
+		WHERE key = $1`, key)
+	attempt := &model.LoginAttempt{}
+	err := row.Scan(&attempt.Key, &attempt.Failures, &attempt.LastFailureAt, &attempt.BlockedUntil, &attempt.LockedUntil)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrLoginAttemptNotFound
+		}
+		return nil, err
+	}
+	return attempt, nil
+}
+
+func (r *PostgresLoginAttemptRepository) RecordLoginFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*model.LoginAttempt, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO login_attempts (key, failures, last_failure_at, blocked_until, locked_until)
+		VALUES ($1, 1, $2, $4, $4)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+				WHEN login_attempts.last_failure_at < $3 THEN 1
+				ELSE login_attempts.failures + 1
+			END,
+			last_failure_at = EXCLUDED.last_failure_at
+		RETURNING key, failures, last_failure_at, blocked_until, locked_until`,
+		key, now, now.Add(-window), time.Time{})
+	attempt := &model.LoginAttempt{}
+	err := row.Scan(&attempt.Key, &attempt.Failures, &attempt.LastFailureAt, &attempt.BlockedUntil, &attempt.LockedUntil)
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+func (r *PostgresLoginAttemptRepository) BlockLoginAttempt(ctx context.Context, key string, blockedUntil, lockedUntil time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE login_attempts
+		SET failures = CASE WHEN $3 > locked_until THEN 0 ELSE failures END,
+			blocked_until = GREATEST(blocked_until, $2),
+			locked_until = GREATEST(locked_until, $3)
+		WHERE key = $1`,
+		key, blockedUntil, lockedUntil)
+	return err
+}
+
+func (r *PostgresLoginAttemptRepository) DeleteLoginAttempt(ctx context.Context, key string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM login_attempts
+		WHERE key = $1`, key)
+	return err
+}
@@ -0,0 +1,81 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const outboxColumns = `id, type, aggregate_type, aggregate_id, payload, occurred_at, published_at, attempts, last_error, delivered_to, dead_at`
+
+type PostgresOutboxRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresOutboxRepository(pool *pgxpool.Pool) repository.OutboxRepository {
+	return &PostgresOutboxRepository{pool: pool}
+}
+
+func (r *PostgresOutboxRepository) AddEvent(ctx context.Context, event *model.DomainEvent) error {
+	deliveredTo := event.DeliveredTo
+	if deliveredTo == nil {
+		deliveredTo = []string{}
+	}
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO outbox_events (`+outboxColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		event.ID, event.Type, event.AggregateType, event.AggregateID, nullableJSON(event.Payload),
+		event.OccurredAt, event.PublishedAt, event.Attempts, event.LastError, deliveredTo, event.DeadAt)
+	return err
+}
+
+func (r *PostgresOutboxRepository) ListPendingEvents(ctx context.Context, limit int) ([]*model.DomainEvent, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+outboxColumns+`
+		FROM outbox_events
+		WHERE published_at IS NULL AND dead_at IS NULL
+		ORDER BY seq
+		LIMIT $1`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*model.DomainEvent
+	for rows.Next() {
+		event := &model.DomainEvent{}
+		if err := rows.Scan(&event.ID, &event.Type, &event.AggregateType, &event.AggregateID, &event.Payload,
+			&event.OccurredAt, &event.PublishedAt, &event.Attempts, &event.LastError, &event.DeliveredTo, &event.DeadAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func (r *PostgresOutboxRepository) MarkEventDelivered(ctx context.Context, id, publisher string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE outbox_events
+		SET delivered_to = array_append(delivered_to, $1)
+		WHERE id = $2 AND NOT ($1 = ANY(delivered_to))`, publisher, id)
+	return err
+}
+
+func (r *PostgresOutboxRepository) MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE outbox_events
+		SET published_at = $1, attempts = attempts + 1, last_error = ''
+		WHERE id = $2`, publishedAt, id)
+	return err
+}
+
+func (r *PostgresOutboxRepository) MarkEventFailed(ctx context.Context, id string, lastError string, deadAt *time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, last_error = $1, dead_at = $2
+		WHERE id = $3`, lastError, deadAt, id)
+	return err
+}
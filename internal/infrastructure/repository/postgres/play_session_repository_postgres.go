@@ -0,0 +1,67 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresPlaySessionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresPlaySessionRepository(pool *pgxpool.Pool) repository.PlaySessionRepository {
+	return &PostgresPlaySessionRepository{pool: pool}
+}
+
+func (r *PostgresPlaySessionRepository) GetLatestPlaySession(ctx context.Context, playerID string) (*model.PlaySession, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, player_id, started_at, last_activity_at, spins, wagered, won,
+			last_reality_check_at, reality_check_pending
+		FROM play_sessions
+		WHERE player_id = $1
+		ORDER BY started_at DESC
+		LIMIT 1`, playerID)
+	session := &model.PlaySession{}
+	var lastRealityCheckAt *time.Time
+	err := row.Scan(&session.ID, &session.PlayerID, &session.StartedAt, &session.LastActivityAt,
+		&session.Spins, &session.Wagered, &session.Won, &lastRealityCheckAt, &session.RealityCheckPending)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrPlaySessionNotFound
+		}
+		return nil, err
+	}
+	if lastRealityCheckAt != nil {
+		session.LastRealityCheckAt = *lastRealityCheckAt
+	}
+	return session, nil
+}
+
+func (r *PostgresPlaySessionRepository) SavePlaySession(ctx context.Context, session *model.PlaySession) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO play_sessions (id, player_id, started_at, last_activity_at, spins, wagered, won,
+			last_reality_check_at, reality_check_pending)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE
+		SET last_activity_at = EXCLUDED.last_activity_at,
+			spins = EXCLUDED.spins,
+			wagered = EXCLUDED.wagered,
+			won = EXCLUDED.won,
+			last_reality_check_at = EXCLUDED.last_reality_check_at,
+			reality_check_pending = EXCLUDED.reality_check_pending`,
+		session.ID, session.PlayerID, session.StartedAt, session.LastActivityAt, session.Spins, session.Wagered, session.Won,
+		nullableTime(session.LastRealityCheckAt), session.RealityCheckPending)
+	return err
+}
+
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
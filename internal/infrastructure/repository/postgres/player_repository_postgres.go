@@ -2,6 +2,7 @@ package repository_postgres
 
 import (
 	"context"
+	"fmt"
 	"slot-machine/internal/domain/model"
 	"slot-machine/internal/domain/repository"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const playerColumns = `id, balance, email, email_verified, password, role, totp_enabled, totp_secret, totp_last_counter, reality_check_minutes, created_at, blocked, blocked_reason`
+
 type PostgresPlayerRepository struct {
 	pool *pgxpool.Pool
 }
@@ -19,21 +22,13 @@ func NewPostgresPlayerRepository(pool *pgxpool.Pool) repository.PlayerRepository
 	}
 }
 
-func (r *PostgresPlayerRepository) CreatePlayer(ctx context.Context, player *model.Player) error {
-	_, err := r.pool.Exec(ctx, `
-		INSERT INTO players (id, balance, email, password, role)
-		VALUES ($1, $2, $3, $4, $5)`,
-		player.ID, player.Balance, player.Email, player.Password, player.Role)
-	return err
-}
-
-func (r *PostgresPlayerRepository) GetPlayer(ctx context.Context, id string) (*model.Player, error) {
-	row := r.pool.QueryRow(ctx, `
-		SELECT id, balance, email, password, role
-		FROM players
-		WHERE id = $1`, id)
+func scanPlayer(row pgx.Row) (*model.Player, error) {
 	player := &model.Player{}
-	err := row.Scan(&player.ID, &player.Balance, &player.Email, &player.Password, &player.Role)
+	err := row.Scan(
+		&player.ID, &player.Balance, &player.Email, &player.EmailVerified, &player.Password, &player.Role,
+		&player.TOTPEnabled, &player.TOTPSecret, &player.TOTPLastCounter, &player.RealityCheckMinutes,
+		&player.CreatedAt, &player.Blocked, &player.BlockedReason,
+	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, repository.ErrPlayerNotFound
@@ -43,34 +38,134 @@ func (r *PostgresPlayerRepository) GetPlayer(ctx context.Context, id string) (*m
 	return player, nil
 }
 
+func (r *PostgresPlayerRepository) CreatePlayer(ctx context.Context, player *model.Player) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO players (`+playerColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		player.ID, player.Balance, player.Email, player.EmailVerified, player.Password, player.Role,
+		player.TOTPEnabled, player.TOTPSecret, player.TOTPLastCounter, player.RealityCheckMinutes,
+		player.CreatedAt, player.Blocked, player.BlockedReason)
+	return err
+}
+
+func (r *PostgresPlayerRepository) GetPlayer(ctx context.Context, id string) (*model.Player, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+playerColumns+`
+		FROM players
+		WHERE id = $1`, id)
+	return scanPlayer(row)
+}
+
 func (r *PostgresPlayerRepository) GetPlayerByEmail(ctx context.Context, email string) (*model.Player, error) {
-	row := r.pool.QueryRow(ctx, `
-		SELECT id, balance, email, password, role
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+playerColumns+`
 		FROM players
-		WHERE email = $1`, email)
-	player := &model.Player{}
-	err := row.Scan(&player.ID, &player.Balance, &player.Email, &player.Password, &player.Role)
-	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, repository.ErrPlayerNotFound
-		}
-		return nil, err
-	}
-	return player, nil
+		WHERE LOWER(email) = LOWER($1)`, email)
+	return scanPlayer(row)
 }
 
 func (r *PostgresPlayerRepository) UpdatePlayer(ctx context.Context, player *model.Player) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE players
+		SET balance = $1, email = $2, email_verified = $3, password = $4, role = $5,
+			totp_enabled = $6, totp_secret = $7, totp_last_counter = $8, reality_check_minutes = $9,
+			blocked = $10, blocked_reason = $11
+		WHERE id = $12`,
+		player.Balance, player.Email, player.EmailVerified, player.Password, player.Role,
+		player.TOTPEnabled, player.TOTPSecret, player.TOTPLastCounter, player.RealityCheckMinutes,
+		player.Blocked, player.BlockedReason, player.ID)
+	return err
+}
+
+func (r *PostgresPlayerRepository) AdjustBalance(ctx context.Context, id string, delta int) (int, error) {
+	var balance int
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		UPDATE players
-		SET balance = $1, email = $2, password = $3, role = $4
-		WHERE id = $5`,
-		player.Balance, player.Email, player.Password, player.Role, player.ID)
+		SET balance = balance + $1
+		WHERE id = $2 AND balance + $1 >= 0
+		RETURNING balance`,
+		delta, id).Scan(&balance)
+	if err == pgx.ErrNoRows {
+		if _, err := r.GetPlayer(ctx, id); err != nil {
+			return 0, err
+		}
+		return 0, repository.ErrNegativeBalance
+	}
+	if err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
+
+func (r *PostgresPlayerRepository) LockPlayer(ctx context.Context, id string) error {
+	var locked string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id
+		FROM players
+		WHERE id = $1
+		FOR UPDATE`, id).Scan(&locked)
+	if err == pgx.ErrNoRows {
+		return repository.ErrPlayerNotFound
+	}
 	return err
 }
 
+func (r *PostgresPlayerRepository) ConsumeTOTPCounter(ctx context.Context, id string, counter int64) (bool, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE players
+		SET totp_last_counter = $1
+		WHERE id = $2 AND totp_last_counter < $1`,
+		counter, id)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *PostgresPlayerRepository) SetTOTPSecret(ctx context.Context, id, secret string) error {
+	return r.updatePlayerColumns(ctx, id, `totp_secret = $2, totp_enabled = FALSE, totp_last_counter = 0`, secret)
+}
+
+func (r *PostgresPlayerRepository) EnableTOTP(ctx context.Context, id string) error {
+	return r.updatePlayerColumns(ctx, id, `totp_enabled = TRUE`)
+}
+
+func (r *PostgresPlayerRepository) UpdatePassword(ctx context.Context, id, passwordHash string) error {
+	return r.updatePlayerColumns(ctx, id, `password = $2`, passwordHash)
+}
+
+func (r *PostgresPlayerRepository) MarkEmailVerified(ctx context.Context, id string) error {
+	return r.updatePlayerColumns(ctx, id, `email_verified = TRUE`)
+}
+
+func (r *PostgresPlayerRepository) SetRealityCheckMinutes(ctx context.Context, id string, minutes int) error {
+	return r.updatePlayerColumns(ctx, id, `reality_check_minutes = $2`, minutes)
+}
+
+func (r *PostgresPlayerRepository) SetBlocked(ctx context.Context, id string, blocked bool, reason string) error {
+	return r.updatePlayerColumns(ctx, id, `blocked = $2, blocked_reason = $3`, blocked, reason)
+}
+
+// updatePlayerColumns altera só as colunas de set, cujos parâmetros começam
+// em $2, para não sobrescrever o que outras requisições gravaram no jogador.
+func (r *PostgresPlayerRepository) updatePlayerColumns(ctx context.Context, id, set string, args ...any) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE players
+		SET `+set+`
+		WHERE id = $1`,
+		append([]any{id}, args...)...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrPlayerNotFound
+	}
+	return nil
+}
+
 func (r *PostgresPlayerRepository) ListPlayers(ctx context.Context) ([]*model.Player, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT id, balance, email, password, role
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+playerColumns+`
 		FROM players`)
 	if err != nil {
 		return nil, err
@@ -79,8 +174,7 @@ func (r *PostgresPlayerRepository) ListPlayers(ctx context.Context) ([]*model.Pl
 
 	var players []*model.Player
 	for rows.Next() {
-		player := &model.Player{}
-		err := rows.Scan(&player.ID, &player.Balance, &player.Email, &player.Password, &player.Role)
+		player, err := scanPlayer(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -88,3 +182,54 @@ func (r *PostgresPlayerRepository) ListPlayers(ctx context.Context) ([]*model.Pl
 	}
 	return players, nil
 }
+
+func (r *PostgresPlayerRepository) SearchPlayers(ctx context.Context, filter repository.PlayerFilter) ([]*model.Player, int, error) {
+	where := ``
+	var args []any
+	if filter.Email != "" {
+		args = append(args, filter.Email)
+		where = ` WHERE email ILIKE '%' || $1 || '%'`
+	}
+
+	var total int
+	if err := conn(ctx, r.pool).QueryRow(ctx, `SELECT COUNT(*) FROM players`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// A coluna de ordenação vem de uma lista fechada, nunca do cliente.
+	sortColumn := "created_at"
+	if filter.SortBy == repository.PlayerSortBalance {
+		sortColumn = "balance"
+	}
+	direction := "ASC"
+	if filter.Descending {
+		direction = "DESC"
+	}
+
+	query := `SELECT ` + playerColumns + ` FROM players` + where +
+		fmt.Sprintf(` ORDER BY %s %s, id`, sortColumn, direction)
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(` OFFSET $%d`, len(args))
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var players []*model.Player
+	for rows.Next() {
+		player, err := scanPlayer(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		players = append(players, player)
+	}
+	return players, total, rows.Err()
+}
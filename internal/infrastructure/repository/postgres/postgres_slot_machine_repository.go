@@ -2,6 +2,7 @@ package repository_postgres
 
 import (
 	"context"
+	"encoding/json"
 	"slot-machine/internal/domain/model"
 	"slot-machine/internal/domain/repository"
 
@@ -27,15 +28,24 @@ func (r *PostgresSlotMachineRepository) GetSlotMachine(ctx context.Context, id s
 		initialBalance int
 		multipleGain   int
 		description    string
+		reels          int
+		gridRows       int
+		paylines       []byte
+		paytable       []byte
+		strips         []byte
+		alignedWeight  int
+		bonus          []byte
+		jurisdiction   string
+		nearMiss       string
 	)
 
-	row := r.pool.QueryRow(ctx, `
-		SELECT id, level, balance, initial_balance, multiple_gain, description
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, level, balance, initial_balance, multiple_gain, description, reels, grid_rows, paylines, paytable, reel_strips, aligned_weight, bonus, jurisdiction, near_miss
 		FROM slot_machines
 		WHERE id = $1
 	`, id)
 
-	err := row.Scan(&slotID, &level, &balance, &initialBalance, &multipleGain, &description)
+	err := row.Scan(&slotID, &level, &balance, &initialBalance, &multipleGain, &description, &reels, &gridRows, &paylines, &paytable, &strips, &alignedWeight, &bonus, &jurisdiction, &nearMiss)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, repository.ErrSlotMachineNotFound
@@ -50,16 +60,22 @@ func (r *PostgresSlotMachineRepository) GetSlotMachine(ctx context.Context, id s
 		multipleGain,
 		description,
 	)
+	sm.InitialBalance = initialBalance
+	sm.Jurisdiction = jurisdiction
+	sm.NearMiss = model.NearMissMode(nearMiss)
+	if err := decodeMachineConfig(sm, reels, gridRows, paylines, paytable, strips, alignedWeight, bonus); err != nil {
+		return nil, err
+	}
 
 	return sm, nil
 }
 
 func (r *PostgresSlotMachineRepository) UpdateSlotMachine(ctx context.Context, machine *model.SlotMachine) error {
-	commandTag, err := r.pool.Exec(ctx, `
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE slot_machines
-		SET level = $1, balance = $2, initial_balance = $3, multiple_gain = $4, description = $5
-		WHERE id = $6
-	`, machine.Level, machine.Balance, machine.InitialBalance, machine.MultipleGain, machine.Description, machine.ID)
+		SET level = $1, balance = $2, multiple_gain = $3, description = $4
+		WHERE id = $5
+	`, machine.Level, machine.Balance, machine.MultipleGain, machine.Description, machine.ID)
 	if err != nil {
 		return err
 	}
@@ -69,11 +85,50 @@ func (r *PostgresSlotMachineRepository) UpdateSlotMachine(ctx context.Context, m
 	return nil
 }
 
+func (r *PostgresSlotMachineRepository) AdjustSlotMachineBalance(ctx context.Context, id string, delta int) (int, error) {
+	var balance int
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE slot_machines
+		SET balance = balance + $1
+		WHERE id = $2
+		RETURNING balance
+	`, delta, id).Scan(&balance)
+	if err == pgx.ErrNoRows {
+		return 0, repository.ErrSlotMachineNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
+
 func (r *PostgresSlotMachineRepository) CreateSlotMachine(ctx context.Context, machine *model.SlotMachine) error {
-	_, err := r.pool.Exec(ctx, `
-		INSERT INTO slot_machines (id, level, balance, initial_balance, multiple_gain, description)
-		VALUES ($1, $2, $3, $4, $5, $6)
-	`, machine.ID, machine.Level, machine.Balance, machine.InitialBalance, machine.MultipleGain, machine.Description)
+	paylines, err := json.Marshal(machine.Paylines)
+	if err != nil {
+		return err
+	}
+	strips, err := json.Marshal(machine.Strips)
+	if err != nil {
+		return err
+	}
+	var paytable, bonus []byte
+	if len(machine.Paytable) > 0 {
+		if paytable, err = json.Marshal(machine.Paytable); err != nil {
+			return err
+		}
+	}
+	if machine.Bonus != nil {
+		if bonus, err = json.Marshal(machine.Bonus); err != nil {
+			return err
+		}
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO slot_machines (id, level, balance, initial_balance, multiple_gain, description, reels, grid_rows, paylines, paytable, reel_strips, aligned_weight, bonus, jurisdiction, near_miss)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`, machine.ID, machine.Level, machine.Balance, machine.InitialBalance, machine.MultipleGain, machine.Description,
+		machine.ReelCount(), machine.RowCount(), nullableJSON(paylines), nullableJSON(paytable), nullableJSON(strips), machine.AlignedWeight, nullableJSON(bonus),
+		machine.Jurisdiction, string(machine.NearMiss))
 	if err != nil {
 		if err.Error() == "duplicate key value violates unique constraint" {
 			return repository.ErrSlotMachineExists
@@ -82,3 +137,86 @@ func (r *PostgresSlotMachineRepository) CreateSlotMachine(ctx context.Context, m
 	}
 	return nil
 }
+
+func (r *PostgresSlotMachineRepository) ListSlotMachines(ctx context.Context) ([]*model.SlotMachine, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, level, balance, initial_balance, multiple_gain, description, reels, grid_rows, paylines, paytable, reel_strips, aligned_weight, bonus, jurisdiction, near_miss
+		FROM slot_machines
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var machines []*model.SlotMachine
+	for rows.Next() {
+		var (
+			slotID         string
+			level          int
+			balance        int
+			initialBalance int
+			multipleGain   int
+			description    string
+			reels          int
+			gridRows       int
+			paylines       []byte
+			paytable       []byte
+			strips         []byte
+			alignedWeight  int
+			bonus          []byte
+			jurisdiction   string
+			nearMiss       string
+		)
+		if err := rows.Scan(&slotID, &level, &balance, &initialBalance, &multipleGain, &description, &reels, &gridRows, &paylines, &paytable, &strips, &alignedWeight, &bonus, &jurisdiction, &nearMiss); err != nil {
+			return nil, err
+		}
+		sm := model.NewSlotMachine(slotID, level, balance, multipleGain, description)
+		sm.InitialBalance = initialBalance
+		sm.Jurisdiction = jurisdiction
+		sm.NearMiss = model.NearMissMode(nearMiss)
+		if err := decodeMachineConfig(sm, reels, gridRows, paylines, paytable, strips, alignedWeight, bonus); err != nil {
+			return nil, err
+		}
+		machines = append(machines, sm)
+	}
+	return machines, rows.Err()
+}
+
+// decodeMachineConfig aplica a grade, as fitas e o bônus gravados. Colunas
+// JSON nulas indicam os padrões da grade, as fitas equivalentes ao Level e
+// máquina sem rodadas grátis.
+func decodeMachineConfig(sm *model.SlotMachine, reels, rows int, paylinesJSON, paytableJSON, stripsJSON []byte, alignedWeight int, bonusJSON []byte) error {
+	var (
+		paylines []model.Payline
+		paytable []model.LinePay
+	)
+	if len(paylinesJSON) > 0 {
+		if err := json.Unmarshal(paylinesJSON, &paylines); err != nil {
+			return err
+		}
+	}
+	if len(paytableJSON) > 0 {
+		if err := json.Unmarshal(paytableJSON, &paytable); err != nil {
+			return err
+		}
+	}
+	if reels != sm.Reels || rows != sm.Rows || len(paylines) > 0 || len(paytable) > 0 {
+		sm.ConfigureGrid(reels, rows, paylines, paytable)
+	}
+	if len(stripsJSON) > 0 {
+		sm.Strips = nil
+		if err := json.Unmarshal(stripsJSON, &sm.Strips); err != nil {
+			return err
+		}
+		sm.AlignedWeight = alignedWeight
+	}
+
+	if len(bonusJSON) > 0 {
+		sm.Bonus = &model.BonusFeature{}
+		if err := json.Unmarshal(bonusJSON, sm.Bonus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
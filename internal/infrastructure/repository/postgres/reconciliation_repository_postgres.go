@@ -0,0 +1,157 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresReconciliationRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresReconciliationRepository(pool *pgxpool.Pool) repository.ReconciliationRepository {
+	return &PostgresReconciliationRepository{pool: pool}
+}
+
+// LoadLedgerSnapshot faz todas as leituras em uma transação REPEATABLE READ,
+// que enxerga o mesmo instante do banco em todas as consultas.
+func (r *PostgresReconciliationRepository) LoadLedgerSnapshot(ctx context.Context) (*model.LedgerSnapshot, error) {
+	snapshot := &model.LedgerSnapshot{
+		MachineAdjustments: make(map[string]int),
+		JackpotWins:        make(map[string]int),
+	}
+	options := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+
+	err := pgx.BeginTxFunc(ctx, r.pool, options, func(tx pgx.Tx) error {
+		var err error
+		snapshot.Players, err = queryAccountBalances(ctx, tx, `SELECT id, balance, 0 FROM players`)
+		if err != nil {
+			return err
+		}
+		snapshot.Machines, err = queryAccountBalances(ctx, tx, `SELECT id, balance, initial_balance FROM slot_machines`)
+		if err != nil {
+			return err
+		}
+		snapshot.JackpotPools, err = queryAccountBalances(ctx, tx, `SELECT id, amount, 0 FROM jackpot_pools`)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, `SELECT balance FROM treasury WHERE id = $1`, model.HouseTreasuryID).Scan(&snapshot.TreasuryBalance)
+		if err != nil {
+			return err
+		}
+
+		snapshot.Transactions, err = queryTransactionTotals(ctx, tx)
+		if err != nil {
+			return err
+		}
+		snapshot.TreasuryMovements, err = queryTreasuryMovementTotals(ctx, tx)
+		if err != nil {
+			return err
+		}
+		snapshot.JackpotContributions, err = queryJackpotContributionTotals(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if err := sumByKey(ctx, tx, snapshot.JackpotWins, `
+			SELECT pool_id, SUM(amount)
+			FROM jackpot_wins
+			GROUP BY pool_id`); err != nil {
+			return err
+		}
+
+		return sumByKey(ctx, tx, snapshot.MachineAdjustments, `
+			SELECT target_id, SUM(amount)
+			FROM balance_adjustments
+			WHERE status = $1 AND target_type = $2
+			GROUP BY target_id`,
+			model.AdjustmentApproved, model.AdjustmentTargetMachine)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// sumByKey lê pares (chave, soma) para o mapa informado.
+func sumByKey(ctx context.Context, tx pgx.Tx, sums map[string]int, query string, args ...any) error {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		var amount int
+		if err := rows.Scan(&key, &amount); err != nil {
+			return err
+		}
+		sums[key] = amount
+	}
+	return rows.Err()
+}
+
+func queryAccountBalances(ctx context.Context, tx pgx.Tx, query string) ([]model.AccountBalance, error) {
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []model.AccountBalance
+	for rows.Next() {
+		var account model.AccountBalance
+		if err := rows.Scan(&account.ID, &account.Balance, &account.InitialBalance); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, rows.Err()
+}
+
+func queryTransactionTotals(ctx context.Context, tx pgx.Tx) ([]model.TransactionTotal, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT player_id, machine_id, type, SUM(amount)
+		FROM transactions
+		GROUP BY player_id, machine_id, type`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []model.TransactionTotal
+	for rows.Next() {
+		var total model.TransactionTotal
+		if err := rows.Scan(&total.PlayerID, &total.MachineID, &total.Type, &total.Amount); err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+	return totals, rows.Err()
+}
+
+func queryTreasuryMovementTotals(ctx context.Context, tx pgx.Tx) ([]model.TreasuryMovementTotal, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT machine_id, jackpot_pool_id, type, SUM(amount)
+		FROM treasury_movements
+		GROUP BY machine_id, jackpot_pool_id, type`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []model.TreasuryMovementTotal
+	for rows.Next() {
+		var total model.TreasuryMovementTotal
+		if err := rows.Scan(&total.MachineID, &total.JackpotPoolID, &total.Type, &total.Amount); err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+	return totals, rows.Err()
+}
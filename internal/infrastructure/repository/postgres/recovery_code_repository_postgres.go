@@ -0,0 +1,51 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresRecoveryCodeRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresRecoveryCodeRepository(pool *pgxpool.Pool) repository.RecoveryCodeRepository {
+	return &PostgresRecoveryCodeRepository{pool: pool}
+}
+
+func (r *PostgresRecoveryCodeRepository) ReplaceRecoveryCodes(ctx context.Context, playerID string, codeHashes []string) error {
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM recovery_codes WHERE player_id = $1`, playerID); err != nil {
+			return err
+		}
+
+		for _, hash := range codeHashes {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO recovery_codes (player_id, code_hash)
+				VALUES ($1, $2)`, playerID, hash)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *PostgresRecoveryCodeRepository) ConsumeRecoveryCode(ctx context.Context, playerID, codeHash string) (bool, error) {
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE recovery_codes
+		SET used_at = NOW()
+		WHERE player_id = $1 AND code_hash = $2 AND used_at IS NULL`, playerID, codeHash)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() == 1, nil
+}
+
+func (r *PostgresRecoveryCodeRepository) DeleteRecoveryCodes(ctx context.Context, playerID string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM recovery_codes WHERE player_id = $1`, playerID)
+	return err
+}
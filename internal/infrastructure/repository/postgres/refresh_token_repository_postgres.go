@@ -18,7 +18,7 @@ func NewPostgresRefreshTokenRepository(pool *pgxpool.Pool) repository.RefreshTok
 }
 
 func (r *PostgresRefreshTokenRepository) StoreRefreshToken(ctx context.Context, userID string, token string) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		INSERT INTO refresh_tokens (user_id, token)
 		VALUES ($1, $2)
 		ON CONFLICT DO NOTHING
@@ -27,7 +27,7 @@ func (r *PostgresRefreshTokenRepository) StoreRefreshToken(ctx context.Context,
 }
 
 func (r *PostgresRefreshTokenRepository) DeleteRefreshToken(ctx context.Context, userID string, token string) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		DELETE FROM refresh_tokens
 		WHERE user_id = $1 AND token = $2
 	`, userID, token)
@@ -35,7 +35,7 @@ func (r *PostgresRefreshTokenRepository) DeleteRefreshToken(ctx context.Context,
 }
 
 func (r *PostgresRefreshTokenRepository) ValidateRefreshToken(ctx context.Context, userID string, token string) (bool, error) {
-	row := r.pool.QueryRow(ctx, `
+	row := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT token
 		FROM refresh_tokens
 		WHERE user_id = $1 AND token = $2
@@ -51,3 +51,11 @@ func (r *PostgresRefreshTokenRepository) ValidateRefreshToken(ctx context.Contex
 	}
 	return true, nil
 }
+
+func (r *PostgresRefreshTokenRepository) RevokeAllRefreshTokens(ctx context.Context, userID string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM refresh_tokens
+		WHERE user_id = $1
+	`, userID)
+	return err
+}
@@ -0,0 +1,48 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresSelfExclusionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresSelfExclusionRepository(pool *pgxpool.Pool) repository.SelfExclusionRepository {
+	return &PostgresSelfExclusionRepository{pool: pool}
+}
+
+func (r *PostgresSelfExclusionRepository) GetSelfExclusion(ctx context.Context, playerID string) (*model.SelfExclusion, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT player_id, type, started_at, ends_at, lifted_at, lifted_by
+		FROM self_exclusions
+		WHERE player_id = $1`, playerID)
+	exclusion := &model.SelfExclusion{}
+	err := row.Scan(&exclusion.PlayerID, &exclusion.Type, &exclusion.StartedAt, &exclusion.EndsAt, &exclusion.LiftedAt, &exclusion.LiftedBy)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrSelfExclusionNotFound
+		}
+		return nil, err
+	}
+	return exclusion, nil
+}
+
+func (r *PostgresSelfExclusionRepository) SaveSelfExclusion(ctx context.Context, exclusion *model.SelfExclusion) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO self_exclusions (player_id, type, started_at, ends_at, lifted_at, lifted_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (player_id) DO UPDATE
+		SET type = EXCLUDED.type,
+			started_at = EXCLUDED.started_at,
+			ends_at = EXCLUDED.ends_at,
+			lifted_at = EXCLUDED.lifted_at,
+			lifted_by = EXCLUDED.lifted_by`,
+		exclusion.PlayerID, exclusion.Type, exclusion.StartedAt, exclusion.EndsAt, exclusion.LiftedAt, exclusion.LiftedBy)
+	return err
+}
@@ -0,0 +1,143 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresSpinRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresSpinRepository(pool *pgxpool.Pool) repository.SpinRepository {
+	return &PostgresSpinRepository{pool: pool}
+}
+
+func (r *PostgresSpinRepository) RecordSpin(ctx context.Context, spin *model.Spin) error {
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO spins (id, player_id, machine_id, bet, payout, jackpot_pool_id, jackpot_contribution, jackpot_win, result, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			spin.ID, spin.PlayerID, spin.MachineID, spin.Bet, spin.Payout, spin.JackpotPoolID, spin.JackpotContribution, spin.JackpotWin,
+			spin.Result, spin.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO player_spin_stats (player_id, machine_id, day, spins, total_wagered, total_won, biggest_win)
+			VALUES ($1, $2, $3, 1, $4, $5, $5)
+			ON CONFLICT (player_id, machine_id, day) DO UPDATE SET
+				spins = player_spin_stats.spins + 1,
+				total_wagered = player_spin_stats.total_wagered + EXCLUDED.total_wagered,
+				total_won = player_spin_stats.total_won + EXCLUDED.total_won,
+				biggest_win = GREATEST(player_spin_stats.biggest_win, EXCLUDED.biggest_win)`,
+			spin.PlayerID, spin.MachineID, model.GranularityDay.Truncate(spin.CreatedAt), spin.Bet, spin.TotalWon())
+		return err
+	})
+}
+
+func (r *PostgresSpinRepository) ListPlayerSpinStats(ctx context.Context, playerID string, from time.Time) ([]model.PlayerSpinStats, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT player_id, machine_id, day, spins, total_wagered, total_won, biggest_win
+		FROM player_spin_stats
+		WHERE player_id = $1 AND day >= $2
+		ORDER BY day, machine_id`,
+		playerID, model.GranularityDay.Truncate(from))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []model.PlayerSpinStats
+	for rows.Next() {
+		var row model.PlayerSpinStats
+		err := rows.Scan(&row.PlayerID, &row.MachineID, &row.Day, &row.Spins, &row.TotalWagered, &row.TotalWon, &row.BiggestWin)
+		if err != nil {
+			return nil, err
+		}
+		row.Day = row.Day.UTC()
+		stats = append(stats, row)
+	}
+	return stats, rows.Err()
+}
+
+// spinAggregateColumns é compartilhado pelo total e pela série, para que os
+// dois sejam calculados da mesma forma.
+const spinAggregateColumns = `
+	COUNT(*),
+	COUNT(*) FILTER (WHERE payout > 0),
+	COALESCE(SUM(bet), 0),
+	COALESCE(SUM(payout), 0),
+	COUNT(DISTINCT player_id),
+	COALESCE(MAX(payout), 0)`
+
+func (r *PostgresSpinRepository) AggregateMachineSpins(ctx context.Context, machineID string, from, to time.Time, granularity model.StatsGranularity) (model.SpinAggregate, []model.SpinAggregate, error) {
+	var total model.SpinAggregate
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+spinAggregateColumns+`
+		FROM spins
+		WHERE machine_id = $1 AND created_at >= $2 AND created_at < $3`,
+		machineID, from, to).Scan(&total.Spins, &total.Wins, &total.TotalWagered, &total.TotalPaid, &total.UniquePlayers, &total.LargestWin)
+	if err != nil {
+		return model.SpinAggregate{}, nil, err
+	}
+
+	// granularity é validada pelo caso de uso e só pode ser hour ou day.
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT date_trunc($4, created_at AT TIME ZONE 'UTC') AS bucket, `+spinAggregateColumns+`
+		FROM spins
+		WHERE machine_id = $1 AND created_at >= $2 AND created_at < $3
+		GROUP BY bucket
+		ORDER BY bucket`,
+		machineID, from, to, string(granularity))
+	if err != nil {
+		return model.SpinAggregate{}, nil, err
+	}
+	defer rows.Close()
+
+	var series []model.SpinAggregate
+	for rows.Next() {
+		var bucket model.SpinAggregate
+		err := rows.Scan(&bucket.Start, &bucket.Spins, &bucket.Wins, &bucket.TotalWagered, &bucket.TotalPaid, &bucket.UniquePlayers, &bucket.LargestWin)
+		if err != nil {
+			return model.SpinAggregate{}, nil, err
+		}
+		bucket.Start = bucket.Start.UTC()
+		series = append(series, bucket)
+	}
+	return total, series, rows.Err()
+}
+
+func (r *PostgresSpinRepository) SumJackpotContributions(ctx context.Context) ([]model.JackpotContributionTotal, error) {
+	return queryJackpotContributionTotals(ctx, conn(ctx, r.pool))
+}
+
+// queryJackpotContributionTotals também é usada pela conciliação, dentro da
+// transação do snapshot.
+func queryJackpotContributionTotals(ctx context.Context, q querier) ([]model.JackpotContributionTotal, error) {
+	rows, err := q.Query(ctx, `
+		SELECT jackpot_pool_id, machine_id, SUM(jackpot_contribution)
+		FROM spins
+		WHERE jackpot_contribution > 0
+		GROUP BY jackpot_pool_id, machine_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []model.JackpotContributionTotal
+	for rows.Next() {
+		var total model.JackpotContributionTotal
+		if err := rows.Scan(&total.PoolID, &total.MachineID, &total.Amount); err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+	return totals, rows.Err()
+}
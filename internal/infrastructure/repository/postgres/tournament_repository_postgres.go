@@ -0,0 +1,216 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	tournamentColumns = `id, name, machine_ids, starts_at, ends_at, entry_fee, guaranteed_prize, prize_distribution,
+		scoring, status, entrants, created_by, created_at, settled_at`
+	tournamentEntryColumns = `tournament_id, player_id, score, spins, joined_at, scored_at`
+)
+
+type PostgresTournamentRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresTournamentRepository(pool *pgxpool.Pool) repository.TournamentRepository {
+	return &PostgresTournamentRepository{pool: pool}
+}
+
+func scanTournament(row pgx.Row) (*model.Tournament, error) {
+	tournament := &model.Tournament{}
+	err := row.Scan(&tournament.ID, &tournament.Name, &tournament.MachineIDs, &tournament.StartsAt, &tournament.EndsAt,
+		&tournament.EntryFee, &tournament.GuaranteedPrize, &tournament.PrizeDistribution, &tournament.Scoring,
+		&tournament.Status, &tournament.Entrants, &tournament.CreatedBy, &tournament.CreatedAt, &tournament.SettledAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrTournamentNotFound
+		}
+		return nil, err
+	}
+	return tournament, nil
+}
+
+func scanTournamentEntry(row pgx.Row) (*model.TournamentEntry, error) {
+	entry := &model.TournamentEntry{}
+	err := row.Scan(&entry.TournamentID, &entry.PlayerID, &entry.Score, &entry.Spins, &entry.JoinedAt, &entry.ScoredAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrTournamentEntryNotFound
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (r *PostgresTournamentRepository) CreateTournament(ctx context.Context, tournament *model.Tournament) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO tournaments (`+tournamentColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		tournament.ID, tournament.Name, tournament.MachineIDs, tournament.StartsAt, tournament.EndsAt,
+		tournament.EntryFee, tournament.GuaranteedPrize, tournament.PrizeDistribution, tournament.Scoring,
+		tournament.Status, tournament.Entrants, tournament.CreatedBy, tournament.CreatedAt, tournament.SettledAt)
+	return err
+}
+
+func (r *PostgresTournamentRepository) GetTournament(ctx context.Context, id string) (*model.Tournament, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+tournamentColumns+`
+		FROM tournaments
+		WHERE id = $1`, id)
+	return scanTournament(row)
+}
+
+func (r *PostgresTournamentRepository) ListTournaments(ctx context.Context, status model.TournamentStatus) ([]*model.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + ` FROM tournaments`
+	var args []any
+	if status != "" {
+		query += ` WHERE status = $1`
+		args = append(args, status)
+	}
+	query += ` ORDER BY starts_at DESC`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return collectTournaments(rows)
+}
+
+func (r *PostgresTournamentRepository) ListRunningTournaments(ctx context.Context, machineID string, now time.Time) ([]*model.Tournament, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+tournamentColumns+`
+		FROM tournaments
+		WHERE status = $1 AND starts_at <= $2 AND ends_at > $2 AND $3 = ANY(machine_ids)
+		ORDER BY starts_at DESC`, model.TournamentStatusOpen, now, machineID)
+	if err != nil {
+		return nil, err
+	}
+	return collectTournaments(rows)
+}
+
+func (r *PostgresTournamentRepository) ListDueTournaments(ctx context.Context, now time.Time) ([]*model.Tournament, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+tournamentColumns+`
+		FROM tournaments
+		WHERE status = $1 AND ends_at <= $2
+		ORDER BY ends_at`, model.TournamentStatusOpen, now)
+	if err != nil {
+		return nil, err
+	}
+	return collectTournaments(rows)
+}
+
+func (r *PostgresTournamentRepository) AddEntry(ctx context.Context, entry *model.TournamentEntry) error {
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			INSERT INTO tournament_entries (`+tournamentEntryColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (tournament_id, player_id) DO NOTHING`,
+			entry.TournamentID, entry.PlayerID, entry.Score, entry.Spins, entry.JoinedAt, entry.ScoredAt)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return repository.ErrTournamentEntryExists
+		}
+		tag, err = tx.Exec(ctx, `UPDATE tournaments SET entrants = entrants + 1 WHERE id = $1`, entry.TournamentID)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return repository.ErrTournamentNotFound
+		}
+		return nil
+	})
+}
+
+func (r *PostgresTournamentRepository) GetEntry(ctx context.Context, tournamentID, playerID string) (*model.TournamentEntry, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+tournamentEntryColumns+`
+		FROM tournament_entries
+		WHERE tournament_id = $1 AND player_id = $2`, tournamentID, playerID)
+	return scanTournamentEntry(row)
+}
+
+func (r *PostgresTournamentRepository) ListEntries(ctx context.Context, tournamentID string) ([]*model.TournamentEntry, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+tournamentEntryColumns+`
+		FROM tournament_entries
+		WHERE tournament_id = $1`, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*model.TournamentEntry
+	for rows.Next() {
+		entry, err := scanTournamentEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func (r *PostgresTournamentRepository) RecordScore(ctx context.Context, tournamentID, playerID string, scoring model.TournamentScoring, points int, at time.Time) error {
+	// A pontuação é atualizada na própria linha para que jogadas simultâneas
+	// do mesmo jogador não se sobrescrevam.
+	update := `score = score + $1, scored_at = CASE WHEN $1 > 0 THEN $2 ELSE scored_at END`
+	if scoring == model.TournamentScoringBiggestMultiplier {
+		update = `score = GREATEST(score, $1), scored_at = CASE WHEN $1 > score THEN $2 ELSE scored_at END`
+	}
+
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE tournament_entries
+		SET spins = spins + 1, `+update+`
+		WHERE tournament_id = $3 AND player_id = $4`,
+		points, at, tournamentID, playerID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrTournamentEntryNotFound
+	}
+	return nil
+}
+
+func (r *PostgresTournamentRepository) SettleTournament(ctx context.Context, id string, settledAt time.Time) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE tournaments
+		SET status = $1, settled_at = $2
+		WHERE id = $3 AND status = $4`,
+		model.TournamentStatusSettled, settledAt, id, model.TournamentStatusOpen)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		if _, err := r.GetTournament(ctx, id); err != nil {
+			return err
+		}
+		return repository.ErrTournamentSettled
+	}
+	return nil
+}
+
+func collectTournaments(rows pgx.Rows) ([]*model.Tournament, error) {
+	defer rows.Close()
+
+	var tournaments []*model.Tournament
+	for rows.Next() {
+		tournament, err := scanTournament(rows)
+		if err != nil {
+			return nil, err
+		}
+		tournaments = append(tournaments, tournament)
+	}
+	return tournaments, rows.Err()
+}
@@ -0,0 +1,65 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresTransactionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresTransactionRepository(pool *pgxpool.Pool) repository.TransactionRepository {
+	return &PostgresTransactionRepository{pool: pool}
+}
+
+func (r *PostgresTransactionRepository) RecordTransaction(ctx context.Context, transaction *model.Transaction) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO transactions (id, player_id, type, amount, machine_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		transaction.ID, transaction.PlayerID, transaction.Type, transaction.Amount, transaction.MachineID, transaction.CreatedAt)
+	return err
+}
+
+func (r *PostgresTransactionRepository) SumTransactions(ctx context.Context, playerID string, txType model.TransactionType, since time.Time) (int, error) {
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM transactions
+		WHERE player_id = $1 AND type = $2 AND created_at >= $3`,
+		playerID, txType, since).Scan(&total)
+	return total, err
+}
+
+func (r *PostgresTransactionRepository) ListTransactions(ctx context.Context, playerID string, limit int) ([]*model.Transaction, error) {
+	query := `
+		SELECT id, player_id, type, amount, machine_id, created_at
+		FROM transactions
+		WHERE player_id = $1
+		ORDER BY created_at DESC, id`
+	args := []any{playerID}
+	if limit > 0 {
+		query += ` LIMIT $2`
+		args = append(args, limit)
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []*model.Transaction
+	for rows.Next() {
+		tx := &model.Transaction{}
+		if err := rows.Scan(&tx.ID, &tx.PlayerID, &tx.Type, &tx.Amount, &tx.MachineID, &tx.CreatedAt); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, tx)
+	}
+	return transactions, rows.Err()
+}
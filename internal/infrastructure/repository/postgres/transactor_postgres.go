@@ -0,0 +1,49 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/ports"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type txKey struct{}
+
+// querier é atendido tanto pelo pool de conexões quanto por uma transação.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// conn retorna a transação do contexto ou, fora dela, o pool. Dentro de uma
+// transação, pgx.BeginFunc abre um savepoint em vez de outra transação.
+func conn(ctx context.Context, pool *pgxpool.Pool) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return pool
+}
+
+type PostgresTransactor struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresTransactor(pool *pgxpool.Pool) ports.Transactor {
+	return &PostgresTransactor{pool: pool}
+}
+
+// WithinTransaction executa fn em uma transação compartilhada por todos os
+// repositórios Postgres que recebem o contexto repassado. Chamadas aninhadas
+// reutilizam a transação externa.
+func (t *PostgresTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+	return pgx.BeginFunc(ctx, t.pool, func(tx pgx.Tx) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
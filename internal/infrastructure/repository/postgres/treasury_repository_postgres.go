@@ -0,0 +1,109 @@
+package repository_postgres
+
+import (
+	"context"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresTreasuryRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresTreasuryRepository(pool *pgxpool.Pool) repository.TreasuryRepository {
+	return &PostgresTreasuryRepository{pool: pool}
+}
+
+func (r *PostgresTreasuryRepository) GetTreasury(ctx context.Context) (*model.Treasury, error) {
+	treasury := &model.Treasury{}
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, balance, updated_at
+		FROM treasury
+		WHERE id = $1`, model.HouseTreasuryID).Scan(&treasury.ID, &treasury.Balance, &treasury.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return treasury, nil
+}
+
+func (r *PostgresTreasuryRepository) ApplyMovement(ctx context.Context, movement *model.TreasuryMovement, minMachineBalance int) error {
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		return applyTreasuryMovement(ctx, tx, movement, minMachineBalance)
+	})
+}
+
+// applyTreasuryMovement é compartilhada com o repositório de ajustes, que
+// credita ou debita a tesouraria dentro da própria transação.
+func applyTreasuryMovement(ctx context.Context, tx pgx.Tx, movement *model.TreasuryMovement, minMachineBalance int) error {
+	err := tx.QueryRow(ctx, `
+		UPDATE treasury
+		SET balance = balance + $1, updated_at = $2
+		WHERE id = $3 AND balance + $1 >= 0
+		RETURNING balance`,
+		movement.TreasuryDelta(), movement.CreatedAt, model.HouseTreasuryID).Scan(&movement.TreasuryBalance)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return repository.ErrInsufficientTreasuryBalance
+		}
+		return err
+	}
+
+	if movement.Type == model.MovementRefill || movement.Type == model.MovementCashout {
+		var balance int
+		err := tx.QueryRow(ctx, `SELECT balance FROM slot_machines WHERE id = $1 FOR UPDATE`, movement.MachineID).Scan(&balance)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return repository.ErrSlotMachineNotFound
+			}
+			return err
+		}
+		balance -= movement.TreasuryDelta()
+		if movement.Type == model.MovementCashout && balance < minMachineBalance {
+			return repository.ErrInsufficientMachineBalance
+		}
+		if _, err := tx.Exec(ctx, `UPDATE slot_machines SET balance = $1 WHERE id = $2`, balance, movement.MachineID); err != nil {
+			return err
+		}
+		movement.MachineBalance = balance
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO treasury_movements (id, type, machine_id, jackpot_pool_id, amount, reason, actor, treasury_balance, machine_balance, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		movement.ID, movement.Type, movement.MachineID, movement.JackpotPoolID, movement.Amount, movement.Reason, movement.Actor,
+		movement.TreasuryBalance, movement.MachineBalance, movement.CreatedAt)
+	return err
+}
+
+func (r *PostgresTreasuryRepository) ListTreasuryMovements(ctx context.Context, limit int) ([]*model.TreasuryMovement, error) {
+	query := `
+		SELECT id, type, machine_id, jackpot_pool_id, amount, reason, actor, treasury_balance, machine_balance, created_at
+		FROM treasury_movements
+		ORDER BY created_at DESC, id`
+	var args []any
+	if limit > 0 {
+		query += ` LIMIT $1`
+		args = append(args, limit)
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []*model.TreasuryMovement
+	for rows.Next() {
+		movement := &model.TreasuryMovement{}
+		err := rows.Scan(&movement.ID, &movement.Type, &movement.MachineID, &movement.JackpotPoolID, &movement.Amount, &movement.Reason, &movement.Actor,
+			&movement.TreasuryBalance, &movement.MachineBalance, &movement.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+	return movements, rows.Err()
+}
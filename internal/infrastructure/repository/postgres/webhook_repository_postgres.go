@@ -0,0 +1,205 @@
+package repository_postgres
+
+import (
+	"context"
+	"fmt"
+	"slot-machine/internal/domain/model"
+	"slot-machine/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	webhookSubscriptionColumns = `id, url, event_types, secret, created_by, created_at`
+	webhookDeliveryColumns     = `id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+		last_attempt_at, last_status_code, last_error, created_at, delivered_at`
+)
+
+type PostgresWebhookRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresWebhookRepository(pool *pgxpool.Pool) repository.WebhookRepository {
+	return &PostgresWebhookRepository{pool: pool}
+}
+
+func scanWebhookSubscription(row pgx.Row) (*model.WebhookSubscription, error) {
+	subscription := &model.WebhookSubscription{}
+	var eventTypes []string
+	err := row.Scan(&subscription.ID, &subscription.URL, &eventTypes, &subscription.Secret, &subscription.CreatedBy, &subscription.CreatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrWebhookSubscriptionNotFound
+		}
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		subscription.EventTypes = append(subscription.EventTypes, model.DomainEventType(eventType))
+	}
+	return subscription, nil
+}
+
+func scanWebhookDelivery(row pgx.Row) (*model.WebhookDelivery, error) {
+	delivery := &model.WebhookDelivery{}
+	err := row.Scan(&delivery.ID, &delivery.SubscriptionID, &delivery.EventID, &delivery.EventType, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastAttemptAt, &delivery.LastStatusCode,
+		&delivery.LastError, &delivery.CreatedAt, &delivery.DeliveredAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *PostgresWebhookRepository) CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO webhook_subscriptions (`+webhookSubscriptionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		subscription.ID, subscription.URL, eventTypes, subscription.Secret, subscription.CreatedBy, subscription.CreatedAt)
+	return err
+}
+
+func (r *PostgresWebhookRepository) GetSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+webhookSubscriptionColumns+`
+		FROM webhook_subscriptions
+		WHERE id = $1`, id)
+	return scanWebhookSubscription(row)
+}
+
+func (r *PostgresWebhookRepository) ListSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+webhookSubscriptionColumns+`
+		FROM webhook_subscriptions
+		ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []*model.WebhookSubscription
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, rows.Err()
+}
+
+func (r *PostgresWebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+func (r *PostgresWebhookRepository) AddDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO webhook_deliveries (`+webhookDeliveryColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING`,
+		delivery.ID, delivery.SubscriptionID, delivery.EventID, delivery.EventType, nullableJSON(delivery.Payload),
+		delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastAttemptAt, delivery.LastStatusCode,
+		delivery.LastError, delivery.CreatedAt, delivery.DeliveredAt)
+	return err
+}
+
+func (r *PostgresWebhookRepository) GetDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT `+webhookDeliveryColumns+`
+		FROM webhook_deliveries
+		WHERE id = $1`, id)
+	return scanWebhookDelivery(row)
+}
+
+func (r *PostgresWebhookRepository) ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+webhookDeliveryColumns+`
+		FROM webhook_deliveries
+		WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at
+		LIMIT $3`, model.WebhookDeliveryPending, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return collectWebhookDeliveries(rows)
+}
+
+func (r *PostgresWebhookRepository) ListDeliveries(ctx context.Context, filter repository.WebhookDeliveryFilter) ([]*model.WebhookDelivery, error) {
+	var conditions []string
+	var args []any
+	addCondition := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.SubscriptionID != "" {
+		addCondition("subscription_id = $%d", filter.SubscriptionID)
+	}
+	if filter.Status != "" {
+		addCondition("status = $%d", filter.Status)
+	}
+
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY created_at DESC`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return collectWebhookDeliveries(rows)
+}
+
+func (r *PostgresWebhookRepository) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $1, attempts = $2, next_attempt_at = $3, last_attempt_at = $4,
+			last_status_code = $5, last_error = $6, delivered_at = $7
+		WHERE id = $8`,
+		delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastAttemptAt,
+		delivery.LastStatusCode, delivery.LastError, delivery.DeliveredAt, delivery.ID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrWebhookDeliveryNotFound
+	}
+	return nil
+}
+
+func collectWebhookDeliveries(rows pgx.Rows) ([]*model.WebhookDelivery, error) {
+	defer rows.Close()
+
+	var deliveries []*model.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ParseTimeOfDay converte "HH:MM" no deslocamento a partir da meia-noite.
+func ParseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("horário %q deve estar no formato HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// NextDailyRun retorna a próxima ocorrência do horário at (UTC) depois de now.
+func NextDailyRun(now time.Time, at time.Duration) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(at)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// RunDaily executa job uma vez por dia no horário at (UTC) até ctx ser
+// cancelado. Uma execução longa não se sobrepõe à seguinte.
+func RunDaily(ctx context.Context, at time.Duration, job func(ctx context.Context)) {
+	for {
+		timer := time.NewTimer(time.Until(NextDailyRun(time.Now(), at)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			job(ctx)
+		}
+	}
+}
+
+// RunEvery executa job a cada interval até ctx ser cancelado. Como em
+// RunDaily, uma execução longa não se sobrepõe à seguinte.
+func RunEvery(ctx context.Context, interval time.Duration, job func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			job(ctx)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package security
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	domain_security "slot-machine/internal/domain/security"
+)
+
+// bcryptMaxBytes é o maior tamanho de senha que o bcrypt aceita.
+const bcryptMaxBytes = 72
+
+type ListPasswordPolicy struct {
+	MinLength int
+	MaxLength int
+	breached  map[string]struct{}
+}
+
+func NewPasswordPolicy(minLength, maxLength int, breached []string) *ListPasswordPolicy {
+	if maxLength <= 0 || maxLength > bcryptMaxBytes {
+		maxLength = bcryptMaxBytes
+	}
+
+	set := make(map[string]struct{}, len(breached))
+	for _, password := range breached {
+		set[strings.ToLower(password)] = struct{}{}
+	}
+
+	return &ListPasswordPolicy{
+		MinLength: minLength,
+		MaxLength: maxLength,
+		breached:  set,
+	}
+}
+
+func (p *ListPasswordPolicy) Validate(password string) error {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return domain_security.ErrPasswordTooShort
+	}
+	if len(password) > p.MaxLength {
+		return domain_security.ErrPasswordTooLong
+	}
+	if _, found := p.breached[strings.ToLower(password)]; found {
+		return domain_security.ErrPasswordBreached
+	}
+	return nil
+}
+
+// LoadBreachedPasswords lê um arquivo local com uma senha vazada por linha.
+// Linhas vazias e iniciadas por '#' são ignoradas.
+func LoadBreachedPasswords(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var passwords []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords = append(passwords, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return passwords, nil
+}
@@ -0,0 +1,43 @@
+package security
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTPGenerator(t *testing.T) {
+	// Vetores de teste da RFC 6238 (SHA1, 8 dígitos).
+	generator := NewTOTPGenerator("Slot Machine")
+	generator.Digits = 8
+	secret := secretEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	t.Run("Code_RFCVectors", func(t *testing.T) {
+		vectors := map[int64]string{
+			59:          "94287082",
+			1111111109:  "07081804",
+			1234567890:  "89005924",
+			20000000000: "65353130",
+		}
+
+		for unix, expected := range vectors {
+			code, err := generator.Code(secret, time.Unix(unix, 0))
+			assert.NoError(t, err, "Expected no error generating the code")
+			assert.Equal(t, expected, code, "Code for %d should match the RFC vector", unix)
+		}
+	})
+
+	t.Run("Verify_AcceptsAdjacentStep", func(t *testing.T) {
+		at := time.Unix(1111111109, 0)
+		code, err := generator.Code(secret, at.Add(-30*time.Second))
+		assert.NoError(t, err, "Expected no error generating the code")
+
+		counter, ok := generator.Verify(secret, code, at)
+		assert.True(t, ok, "Expected the previous step to be accepted")
+		assert.Equal(t, at.Unix()/30-1, counter, "Expected the matched counter to be returned")
+
+		_, ok = generator.Verify(secret, code, at.Add(2*time.Minute))
+		assert.False(t, ok, "Expected codes outside the skew window to be rejected")
+	})
+}